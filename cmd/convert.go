@@ -11,10 +11,10 @@ import (
 	"github.com/spf13/viper"
 	"github.com/turtacn/SQLTraceBench/internal/app/conversion"
 	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+	"github.com/turtacn/SQLTraceBench/internal/domain/services"
 	"github.com/turtacn/SQLTraceBench/internal/infrastructure/parsers"
 	"github.com/turtacn/SQLTraceBench/pkg/utils"
 	"github.com/turtacn/SQLTraceBench/plugin_registry"
-	"github.com/turtacn/SQLTraceBench/plugins"
 )
 
 var (
@@ -28,15 +28,82 @@ var (
 	outputPath   string
 	targetPlugin string
 	convertMode  string
+	traceFormat  string
+	sourceDB     string
+	convertSel   conversion.TraceSelection
+	convertAnon  anonymizationFlags
+	convertClust clusteringFlags
 )
 
 func init() {
 	rootCmd.AddCommand(convertCmd)
 	convertCmd.Flags().StringVarP(&tracePath, "trace", "t", "", "Path to the raw SQL trace file (deprecated, use --source)")
-	convertCmd.Flags().StringVarP(&sourcePath, "source", "s", "", "Path to the source file (schema SQL or trace JSON)")
+	convertCmd.Flags().StringVarP(&sourcePath, "source", "s", "", "Path to the source file (schema SQL or trace file); traces may also be a directory or glob of .gz/.zst files")
 	convertCmd.Flags().StringVarP(&outputPath, "out", "o", "output.json", "Path to the output file")
 	convertCmd.Flags().StringVar(&targetPlugin, "target", "", "Target database for SQL translation (e.g., clickhouse, starrocks)")
 	convertCmd.Flags().StringVarP(&convertMode, "mode", "m", "auto", "Conversion mode: 'schema' or 'trace' (auto-detect by extension)")
+	convertCmd.Flags().StringVar(&traceFormat, "trace-format", "", "Trace file format: "+strings.Join(parsers.GlobalTraceReaders.Names(), ", ")+" (auto-detect from content)")
+	convertCmd.Flags().StringVar(&sourceDB, "source-db", "", "SQL dialect of the traces, e.g. mysql or postgres (derived from the trace format if empty)")
+	addTraceSelectionFlags(convertCmd, &convertSel)
+	convertAnon.register(convertCmd)
+	convertClust.register(convertCmd)
+	convertCmd.Flags().StringVar(&convertClust.reportPath, "cluster-report", "", "Write the template cluster report to this JSON file")
+}
+
+// addTraceSelectionFlags registers the trace filter and sampling flags of a command.
+func addTraceSelectionFlags(cmd *cobra.Command, sel *conversion.TraceSelection) {
+	cmd.Flags().StringVar(&sel.Filter, "filter", "", "Keep only traces matching this expression, e.g. \"type == 'SELECT' && table == 'orders' && db == 'shop'\"")
+	cmd.Flags().Float64Var(&sel.SampleRate, "sample-rate", 0, "Keep this fraction (0-1] of the filtered traces, chosen deterministically")
+	cmd.Flags().StringVar(&sel.SampleBy, "sample-by", "trace", "Sampling key: trace, session, query or txn")
+	cmd.Flags().StringVar(&sel.SampleSeed, "sample-seed", "", "Seed that varies which traces are sampled")
+}
+
+// anonymizationFlags holds the PII anonymization flags of a command.
+type anonymizationFlags struct {
+	enabled    bool
+	configPath string
+}
+
+func (f *anonymizationFlags) register(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&f.enabled, "anonymize", false, "Replace PII in traces with keyed pseudonyms (key in $"+services.DefaultAnonymizerSecretEnv+" unless configured otherwise)")
+	cmd.Flags().StringVar(&f.configPath, "anonymize-config", services.DefaultAnonymizerConfigPath, "Anonymization detectors and sensitive column/parameter names")
+}
+
+// config returns the anonymization configuration, or nil if --anonymize is not set.
+// A missing config file enables all detectors with no sensitive fields.
+func (f *anonymizationFlags) config() (*services.AnonymizerConfig, error) {
+	if !f.enabled {
+		return nil, nil
+	}
+	cfg, err := services.LoadAnonymizerConfig(f.configPath)
+	if os.IsNotExist(err) {
+		cfg, err = &services.AnonymizerConfig{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	cfg.Enabled = true
+	return cfg, nil
+}
+
+// clusteringFlags holds the template clustering flags of a command.
+type clusteringFlags struct {
+	enabled    bool
+	threshold  float64
+	reportPath string
+}
+
+func (f *clusteringFlags) register(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&f.enabled, "cluster", false, "Merge near-duplicate templates, e.g. ORM variants with reordered columns or optional predicates")
+	cmd.Flags().Float64Var(&f.threshold, "cluster-threshold", services.DefaultClusterThreshold, "Minimum similarity (0-1] of a template to its cluster's representative")
+}
+
+// config returns the clustering configuration, or nil if --cluster is not set.
+func (f *clusteringFlags) config() *services.ClusterConfig {
+	if !f.enabled {
+		return nil
+	}
+	return &services.ClusterConfig{Enabled: true, Threshold: f.threshold}
 }
 
 func runConvert(cmd *cobra.Command, args []string) error {
@@ -54,12 +121,14 @@ func runConvert(cmd *cobra.Command, args []string) error {
 	// Detect mode
 	if convertMode == "auto" {
 		ext := strings.ToLower(filepath.Ext(sourcePath))
-		if ext == ".sql" || ext == ".ddl" {
+		if traceFormat != "" {
+			convertMode = "trace"
+		} else if ext == ".sql" || ext == ".ddl" {
 			convertMode = "schema"
-		} else if ext == ".json" || ext == ".jsonl" {
+		} else if _, err := parsers.OpenTraceSource(sourcePath, "", 0); err == nil {
 			convertMode = "trace"
 		} else {
-			return fmt.Errorf("could not auto-detect mode from file extension %s, please specify --mode", ext)
+			return fmt.Errorf("could not auto-detect mode for %s, please specify --mode: %w", sourcePath, err)
 		}
 	}
 
@@ -74,6 +143,7 @@ func runConvert(cmd *cobra.Command, args []string) error {
 			SourceSchemaPath: sourcePath,
 			TargetDBType:     targetPlugin,
 			OutputPath:       outputPath,
+			SourceDB:         sourceDB,
 		}
 		if err := svc.ConvertSchemaFromFile(cmd.Context(), req); err != nil {
 			return err
@@ -83,11 +153,8 @@ func runConvert(cmd *cobra.Command, args []string) error {
 	}
 
 	// Trace Conversion Logic
-	logger.Info("Starting Trace Conversion", utils.Field{Key: "source", Value: sourcePath})
-	bufferSize := viper.GetInt("parser.buffer_size")
-	if bufferSize == 0 {
-		bufferSize = 1024 * 1024
-	}
+	logger.Info("Starting Trace Conversion", utils.Field{Key: "source", Value: sourcePath}, utils.Field{Key: "format", Value: traceFormat})
+	bufferSize := viper.GetInt("parser.buffer_size") // Zero uses configs/trace_parser.yaml
 
 	isStreamOutput := len(outputPath) > 5 && outputPath[len(outputPath)-6:] == ".jsonl"
 
@@ -99,13 +166,9 @@ func runConvert(cmd *cobra.Command, args []string) error {
 }
 
 func runTraceStreamConversion(cmd *cobra.Command, svc conversion.Service, logger *utils.Logger, bufferSize int) error {
-	var plugin plugins.Plugin
-	if targetPlugin != "" {
-		p, err := plugin_registry.GetPlugin(targetPlugin)
-		if err != nil {
-			return err
-		}
-		plugin = p
+	anonymization, err := convertAnon.config()
+	if err != nil {
+		return err
 	}
 
 	outFile, err := os.Create(outputPath)
@@ -113,23 +176,22 @@ func runTraceStreamConversion(cmd *cobra.Command, svc conversion.Service, logger
 		return err
 	}
 	defer outFile.Close()
-	encoder := json.NewEncoder(outFile)
+	writer := parsers.NewJSONLTraceWriter(outFile)
 
 	count := 0
-	err = svc.ConvertStreamingly(cmd.Context(), sourcePath, bufferSize, func(trace models.SQLTrace) error {
-		if plugin != nil {
-			translatedQuery, err := plugin.TranslateQuery(trace.Query)
-			if err == nil {
-				trace.Query = translatedQuery
-			}
-		}
-		if err := encoder.Encode(trace); err != nil {
+	req := conversion.ConvertTraceRequest{
+		SourcePath:    sourcePath,
+		TargetDBType:  targetPlugin,
+		Format:        traceFormat,
+		SourceDB:      sourceDB,
+		Selection:     convertSel,
+		Anonymization: anonymization,
+	}
+	_, err = svc.ConvertStreamingly(cmd.Context(), req, bufferSize, func(trace models.SQLTrace) error {
+		if err := writer.Write(trace); err != nil {
 			return err
 		}
 		count++
-		if count%10000 == 0 {
-			logger.Info("Progress", utils.Field{Key: "processed", Value: count})
-		}
 		return nil
 	})
 
@@ -141,15 +203,34 @@ func runTraceStreamConversion(cmd *cobra.Command, svc conversion.Service, logger
 }
 
 func runTraceBatchConversion(cmd *cobra.Command, svc conversion.Service, logger *utils.Logger) error {
+	anonymization, err := convertAnon.config()
+	if err != nil {
+		return err
+	}
 	req := conversion.ConvertTraceRequest{
-		SourcePath:   sourcePath,
-		TargetDBType: targetPlugin,
+		SourcePath:    sourcePath,
+		TargetDBType:  targetPlugin,
+		Format:        traceFormat,
+		SourceDB:      sourceDB,
+		Selection:     convertSel,
+		Anonymization: anonymization,
+		Clustering:    convertClust.config(),
 	}
 
 	result, err := svc.ConvertFromFile(cmd.Context(), req)
 	if err != nil {
 		return err
 	}
+	if convertClust.reportPath != "" && result.Clusters != nil {
+		data, err := json.MarshalIndent(result.Clusters, "", "  ")
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(convertClust.reportPath, data, 0644); err != nil {
+			return err
+		}
+		logger.Info("Template cluster report written", utils.Field{Key: "output", Value: convertClust.reportPath})
+	}
 
 	outFile, err := os.Create(outputPath)
 	if err != nil {
@@ -3,13 +3,23 @@ package cmd
 import (
 	"context"
 	"encoding/json"
-	"io/ioutil"
+	"errors"
+	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/turtacn/SQLTraceBench/internal/app"
+	"github.com/turtacn/SQLTraceBench/internal/app/conversion"
 	"github.com/turtacn/SQLTraceBench/internal/app/generation"
 	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+	"github.com/turtacn/SQLTraceBench/internal/domain/services"
+	"github.com/turtacn/SQLTraceBench/internal/infrastructure/database"
+	"github.com/turtacn/SQLTraceBench/internal/infrastructure/parsers"
+	"github.com/turtacn/SQLTraceBench/internal/infrastructure/storage"
+	"github.com/turtacn/SQLTraceBench/pkg/types"
+	"github.com/turtacn/SQLTraceBench/pkg/utils"
 )
 
 var (
@@ -21,35 +31,140 @@ var (
 	sourceTracePath string
 	workloadPath    string
 	genCount        int
+	genTraceFormat  string
+	genDialect      string
+	genSourceDB     string
+	genSelection    conversion.TraceSelection
+	genAnon         anonymizationFlags
+	genClust        clusteringFlags
+	genModelDir     string
+	genSaveModelDir string
+	genRunID        string
+	genRefTime      string
+	genSessions     services.SessionConfig
+	genKeyDSN       string
+	genKeyDriver    string
+	genKeyLimit     int
+	genTemporal     services.TemporalConfig
 )
 
 func init() {
 	rootCmd.AddCommand(generateCmd)
-	generateCmd.Flags().StringVarP(&sourceTracePath, "source-traces", "s", "traces.json", "Path to the source SQL trace file")
+	generateCmd.Flags().StringVarP(&sourceTracePath, "source-traces", "s", "traces.json", "Path to the source SQL trace file, directory or glob")
 	generateCmd.Flags().StringVarP(&workloadPath, "out", "o", "workload.json", "Path to the output workload file")
 	generateCmd.Flags().IntVarP(&genCount, "count", "c", 1000, "Number of queries to generate in the workload")
+	generateCmd.Flags().StringVar(&genTraceFormat, "trace-format", "", "Source trace format: "+strings.Join(parsers.GlobalTraceReaders.TraceNames(), ", ")+" (auto-detect from content)")
+	generateCmd.Flags().StringVar(&genSourceDB, "source-db", "", "SQL dialect of the source traces, e.g. mysql or postgres (derived from the trace format if empty)")
+	generateCmd.Flags().StringVar(&genDialect, "dialect", "mysql", "Placeholder syntax of the generated queries: mysql and starrocks use ?, postgres $n, clickhouse {name:Type}")
+	addTraceSelectionFlags(generateCmd, &genSelection)
+	genAnon.register(generateCmd)
+	genClust.register(generateCmd)
+	generateCmd.Flags().StringVar(&genSaveModelDir, "save-model", "", "Save the extracted templates and parameter model under this directory, as a new run")
+	generateCmd.Flags().StringVar(&genModelDir, "model", "", "Generate from a model saved with --save-model under this directory instead of reading traces")
+	generateCmd.Flags().StringVar(&genRunID, "run-id", "", "Run ID to save the model as, or to load (default: a new ID when saving, the latest run when loading)")
+	generateCmd.Flags().BoolVar(&genSessions.Enabled, "sessions", false, "Learn the flows between templates within the sessions of the traces, and generate per-session streams")
+	generateCmd.Flags().IntVar(&genSessions.Order, "session-order", 1, "Number of preceding templates the next query of a session depends on (1-5)")
+	generateCmd.Flags().DurationVar(&genSessions.Timeout, "session-timeout", services.DefaultSessionTimeout, "Pause after which a session is split in two")
+	generateCmd.Flags().BoolVar(&genTemporal.Enabled, "temporal", false, "Schedule each query at a time drawn from the rate of the source traces over time")
+	generateCmd.Flags().DurationVar(&genTemporal.Window, "temporal-window", time.Hour, "Width of the bins the rate of the source traces is measured in")
+	generateCmd.Flags().DurationVar(&genTemporal.Duration, "duration", 0, "Time to spread the scheduled queries over (default: the span of the source traces)")
+	generateCmd.Flags().StringVar(&genKeyDSN, "key-dsn", "", "DSN of the target database to draw lookup keys from, so that generated lookups find rows")
+	generateCmd.Flags().StringVar(&genKeyDriver, "key-driver", "mysql", "Database driver for --key-dsn: mysql or clickhouse")
+	generateCmd.Flags().IntVar(&genKeyLimit, "key-candidates", services.DefaultKeyCandidates, "Number of values read per key column of the target database")
+	generateCmd.Flags().StringVar(&genRefTime, "reference-time", "", "Time to re-anchor datetime parameters to, keeping their offsets from the trace timestamps: RFC 3339, 'YYYY-MM-DD hh:mm:ss' or 'YYYY-MM-DD' in UTC (default: now)")
 }
 
 func runGenerate(cmd *cobra.Command, args []string) error {
 	root := app.NewRoot()
+	ctx := context.Background()
+	logger := utils.GetGlobalLogger()
+	if genModelDir != "" && genSaveModelDir != "" {
+		return fmt.Errorf("--model and --save-model cannot be used together")
+	}
 
-	// 1. Load source traces from the input file.
-	traceData, err := ioutil.ReadFile(sourceTracePath)
-	if err != nil {
-		return err
+	req := generation.GenerateRequest{
+		Count:    genCount,
+		Dialect:  types.DatabaseTypeFromString(genDialect),
+		Temporal: genTemporal,
 	}
-	var sourceTraces []models.SQLTrace
-	if err := json.Unmarshal(traceData, &sourceTraces); err != nil {
-		return err
+	if genRefTime != "" {
+		ref, err := parseReferenceTime(genRefTime)
+		if err != nil {
+			return err
+		}
+		req.ReferenceTime = ref
 	}
+	if genModelDir != "" {
+		model, runID, err := loadWorkloadModel(ctx, genModelDir, genRunID)
+		if err != nil {
+			return err
+		}
+		req.Model = model
+		logger.Info("Loaded workload model", utils.Field{Key: "dir", Value: genModelDir}, utils.Field{Key: "run_id", Value: runID},
+			utils.Field{Key: "templates", Value: len(model.Templates)})
+	} else {
+		// 1. Complete the generation request for the source traces.
+		anonymization, err := genAnon.config()
+		if err != nil {
+			return err
+		}
+		convReq := conversion.ConvertTraceRequest{
+			SourcePath:    sourceTracePath,
+			Format:        genTraceFormat,
+			SourceDB:      genSourceDB,
+			Selection:     genSelection,
+			Anonymization: anonymization,
+		}
+		req.SourceDialect, err = root.Conversion.TraceDialect(convReq)
+		if errors.Is(err, conversion.ErrTemplateSource) {
+			return fmt.Errorf("generate learns parameter values from individual traces, which aggregated statistics such as pg_stat_statements do not hold: %w", err)
+		}
+		if err != nil {
+			return err
+		}
+		if cfg := genClust.config(); cfg != nil {
+			req.Clustering = *cfg
+		}
+		req.Sessions = genSessions
 
-	// 2. Create the generation request.
-	req := generation.GenerateRequest{
-		SourceTraces: sourceTraces,
-		Count:        genCount,
+		// 2. Build the model as the traces are read, without holding them.
+		builder, err := root.Generation.NewModelBuilder(req)
+		if err != nil {
+			return err
+		}
+		_, err = root.Conversion.ConvertStreamingly(ctx, convReq, 0, func(trace models.SQLTrace) error {
+			builder.Add(trace)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if req.Model, err = builder.Model(); err != nil {
+			return err
+		}
+
+		if genSaveModelDir != "" {
+			runID := genRunID
+			if runID == "" {
+				runID = storage.NewRunID()
+			}
+			if err := saveWorkloadModel(ctx, genSaveModelDir, runID, req.Model); err != nil {
+				return err
+			}
+			logger.Info("Saved workload model", utils.Field{Key: "dir", Value: genSaveModelDir}, utils.Field{Key: "run_id", Value: runID},
+				utils.Field{Key: "templates", Value: len(req.Model.Templates)})
+		}
 	}
 
-	// 3. Generate the workload.
+	// 3. Generate the workload, drawing lookup keys from the target database if one is
+	// given.
+	keySource, closeKeySource, err := openKeySource(genKeyDSN, genKeyDriver)
+	if err != nil {
+		return err
+	}
+	defer closeKeySource()
+	req.KeySource = keySource
+	req.KeyCandidates = genKeyLimit
 	workload, err := root.Generation.GenerateWorkload(context.Background(), req)
 	if err != nil {
 		return err
@@ -66,3 +181,74 @@ func runGenerate(cmd *cobra.Command, args []string) error {
 	encoder.SetIndent("", "  ")
 	return encoder.Encode(workload)
 }
+
+// openKeySource returns the source of the lookup keys given by the --key-dsn
+// flag, or nil if it is not set, and a function that releases it.
+func openKeySource(dsn, driver string) (services.KeySource, func(), error) {
+	if dsn == "" {
+		return nil, func() {}, nil
+	}
+	source, err := database.OpenSQLKeySource(driver, dsn)
+	if err != nil {
+		return nil, nil, err
+	}
+	return source, func() { source.Close() }, nil
+}
+
+// parseReferenceTime parses the --reference-time flag.
+func parseReferenceTime(value string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02 15:04:05", "2006-01-02"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid reference time %q: use RFC 3339, 'YYYY-MM-DD hh:mm:ss' or 'YYYY-MM-DD'", value)
+}
+
+// saveWorkloadModel stores the templates and parameter model of a run under dir.
+func saveWorkloadModel(ctx context.Context, dir, runID string, model *generation.WorkloadModel) error {
+	tplRepo, err := storage.NewFileTemplateRepository(dir, runID)
+	if err != nil {
+		return err
+	}
+	paramRepo, err := storage.NewFileParameterRepository(dir, runID)
+	if err != nil {
+		return err
+	}
+	if err := tplRepo.SaveAll(ctx, model.Templates); err != nil {
+		return fmt.Errorf("failed to save templates: %w", err)
+	}
+	if err := paramRepo.Save(ctx, model.Parameters); err != nil {
+		return fmt.Errorf("failed to save parameter model: %w", err)
+	}
+	return nil
+}
+
+// loadWorkloadModel reads the model of a run saved under dir, or of the latest run
+// if runID is empty.
+func loadWorkloadModel(ctx context.Context, dir, runID string) (*generation.WorkloadModel, string, error) {
+	if runID == "" {
+		latest, err := storage.LatestRun(dir)
+		if err != nil {
+			return nil, "", err
+		}
+		runID = latest
+	}
+	tplRepo, err := storage.NewFileTemplateRepository(dir, runID)
+	if err != nil {
+		return nil, "", err
+	}
+	paramRepo, err := storage.NewFileParameterRepository(dir, runID)
+	if err != nil {
+		return nil, "", err
+	}
+	templates, err := tplRepo.List(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	params, err := paramRepo.Load(ctx, runID)
+	if err != nil {
+		return nil, "", err
+	}
+	return &generation.WorkloadModel{Templates: templates, Parameters: params}, runID, nil
+}
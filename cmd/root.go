@@ -87,6 +87,9 @@ var workflowRunCmd = &cobra.Command{
 		if err := yaml.Unmarshal(data, &pipelineCfg); err != nil {
 			return err
 		}
+		if format, _ := cmd.Flags().GetString("trace-format"); format != "" {
+			pipelineCfg.InputTraceFormat = format
+		}
 
 		// Confirmation Step
 		if !autoYes && terminal.IsTerminal() {
@@ -132,6 +135,7 @@ func init() {
 
 	workflowRunCmd.Flags().StringP("config", "c", "", "Pipeline config YAML")
 	workflowRunCmd.Flags().BoolVarP(&autoYes, "yes", "y", false, "Skip confirmation prompt")
+	workflowRunCmd.Flags().String("trace-format", "", "Input trace format, overrides input_trace_format in the pipeline config")
 	workflowRunCmd.MarkFlagRequired("config")
 	workflowCmd.AddCommand(workflowRunCmd)
 	rootCmd.AddCommand(workflowCmd)
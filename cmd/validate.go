@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"os"
+	"path/filepath"
 
 	"github.com/spf13/cobra"
 	"github.com/turtacn/SQLTraceBench/internal/app"
@@ -65,5 +66,15 @@ func runValidate(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
-	return reporter.GenerateReport(report, reportPath)
+	result := &models.ValidationResult{
+		BaseMetrics:      baseResult.ToPerformanceMetrics(),
+		CandidateMetrics: candResult.ToPerformanceMetrics(),
+		Pass:             report.Status == "PASS",
+		Reason:           report.Status,
+	}
+	outPath := reportPath
+	if info, err := os.Stat(reportPath); err == nil && info.IsDir() {
+		outPath = filepath.Join(reportPath, "validation_report.html")
+	}
+	return reporter.GenerateReport(result, cfg.Database.Driver, outPath)
 }
\ No newline at end of file
@@ -60,4 +60,18 @@ func TestCreateAndGetJob(t *testing.T) {
 	err = json.Unmarshal(w.Body.Bytes(), &fetchedJob)
 	require.NoError(t, err)
 	assert.Equal(t, createdJob.ID, fetchedJob.ID)
-}
\ No newline at end of file
+}
+
+func TestCreateJob_UnsupportedTraceFormat(t *testing.T) {
+	router := setupRouter()
+
+	config := models.Config{TracePath: "traces.log", TraceFormat: "oracle_awr"}
+	body, _ := json.Marshal(config)
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/jobs", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "unsupported trace format")
+}
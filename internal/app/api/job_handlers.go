@@ -6,6 +6,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+	"github.com/turtacn/SQLTraceBench/internal/infrastructure/parsers"
 )
 
 // JobHandler handles job-related API requests.
@@ -25,6 +26,12 @@ func (h *JobHandler) CreateJob(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
+	if config.TraceFormat != "" {
+		if _, ok := parsers.GlobalTraceReaders.Get(config.TraceFormat); !ok {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "unsupported trace format: " + config.TraceFormat})
+			return
+		}
+	}
 
 	job := &models.Job{
 		Status:    models.JobStatusPending,
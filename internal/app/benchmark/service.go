@@ -12,6 +12,7 @@ import (
 	"github.com/turtacn/SQLTraceBench/internal/app/generation"
 	"github.com/turtacn/SQLTraceBench/internal/domain/services"
 	"github.com/turtacn/SQLTraceBench/internal/infrastructure/metrics"
+	"github.com/turtacn/SQLTraceBench/internal/infrastructure/parsers"
 )
 
 type BenchmarkService interface {
@@ -58,6 +59,9 @@ type ModelConfig struct {
 	Name       string `yaml:"name"`
 	Type       string `yaml:"type"`
 	ConfigPath string `yaml:"config_path"`
+	// SourceTraces is the trace file, directory or glob a "markov" model learns the
+	// sessions of.
+	SourceTraces string `yaml:"source_traces"`
 }
 
 type ScenarioConfig struct {
@@ -87,7 +91,10 @@ func (s *DefaultBenchmarkService) RunBenchmark(
 	// Since I don't have the full model registry here, I will create a mock/placeholder wrapper
 	// or use a hypothetical factory.
 	// In a real app, this would use a ModelFactory.
-	modelsList := initModels(config.Benchmark.Models)
+	modelsList, err := initModels(ctx, config.Benchmark.Models)
+	if err != nil {
+		return nil, err
+	}
 
 	runner := services.NewBenchmarkRunner(modelsList)
 
@@ -147,6 +154,9 @@ func loadBenchmarkConfig(path string) (*Config, error) {
 type RealModelWrapper struct {
 	name    string
 	service generation.Service
+	// model, when set, is what the queries are generated from, as learned from the
+	// source traces of the "markov" model type.
+	model *generation.WorkloadModel
 }
 
 func (m *RealModelWrapper) Name() string { return m.name }
@@ -162,6 +172,9 @@ func (m *RealModelWrapper) Generate(ctx context.Context, count int) ([]models.SQ
 		Count:        count,
 		SourceTraces: []models.SQLTrace{dummyTrace},
 	}
+	if m.model != nil {
+		req = generation.GenerateRequest{Count: count, Model: m.model}
+	}
 
 	workload, err := m.service.GenerateWorkload(ctx, req)
 	if err != nil {
@@ -186,23 +199,77 @@ func (m *RealModelWrapper) Generate(ctx context.Context, count int) ([]models.SQ
 	return traces, nil
 }
 
-func initModels(modelConfigs []ModelConfig) []services.TraceGeneratorModel {
+func initModels(ctx context.Context, modelConfigs []ModelConfig) ([]services.TraceGeneratorModel, error) {
 	var modelsList []services.TraceGeneratorModel
 	for _, mc := range modelConfigs {
-		// Instantiate the default service for all types for now,
-		// as we don't have distinct classes for Markov vs LSTM in the provided codebase inspection.
-		// Assuming `generation.NewService()` gives us the standard generator.
-		// If specific types require specific setup, we would do it here.
-
+		// Every type uses the standard generator; "markov" generates sessions that
+		// follow the transitions between the templates of its source traces.
+		// Other types have no specific setup yet.
 		svc := generation.NewService()
-		// If we had config specific to the model, we would apply it here.
-
-		modelsList = append(modelsList, &RealModelWrapper{
+		wrapper := &RealModelWrapper{
 			name:    mc.Name,
 			service: svc,
-		})
+		}
+		if mc.Type == "markov" {
+			if mc.SourceTraces == "" {
+				fmt.Fprintf(os.Stderr, "warning: skipping markov model %s, which needs source_traces to learn sessions from\n", mc.Name)
+				continue
+			}
+			model, err := trainSessionModel(ctx, svc, mc)
+			if err != nil {
+				return nil, fmt.Errorf("failed to train model %s: %w", mc.Name, err)
+			}
+			if model.Parameters == nil || model.Parameters.Sessions == nil {
+				fmt.Fprintf(os.Stderr, "error: markov model %s learned no sessions from %s (the traces need session ids and timestamps); its queries are picked independently\n", mc.Name, mc.SourceTraces)
+			}
+			wrapper.model = model
+		}
+
+		modelsList = append(modelsList, wrapper)
+	}
+	return modelsList, nil
+}
+
+// trainSessionModel learns the templates, parameters and sessions of the source
+// traces of a "markov" model.
+func trainSessionModel(ctx context.Context, svc generation.Service, mc ModelConfig) (*generation.WorkloadModel, error) {
+	src, err := parsers.OpenTraceSource(mc.SourceTraces, "", 0)
+	if err != nil {
+		return nil, err
+	}
+	builder, err := svc.NewModelBuilder(generation.GenerateRequest{
+		SourceDialect: src.Dialect(),
+		Sessions:      loadSessionConfig(mc.ConfigPath),
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = src.Parse(ctx, func(trace models.SQLTrace) error {
+		builder.Add(trace)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return builder.Model()
+}
+
+// loadSessionConfig reads the session model settings of a "markov" model from its
+// config file, whose settings are optional; the defaults are used if it is missing.
+func loadSessionConfig(path string) services.SessionConfig {
+	cfg := services.SessionConfig{Enabled: true}
+	if path == "" {
+		return cfg
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cfg
+	}
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		fmt.Fprintf(os.Stderr, "warning: ignoring invalid markov model config %s: %v\n", path, err)
 	}
-	return modelsList
+	cfg.Enabled = true
+	return cfg
 }
 
 func generateReport(results []services.BenchmarkResult, outputDir string) (string, error) {
@@ -0,0 +1,79 @@
+package benchmark
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/turtacn/SQLTraceBench/internal/app/generation"
+	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+)
+
+func TestInitModels_Markov(t *testing.T) {
+	// Every session lists its cart and then pays for it.
+	start := time.Date(2025, 5, 10, 12, 0, 0, 0, time.UTC)
+	var traces []models.SQLTrace
+	for i := 0; i < 20; i++ {
+		ts := start.Add(time.Duration(i) * time.Minute)
+		traces = append(traces,
+			models.SQLTrace{Query: fmt.Sprintf("SELECT * FROM cart WHERE user_id = %d", i), SessionID: fmt.Sprint(i), Timestamp: ts},
+			models.SQLTrace{Query: fmt.Sprintf("UPDATE cart SET status = 'paid' WHERE user_id = %d", i), SessionID: fmt.Sprint(i), Timestamp: ts.Add(time.Second)},
+		)
+	}
+	data, err := json.Marshal(traces)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "traces.json")
+	require.NoError(t, os.WriteFile(path, data, 0644))
+
+	list, err := initModels(context.Background(), []ModelConfig{
+		{Name: "markov_v1", Type: "markov", SourceTraces: path},
+		{Name: "untrained", Type: "markov"},
+	})
+	require.NoError(t, err)
+	require.Len(t, list, 1, "a markov model without source traces is skipped")
+	assert.Equal(t, "markov_v1", list[0].Name())
+
+	generated, err := list[0].Generate(context.Background(), 10)
+	require.NoError(t, err)
+	require.Len(t, generated, 10)
+	for i, trace := range generated {
+		if i%2 == 0 {
+			assert.True(t, strings.HasPrefix(trace.Query, "select * from cart"), trace.Query)
+		} else {
+			assert.True(t, strings.HasPrefix(trace.Query, "update cart"), trace.Query)
+		}
+	}
+}
+
+func TestInitModels_MissingTraces(t *testing.T) {
+	_, err := initModels(context.Background(), []ModelConfig{
+		{Name: "markov_v1", Type: "markov", SourceTraces: filepath.Join(t.TempDir(), "missing.json")},
+	})
+	assert.Error(t, err)
+}
+
+func TestInitModels_DefaultConfig(t *testing.T) {
+	// The paths of the default config are relative to the repository root.
+	t.Chdir(filepath.Join("..", "..", ".."))
+	config, err := loadBenchmarkConfig("configs/benchmark.yaml")
+	require.NoError(t, err)
+
+	var markov *ModelConfig
+	for i, mc := range config.Benchmark.Models {
+		if mc.Type == "markov" {
+			markov = &config.Benchmark.Models[i]
+		}
+	}
+	require.NotNil(t, markov)
+	model, err := trainSessionModel(context.Background(), generation.NewService(), *markov)
+	require.NoError(t, err)
+	require.NotNil(t, model.Parameters)
+	assert.NotNil(t, model.Parameters.Sessions)
+}
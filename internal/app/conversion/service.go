@@ -2,6 +2,7 @@ package conversion
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -10,9 +11,15 @@ import (
 	"github.com/turtacn/SQLTraceBench/internal/domain/models"
 	"github.com/turtacn/SQLTraceBench/internal/domain/services"
 	"github.com/turtacn/SQLTraceBench/internal/infrastructure/parsers"
+	"github.com/turtacn/SQLTraceBench/pkg/types"
+	"github.com/turtacn/SQLTraceBench/pkg/utils"
 	"github.com/turtacn/SQLTraceBench/plugin_registry"
 )
 
+// ErrTemplateSource is returned when traces are streamed from a source of
+// pre-aggregated templates (e.g. pg_stat_statements), which holds no individual traces.
+var ErrTemplateSource = errors.New("source holds aggregated templates, not individual traces")
+
 // ConvertRequest represents a request to convert a schema.
 type ConvertRequest struct {
 	SourceSchemaPath string
@@ -23,31 +30,109 @@ type ConvertRequest struct {
 
 // ConvertTraceRequest represents a request to convert traces.
 type ConvertTraceRequest struct {
-	SourcePath   string
+	SourcePath   string // A file, a directory or a glob; files may be gzip or zstd compressed
 	TargetDBType string
+	Format       string // Optional, detected from the file content if empty
+	SourceDB     string // Optional SQL dialect of the traces (e.g. mysql, postgres), derived from the format if empty
+	Selection    TraceSelection
+	// Anonymization, when enabled, replaces PII in the selected traces before translation.
+	Anonymization *services.AnonymizerConfig
+	// Clustering, when enabled, merges near-duplicate templates.
+	Clustering *services.ClusterConfig
+}
+
+// TraceSelection restricts the traces that enter templating. The filter is applied
+// to the traces as read, before SQL translation, and sampling to the traces that
+// pass the filter.
+type TraceSelection struct {
+	// Filter is a services.TraceFilter expression, e.g. "query !~ '^SELECT 1$' && db == 'shop'".
+	Filter string `yaml:"filter" json:"filter,omitempty"`
+	// SampleRate keeps this fraction (0, 1] of the filtered traces. Zero keeps all of them.
+	SampleRate float64 `yaml:"sample_rate" json:"sample_rate,omitempty"`
+	// SampleBy is the sampling key: trace (default), session, query or txn.
+	SampleBy string `yaml:"sample_by" json:"sample_by,omitempty"`
+	// SampleSeed varies which traces are sampled while keeping runs reproducible.
+	SampleSeed string `yaml:"sample_seed" json:"sample_seed,omitempty"`
+}
+
+// IsZero reports whether the selection keeps every trace.
+func (sel TraceSelection) IsZero() bool {
+	return sel.Filter == "" && sel.SampleRate == 0
+}
+
+// traceSelector applies a compiled TraceSelection and counts the traces it drops.
+type traceSelector struct {
+	filter   *services.TraceFilter
+	sampler  *services.TraceSampler
+	filtered int64
+	sampled  int64
+}
+
+func newTraceSelector(sel TraceSelection, parser services.Parser) (*traceSelector, error) {
+	filter, err := services.ParseTraceFilter(sel.Filter)
+	if err != nil {
+		return nil, err
+	}
+	filter.UseParser(parser)
+	ts := &traceSelector{filter: filter}
+	if sel.SampleRate != 0 {
+		ts.sampler, err = services.NewTraceSampler(sel.SampleRate, sel.SampleBy, sel.SampleSeed)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ts, nil
+}
+
+// keep reports whether a trace passes the filter and the sample.
+func (ts *traceSelector) keep(trace *models.SQLTrace) bool {
+	if !ts.filter.Match(trace) {
+		ts.filtered++
+		return false
+	}
+	if !ts.sampler.Keep(trace) {
+		ts.sampled++
+		return false
+	}
+	return true
 }
 
 // ConversionResult holds the result of a trace conversion.
 type ConversionResult struct {
 	Traces    []models.SQLTrace
 	Templates []models.SQLTemplate
+	// Stats counts the parsed, skipped and defaulted input lines. It is nil for template sources.
+	Stats *parsers.ParseStats
+	// Dialect is the SQL dialect of the templates: the target database if the traces
+	// were translated, the source dialect otherwise.
+	Dialect types.DatabaseType
+	// Clusters reports the merged templates. It is nil unless clustering is enabled.
+	Clusters *services.ClusterReport
 }
 
 // Service is the interface for the conversion service.
 type Service interface {
 	ConvertFromFile(ctx context.Context, req ConvertTraceRequest) (*ConversionResult, error)
 	ConvertSchemaFromFile(ctx context.Context, req ConvertRequest) error
-	ConvertStreamingly(ctx context.Context, tracePath string, bufferSize int, callback func(models.SQLTrace) error) error
+	ConvertStreamingly(ctx context.Context, req ConvertTraceRequest, bufferSize int, callback func(models.SQLTrace) error) (types.DatabaseType, error)
+	// TraceDialect returns the SQL dialect of the traces ConvertStreamingly passes to its
+	// callback for req, without reading them.
+	TraceDialect(req ConvertTraceRequest) (types.DatabaseType, error)
 }
 
 // DefaultService is the default implementation of the conversion service.
 type DefaultService struct {
-	templateSvc    *services.TemplateService
+	templateSvc *services.TemplateService
+	// parser is the default parser; dialectParsers override it for the queries of a
+	// SQL dialect the default parser cannot read.
 	parser         services.Parser
+	dialectParsers map[types.DatabaseType]services.Parser
 	pluginRegistry *plugin_registry.Registry
+	parserConfig   *parsers.ParserConfig
 }
 
 // NewService creates a new DefaultService.
+// Trace parser settings are read from configs/trace_parser.yaml when it exists.
 func NewService(parser services.Parser, registry *plugin_registry.Registry) Service {
 	if registry == nil {
 		registry = plugin_registry.GlobalRegistry
@@ -56,74 +141,325 @@ func NewService(parser services.Parser, registry *plugin_registry.Registry) Serv
 		templateSvc:    services.NewTemplateService(),
 		parser:         parser,
 		pluginRegistry: registry,
+		parserConfig:   loadParserConfig(parsers.DefaultParserConfigPath),
+		dialectParsers: map[types.DatabaseType]services.Parser{
+			types.DatabasePostgreSQL: parsers.NewPostgresParser(),
+		},
 	}
 }
 
-// ConvertFromFile reads SQL traces from a file, optionally translates them, and converts them to templates.
-func (s *DefaultService) ConvertFromFile(ctx context.Context, req ConvertTraceRequest) (*ConversionResult, error) {
-	file, err := os.Open(req.SourcePath)
+// loadParserConfig loads the trace parser configuration, falling back to the
+// reader defaults if the file is missing or invalid.
+func loadParserConfig(path string) *parsers.ParserConfig {
+	cfg, err := parsers.LoadParserConfig(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			utils.GetGlobalLogger().Warn("Failed to load trace parser config, using defaults",
+				utils.Field{Key: "path", Value: path}, utils.Field{Key: "error", Value: err})
+		}
+		return nil
+	}
+	return cfg
+}
+
+// openTraceSource opens a trace source configured from the parser configuration.
+func (s *DefaultService) openTraceSource(path, format string, bufferSize int) (*parsers.TraceSource, error) {
+	src, err := parsers.OpenTraceSource(path, format, bufferSize)
 	if err != nil {
 		return nil, err
 	}
-	defer file.Close()
+	src.Config = s.parserConfig
+	src.Stats = &parsers.ParseStats{}
+	if s.parserConfig != nil {
+		src.Stats.ErrorThreshold = int64(s.parserConfig.ErrorThreshold)
+	}
+	src.Progress = newProgressLogger(path)
+	return src, nil
+}
 
-	var traces []models.SQLTrace
-	parser := parsers.NewStreamingTraceParser(0) // Default buffer size
+// parserFor returns the parser for queries of a SQL dialect.
+func (s *DefaultService) parserFor(dialect types.DatabaseType) services.Parser {
+	if p, ok := s.dialectParsers[dialect]; ok {
+		return p
+	}
+	return s.parser
+}
 
-	// Prepare plugin if translation is needed
-	var plugin interface {
-		TranslateQuery(string) (string, error)
+// dialects returns the SQL dialect of the traces of a source, as read and after
+// translation to the target database.
+func dialects(req ConvertTraceRequest, src *parsers.TraceSource) (source, templates types.DatabaseType) {
+	source = types.DatabaseTypeFromString(req.SourceDB)
+	if source == types.DatabaseNone {
+		source = src.Dialect()
 	}
+	templates = source
 	if req.TargetDBType != "" {
-		p, ok := s.pluginRegistry.Get(req.TargetDBType)
-		if !ok {
-			return nil, fmt.Errorf("plugin not found: %s", req.TargetDBType)
-		}
-		plugin = p
+		templates = types.DatabaseTypeFromString(req.TargetDBType)
+	}
+	return source, templates
+}
+
+// ConvertFromFile reads SQL traces from a file, selects, optionally anonymizes and translates them, and converts them to templates.
+func (s *DefaultService) ConvertFromFile(ctx context.Context, req ConvertTraceRequest) (*ConversionResult, error) {
+	anonymizer, err := newAnonymizer(req.Anonymization)
+	if err != nil {
+		return nil, err
+	}
+	clusterer, err := newClusterer(req.Clustering)
+	if err != nil {
+		return nil, err
+	}
+	src, err := s.openTraceSource(req.SourcePath, req.Format, 0) // Configured buffer size
+	if err != nil {
+		return nil, err
+	}
+	sourceDialect, dialect := dialects(req, src)
+	selector, err := newTraceSelector(req.Selection, s.parserFor(sourceDialect))
+	if err != nil {
+		return nil, err
 	}
 
-	err = parser.Parse(file, func(trace models.SQLTrace) error {
-		if plugin != nil {
-			translated, err := plugin.TranslateQuery(trace.Query)
-			if err == nil {
-				trace.Query = translated
+	// Some sources (e.g. pg_stat_statements) are already aggregated into weighted templates.
+	if src.HoldsTemplates() {
+		if !req.Selection.IsZero() {
+			return nil, fmt.Errorf("trace filters and sampling are not supported for pre-aggregated sources")
+		}
+		tpls, err := src.ReadTemplates()
+		if err != nil {
+			return nil, err
+		}
+		if anonymizer != nil {
+			for i := range tpls {
+				tpls[i].RawSQL = anonymizer.AnonymizeQuery(tpls[i].RawSQL)
+				tpls[i].GroupKey = anonymizer.AnonymizeQuery(tpls[i].GroupKey)
 			}
 		}
+		templateSvc := s.templateSvc.ForDialect(sourceDialect).WithParser(s.parserFor(sourceDialect))
+		for i := range tpls {
+			templateSvc.Describe(&tpls[i])
+		}
+		tpls, clusters := clusterTemplates(clusterer, tpls)
+		logTemplateMix(tpls)
+		return &ConversionResult{Templates: tpls, Dialect: sourceDialect, Clusters: clusters}, nil
+	}
+
+	var traces []models.SQLTrace
+
+	translate, err := s.translator(req.TargetDBType)
+	if err != nil {
+		return nil, err
+	}
+
+	err = src.Parse(ctx, func(trace models.SQLTrace) error {
+		if !selector.keep(&trace) {
+			return nil
+		}
+		if anonymizer != nil {
+			trace = anonymizer.AnonymizeTrace(trace)
+		}
+		trace.Query = translate(trace.Query)
 		traces = append(traces, trace)
 		return nil
 	})
+	logParseSummary(req.SourcePath, src.Stats)
+	logSelectionSummary(req.Selection, selector, len(traces))
 	if err != nil {
 		return nil, err
 	}
 
 	tc := models.TraceCollection{Traces: traces}
-	tpls := s.templateSvc.ExtractTemplates(tc)
-
-	for i := range tpls {
-		tables, err := s.parser.ListTables(tpls[i].RawSQL)
-		if err != nil {
-			continue
-		}
-		_ = tables
-	}
+	tpls := s.templateSvc.ForDialect(dialect).WithParser(s.parserFor(dialect)).ExtractTemplates(tc)
+	tpls, clusters := clusterTemplates(clusterer, tpls)
+	logTemplateMix(tpls)
 
 	return &ConversionResult{
 		Traces:    traces,
 		Templates: tpls,
+		Stats:     src.Stats,
+		Dialect:   dialect,
+		Clusters:  clusters,
 	}, nil
 }
 
-// ConvertStreamingly processes traces line-by-line using the provided callback.
-func (s *DefaultService) ConvertStreamingly(ctx context.Context, tracePath string, bufferSize int, callback func(models.SQLTrace) error) error {
-	file, err := os.Open(tracePath)
+// ConvertStreamingly selects, optionally anonymizes and translates, and processes traces one at a time
+// using the provided callback. When req.SourcePath names several files, their traces are
+// merged in timestamp order. It returns the SQL dialect of the traces passed to the
+// callback, and ErrTemplateSource for sources of pre-aggregated templates.
+func (s *DefaultService) ConvertStreamingly(ctx context.Context, req ConvertTraceRequest, bufferSize int, callback func(models.SQLTrace) error) (types.DatabaseType, error) {
+	anonymizer, err := newAnonymizer(req.Anonymization)
+	if err != nil {
+		return types.DatabaseNone, err
+	}
+	translate, err := s.translator(req.TargetDBType)
+	if err != nil {
+		return types.DatabaseNone, err
+	}
+	src, err := s.openTraceSource(req.SourcePath, req.Format, bufferSize)
+	if err != nil {
+		return types.DatabaseNone, err
+	}
+	if src.HoldsTemplates() {
+		return types.DatabaseNone, fmt.Errorf("%s: %w", req.SourcePath, ErrTemplateSource)
+	}
+	sourceDialect, dialect := dialects(req, src)
+	selector, err := newTraceSelector(req.Selection, s.parserFor(sourceDialect))
+	if err != nil {
+		return types.DatabaseNone, err
+	}
+	kept := 0
+	err = src.Parse(ctx, func(trace models.SQLTrace) error {
+		if !selector.keep(&trace) {
+			return nil
+		}
+		kept++
+		if anonymizer != nil {
+			trace = anonymizer.AnonymizeTrace(trace)
+		}
+		trace.Query = translate(trace.Query)
+		return callback(trace)
+	})
+	logParseSummary(req.SourcePath, src.Stats)
+	logSelectionSummary(req.Selection, selector, kept)
+	return dialect, err
+}
+
+// TraceDialect returns the SQL dialect of the traces of a source after translation, and
+// ErrTemplateSource for sources of pre-aggregated templates.
+func (s *DefaultService) TraceDialect(req ConvertTraceRequest) (types.DatabaseType, error) {
+	src, err := parsers.OpenTraceSource(req.SourcePath, req.Format, 0)
 	if err != nil {
-		return err
+		return types.DatabaseNone, err
+	}
+	if src.HoldsTemplates() {
+		return types.DatabaseNone, fmt.Errorf("%s: %w", req.SourcePath, ErrTemplateSource)
+	}
+	_, dialect := dialects(req, src)
+	return dialect, nil
+}
+
+// newAnonymizer returns nil when anonymization is not enabled.
+func newAnonymizer(cfg *services.AnonymizerConfig) (*services.Anonymizer, error) {
+	if cfg == nil || !cfg.Enabled {
+		return nil, nil
+	}
+	return services.NewAnonymizer(*cfg)
+}
+
+// newClusterer returns nil when clustering is not enabled.
+func newClusterer(cfg *services.ClusterConfig) (*services.TemplateClusterer, error) {
+	if cfg == nil || !cfg.Enabled {
+		return nil, nil
+	}
+	return services.NewTemplateClusterer(*cfg)
+}
+
+// clusterTemplates merges near-duplicate templates if clusterer is set.
+func clusterTemplates(clusterer *services.TemplateClusterer, tpls []models.SQLTemplate) ([]models.SQLTemplate, *services.ClusterReport) {
+	if clusterer == nil {
+		return tpls, nil
+	}
+	clustered, report := clusterer.Cluster(tpls)
+	utils.GetGlobalLogger().Info("Template clustering finished",
+		utils.Field{Key: "threshold", Value: report.Threshold},
+		utils.Field{Key: "templates", Value: report.Templates},
+		utils.Field{Key: "clustered", Value: len(clustered)},
+		utils.Field{Key: "merged_clusters", Value: len(report.Clusters)})
+	return clustered, &report
+}
+
+// translator returns a function translating queries to the target database dialect.
+// Queries the plugin cannot translate are kept as they are.
+func (s *DefaultService) translator(targetDBType string) (func(string) string, error) {
+	if targetDBType == "" {
+		return func(query string) string { return query }, nil
+	}
+	plugin, ok := s.pluginRegistry.Get(targetDBType)
+	if !ok {
+		return nil, fmt.Errorf("plugin not found: %s", targetDBType)
+	}
+	return func(query string) string {
+		if translated, err := plugin.TranslateQuery(query); err == nil {
+			return translated
+		}
+		return query
+	}, nil
+}
+
+// logSelectionSummary reports how many traces the filter and the sample dropped.
+func logSelectionSummary(sel TraceSelection, selector *traceSelector, kept int) {
+	if sel.IsZero() {
+		return
 	}
-	defer file.Close()
+	utils.GetGlobalLogger().Info("Trace selection finished",
+		utils.Field{Key: "filter", Value: sel.Filter},
+		utils.Field{Key: "sample_rate", Value: sel.SampleRate},
+		utils.Field{Key: "kept", Value: kept},
+		utils.Field{Key: "filtered", Value: selector.filtered},
+		utils.Field{Key: "sampled_out", Value: selector.sampled})
+}
+
+// logTemplateMix reports the workload mix: the share of the weight of the templates
+// by statement type and the read/write split.
+func logTemplateMix(tpls []models.SQLTemplate) {
+	total := 0
+	byType := make(map[types.QueryType]int)
+	reads := 0
+	for _, t := range tpls {
+		total += t.Weight
+		byType[t.QueryType] += t.Weight
+		if t.ReadOnly {
+			reads += t.Weight
+		}
+	}
+	if total == 0 {
+		return
+	}
+	fields := make([]utils.Field, 0, len(byType)+1)
+	for _, qt := range []types.QueryType{types.QuerySelect, types.QueryInsert, types.QueryUpdate, types.QueryDelete, types.QueryDDL, types.QueryOther} {
+		if w, ok := byType[qt]; ok {
+			fields = append(fields, utils.Field{Key: strings.ToLower(qt.String()), Value: fmt.Sprintf("%.1f%%", 100*float64(w)/float64(total))})
+		}
+	}
+	fields = append(fields, utils.Field{Key: "read", Value: fmt.Sprintf("%.1f%%", 100*float64(reads)/float64(total))})
+	utils.GetGlobalLogger().Info("Workload mix", fields...)
+}
 
-	parser := parsers.NewStreamingTraceParser(bufferSize)
+// logParseSummary reports how many input lines were parsed, skipped as malformed
+// or had a field replaced by its default.
+func logParseSummary(path string, stats *parsers.ParseStats) {
+	fields := []utils.Field{
+		{Key: "source", Value: path},
+		{Key: "parsed", Value: stats.Parsed()},
+		{Key: "skipped", Value: stats.Skipped()},
+		{Key: "defaulted", Value: stats.Defaulted()},
+	}
+	logger := utils.GetGlobalLogger()
+	if stats.Skipped() > 0 || stats.Defaulted() > 0 {
+		logger.Warn("Trace parsing finished with skipped or defaulted lines", fields...)
+		return
+	}
+	logger.Info("Trace parsing finished", fields...)
+}
 
-	return parser.Parse(file, callback)
+// newProgressLogger returns a progress callback that logs every 10% of the input read.
+func newProgressLogger(path string) func(readBytes, totalBytes int64) {
+	logger := utils.GetGlobalLogger()
+	lastStep := int64(-1)
+	return func(readBytes, totalBytes int64) {
+		if totalBytes <= 0 {
+			return
+		}
+		step := readBytes * 10 / totalBytes
+		if step <= lastStep {
+			return
+		}
+		lastStep = step
+		logger.Info("Progress",
+			utils.Field{Key: "source", Value: path},
+			utils.Field{Key: "bytes_read", Value: readBytes},
+			utils.Field{Key: "bytes_total", Value: totalBytes},
+			utils.Field{Key: "percent", Value: step * 10})
+	}
 }
 
 // ConvertSchemaFromFile reads a SQL schema file, converts it to the target dialect, and writes the result.
@@ -145,6 +481,31 @@ func (s *DefaultService) ConvertSchemaFromFile(ctx context.Context, req ConvertR
 		return fmt.Errorf("unsupported source database: %s", sourceDB)
 	}
 
+	// A plugin registered for the target converts the schema itself, unless it
+	// leaves the CREATE statements to the converter.
+	if plugin, ok := s.pluginRegistry.Get(req.TargetDBType); ok {
+		tables, err := converter.ParseDDL(schemaContent)
+		if err != nil {
+			return fmt.Errorf("failed to parse schema: %w", err)
+		}
+		converted, err := plugin.ConvertSchema(&models.Schema{Databases: []models.DatabaseSchema{{Tables: tables}}})
+		if err != nil {
+			return fmt.Errorf("plugin %s failed to convert schema: %w", req.TargetDBType, err)
+		}
+		var sb strings.Builder
+		for _, db := range converted.Databases {
+			for _, table := range db.Tables {
+				if table.CreateSQL != "" {
+					sb.WriteString(table.CreateSQL)
+					sb.WriteString("\n\n")
+				}
+			}
+		}
+		if sb.Len() > 0 {
+			return os.WriteFile(req.OutputPath, []byte(sb.String()), 0644)
+		}
+	}
+
 	convertedDDL, err := converter.ConvertDDL(schemaContent, req.TargetDBType)
 	if err != nil {
 		return fmt.Errorf("failed to convert DDL: %w", err)
@@ -10,7 +10,10 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+	"github.com/turtacn/SQLTraceBench/internal/domain/services"
 	"github.com/turtacn/SQLTraceBench/internal/infrastructure/parsers"
+	"github.com/turtacn/SQLTraceBench/pkg/types"
 )
 
 // TestTemplate is a simplified struct for comparison.
@@ -71,3 +74,189 @@ func TestConvertFromFile_Integration(t *testing.T) {
 	assert.True(t, foundUsers, "users template should be found")
 	assert.True(t, foundOrders, "orders template should be found")
 }
+
+func TestConvertFromFile_PgStatStatements(t *testing.T) {
+	service := NewService(parsers.NewRegexParser(), nil)
+
+	tmpDir := t.TempDir()
+	statsPath := filepath.Join(tmpDir, "pg_stat_statements.csv")
+	content := `queryid,query,calls
+1,"SELECT * FROM users WHERE id = $1",90
+2,SELECT * FROM orders,10
+`
+	require.NoError(t, os.WriteFile(statsPath, []byte(content), 0644))
+
+	req := ConvertTraceRequest{SourcePath: statsPath, Format: parsers.TraceFormatPgStatStatements}
+	result, err := service.ConvertFromFile(context.Background(), req)
+	require.NoError(t, err)
+
+	assert.Empty(t, result.Traces)
+	require.Len(t, result.Templates, 2)
+	assert.Equal(t, "SELECT * FROM users WHERE id = :p1", result.Templates[0].RawSQL)
+	assert.Equal(t, 90, result.Templates[0].Weight)
+
+	// The templates hold no individual traces to stream.
+	_, err = service.ConvertStreamingly(context.Background(), req, 0, func(models.SQLTrace) error { return nil })
+	assert.ErrorIs(t, err, ErrTemplateSource)
+	_, err = service.TraceDialect(req)
+	assert.ErrorIs(t, err, ErrTemplateSource)
+
+	logPath := filepath.Join(tmpDir, "postgresql.log")
+	require.NoError(t, os.WriteFile(logPath, []byte("2025-01-01 12:00:00.000 UTC [42] LOG:  statement: SELECT * FROM users WHERE id = 7\n"), 0644))
+	var traces []models.SQLTrace
+	dialect, err := service.ConvertStreamingly(context.Background(), ConvertTraceRequest{SourcePath: logPath}, 0,
+		func(trace models.SQLTrace) error {
+			traces = append(traces, trace)
+			return nil
+		})
+	require.NoError(t, err)
+	assert.Len(t, traces, 1)
+	assert.Equal(t, types.DatabasePostgreSQL, dialect, "the dialect of the traces is returned")
+	dialect, err = service.TraceDialect(ConvertTraceRequest{SourcePath: logPath})
+	require.NoError(t, err)
+	assert.Equal(t, types.DatabasePostgreSQL, dialect, "the dialect is known before the traces are read")
+	dialect, err = service.TraceDialect(ConvertTraceRequest{SourcePath: logPath, TargetDBType: "clickhouse"})
+	require.NoError(t, err)
+	assert.Equal(t, types.DatabaseClickHouse, dialect, "translated traces are of the target dialect")
+}
+
+func TestConvertFromFile_Selection(t *testing.T) {
+	service := NewService(parsers.NewRegexParser(), nil)
+
+	tracePath := filepath.Join(t.TempDir(), "traces.jsonl")
+	content := `{"query": "SELECT 1", "timestamp": "2025-01-01T00:00:00Z", "db": "shop"}
+{"query": "SELECT * FROM orders", "timestamp": "2024-12-31T23:59:59Z", "db": "shop"}
+{"query": "SELECT * FROM orders", "timestamp": "2025-01-01T00:00:01Z", "db": "shop"}
+{"query": "SELECT * FROM users", "timestamp": "2025-01-01T00:00:02Z", "db": "crm"}
+`
+	require.NoError(t, os.WriteFile(tracePath, []byte(content), 0644))
+
+	req := ConvertTraceRequest{
+		SourcePath: tracePath,
+		Selection:  TraceSelection{Filter: "query !~ '^SELECT 1$' && ts >= '2025-01-01' && db == 'shop'"},
+	}
+	result, err := service.ConvertFromFile(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, result.Traces, 1)
+	assert.Equal(t, "SELECT * FROM orders", result.Traces[0].Query)
+
+	req.Selection = TraceSelection{Filter: "db =="}
+	_, err = service.ConvertFromFile(context.Background(), req)
+	assert.Error(t, err)
+
+	req.Selection = TraceSelection{SampleRate: 0.5}
+	var first, second []string
+	for _, out := range []*[]string{&first, &second} {
+		_, err := service.ConvertStreamingly(context.Background(), req, 0, func(trace models.SQLTrace) error {
+			*out = append(*out, trace.Timestamp.String())
+			return nil
+		})
+		require.NoError(t, err)
+	}
+	assert.Equal(t, first, second, "sampling is deterministic")
+}
+
+func TestConvertFromFile_QueryTypes(t *testing.T) {
+	service := NewService(parsers.NewRegexParser(), nil)
+
+	tracePath := filepath.Join(t.TempDir(), "traces.jsonl")
+	content := `{"query": "SELECT * FROM orders o JOIN users u ON u.id = o.user_id WHERE o.id = 1"}
+{"query": "SELECT * FROM orders o JOIN users u ON u.id = o.user_id WHERE o.id = 2"}
+{"query": "UPDATE orders SET total = 3 WHERE id = 1"}
+{"query": "SELECT * FROM users WHERE id = 4"}
+`
+	require.NoError(t, os.WriteFile(tracePath, []byte(content), 0644))
+
+	result, err := service.ConvertFromFile(context.Background(), ConvertTraceRequest{SourcePath: tracePath})
+	require.NoError(t, err)
+	require.Len(t, result.Templates, 3)
+	join := result.Templates[0]
+	assert.Equal(t, types.QuerySelect, join.QueryType)
+	assert.True(t, join.ReadOnly)
+	assert.Equal(t, []string{"orders", "users"}, join.Tables)
+	for _, tpl := range result.Templates[1:] {
+		if tpl.QueryType == types.QueryUpdate {
+			assert.False(t, tpl.ReadOnly)
+			assert.Equal(t, []string{"orders"}, tpl.Tables)
+		}
+	}
+
+	// Only SELECTs on orders.
+	req := ConvertTraceRequest{SourcePath: tracePath, Selection: TraceSelection{Filter: "type == 'SELECT' && table == 'orders'"}}
+	result, err = service.ConvertFromFile(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, result.Templates, 1)
+	assert.Equal(t, 2, result.Templates[0].Weight)
+}
+
+func TestConvertFromFile_PostgresDialect(t *testing.T) {
+	service := NewService(parsers.NewAntlrParser(), nil)
+
+	logPath := filepath.Join(t.TempDir(), "postgresql.log")
+	content := `2025-01-01 00:00:00.000 UTC [1] app@shop LOG:  duration: 1.000 ms  statement: INSERT INTO counters (key, n) VALUES ('a', 1) ON CONFLICT (key) DO UPDATE SET n = counters.n + 1 RETURNING n
+2025-01-01 00:00:01.000 UTC [1] app@shop LOG:  duration: 1.000 ms  statement: SELECT "Name" FROM "Accounts" WHERE created_at > '2025-01-01'::date
+2025-01-01 00:00:02.000 UTC [1] app@shop LOG:  duration: 1.000 ms  statement: SELECT "Name" FROM "Accounts" WHERE created_at > '2025-02-01'::date
+`
+	require.NoError(t, os.WriteFile(logPath, []byte(content), 0644))
+
+	result, err := service.ConvertFromFile(context.Background(), ConvertTraceRequest{SourcePath: logPath})
+	require.NoError(t, err)
+	assert.Equal(t, types.DatabasePostgreSQL, result.Dialect)
+	require.Len(t, result.Templates, 2)
+	assert.Equal(t, `select "Name" from "Accounts" where created_at > :p1::date`, result.Templates[0].RawSQL)
+	assert.Equal(t, []string{"Accounts"}, result.Templates[0].Tables)
+	assert.Equal(t, []string{"counters"}, result.Templates[1].Tables)
+
+	// The filter reads the tables with the parser of the source dialect.
+	req := ConvertTraceRequest{SourcePath: logPath, Selection: TraceSelection{Filter: "table == 'Accounts'"}}
+	result, err = service.ConvertFromFile(context.Background(), req)
+	require.NoError(t, err)
+	assert.Len(t, result.Traces, 2)
+}
+
+func TestConvertFromFile_Clustering(t *testing.T) {
+	service := NewService(parsers.NewRegexParser(), nil)
+
+	tracePath := filepath.Join(t.TempDir(), "traces.jsonl")
+	content := `{"query": "SELECT id, name FROM users WHERE id = 1 AND active = 1"}
+{"query": "SELECT id, name FROM users WHERE id = 2 AND active = 1"}
+{"query": "SELECT name, id FROM users WHERE active = 1 AND id = 3"}
+{"query": "SELECT * FROM orders"}
+`
+	require.NoError(t, os.WriteFile(tracePath, []byte(content), 0644))
+
+	req := ConvertTraceRequest{SourcePath: tracePath, Clustering: &services.ClusterConfig{Enabled: true}}
+	result, err := service.ConvertFromFile(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, result.Templates, 2)
+	assert.Equal(t, 3, result.Templates[0].Weight)
+	require.NotNil(t, result.Clusters)
+	require.Len(t, result.Clusters.Clusters, 1)
+	assert.Len(t, result.Clusters.Clusters[0].Members, 2)
+	assert.Len(t, result.Traces, 4, "traces are kept as read")
+}
+
+func TestConvertFromFile_Anonymization(t *testing.T) {
+	service := NewService(parsers.NewRegexParser(), nil)
+
+	tracePath := filepath.Join(t.TempDir(), "traces.jsonl")
+	content := `{"query": "SELECT * FROM users WHERE email = 'dave@example.com'", "timestamp": "2025-01-01T00:00:00Z"}
+{"query": "SELECT * FROM users WHERE email = 'dave@example.com'", "timestamp": "2025-01-01T00:00:01Z"}
+`
+	require.NoError(t, os.WriteFile(tracePath, []byte(content), 0644))
+
+	req := ConvertTraceRequest{
+		SourcePath:    tracePath,
+		Anonymization: &services.AnonymizerConfig{Enabled: true, Secret: "k"},
+	}
+	result, err := service.ConvertFromFile(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, result.Templates, 1)
+	assert.Equal(t, 2, result.Templates[0].Weight, "equal values map to equal pseudonyms")
+	assert.NotContains(t, result.Templates[0].RawSQL, "dave")
+
+	req.Anonymization.Secret = ""
+	req.Anonymization.SecretEnv = "SQLTRACEBENCH_TEST_UNSET_KEY"
+	_, err = service.ConvertFromFile(context.Background(), req)
+	assert.Error(t, err, "anonymization without a key is refused")
+}
@@ -54,12 +54,7 @@ func (s *DefaultService) RunBenchmark(ctx context.Context, workload *models.Benc
 				limiter.Acquire(ctx)
 
 				start := time.Now()
-				// Convert args to string
-				var args []string
-				for _, arg := range q.Args {
-					args = append(args, fmt.Sprintf("%v", arg))
-				}
-				_, err := plugin.ExecuteQuery(ctx, &proto.ExecuteQueryRequest{Sql: q.Query, Args: args})
+				_, err := plugin.ExecuteQuery(ctx, &proto.ExecuteQueryRequest{Sql: q.Query, Args: q.TextArgs(), ArgNames: q.ArgNames})
 				duration := time.Since(start)
 
 				results <- models.QueryExecutionResult{Duration: duration, Error: err}
@@ -14,6 +14,7 @@ import (
 
 type MockPlugin struct {
 	plugins.Plugin
+	requests []*proto.ExecuteQueryRequest
 }
 
 func (p *MockPlugin) Name() string {
@@ -21,6 +22,7 @@ func (p *MockPlugin) Name() string {
 }
 
 func (p *MockPlugin) ExecuteQuery(ctx context.Context, req *proto.ExecuteQueryRequest) (*proto.ExecuteQueryResponse, error) {
+	p.requests = append(p.requests, req)
 	return &proto.ExecuteQueryResponse{}, nil
 }
 
@@ -52,4 +54,22 @@ func TestDefaultService_RunBenchmark(t *testing.T) {
 	// Assert the results.
 	require.NoError(t, err)
 	assert.NotNil(t, result)
-}
\ No newline at end of file
+}
+func TestDefaultService_RunBenchmark_NamedArgs(t *testing.T) {
+	plugin := &MockPlugin{}
+	registry := plugin_registry.NewRegistry()
+	registry.Register(plugin)
+
+	workload := &models.BenchmarkWorkload{Queries: []models.QueryWithArgs{{
+		Query:    "SELECT * FROM orders WHERE total > {p1:Float64} AND id = {p2:Int64}",
+		Args:     []interface{}{1500000.0, int64(7)},
+		ArgNames: []string{"p1", "p2"},
+	}}}
+	_, err := NewService(registry).RunBenchmark(context.Background(), workload, ExecutionConfig{TargetDB: "mock", TargetQPS: 10, Concurrency: 1})
+	require.NoError(t, err)
+
+	// The names travel with the arguments, which are sent in the text ClickHouse parses.
+	require.Len(t, plugin.requests, 1)
+	assert.Equal(t, []string{"p1", "p2"}, plugin.requests[0].ArgNames)
+	assert.Equal(t, []string{"1500000", "7"}, plugin.requests[0].Args)
+}
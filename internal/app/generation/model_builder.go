@@ -0,0 +1,139 @@
+package generation
+
+import (
+	"fmt"
+
+	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+	"github.com/turtacn/SQLTraceBench/internal/domain/services"
+)
+
+// ModelBuilder builds a WorkloadModel from a stream of traces in a single pass. Only the
+// templates and bounded sketches of their parameters, sessions and rate over time are
+// held, so traces can be fed as they are read, e.g. from conversion.ConvertStreamingly.
+type ModelBuilder struct {
+	req       GenerateRequest
+	analyzer  *services.ParameterAnalyzer
+	templates *services.TemplateSet
+	// sketches summarizes the traces of each template; every template numbers its
+	// parameters from :p1, so the values are modeled per template.
+	sketches map[string]*templateSketches
+	sessions *services.SessionSketch
+	temporal *services.TemporalSketch
+}
+
+// templateSketches summarizes the traces of one template.
+type templateSketches struct {
+	params *services.ParameterSketches
+	joint  *services.JointSketch
+	ranges *services.RangeSketches
+}
+
+// NewModelBuilder returns a builder of the model of the traces described by req; its
+// SourceTraces are not read.
+func (s *DefaultService) NewModelBuilder(req GenerateRequest) (*ModelBuilder, error) {
+	b := &ModelBuilder{
+		req:       req,
+		analyzer:  s.analyzer,
+		templates: s.templateSvc.ForDialect(req.SourceDialect).NewTemplateSet(),
+		sketches:  make(map[string]*templateSketches),
+		temporal:  (&services.TemporalPatternExtractor{Window: req.Temporal.Window}).NewSketch(),
+	}
+	if req.Sessions.Enabled {
+		analyzer, err := services.NewSessionAnalyzer(req.Sessions)
+		if err != nil {
+			return nil, err
+		}
+		b.sessions = analyzer.NewSketch()
+	}
+	if req.Clustering.Enabled {
+		// Report an invalid configuration before the traces are read.
+		if _, err := services.NewTemplateClusterer(req.Clustering); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// Add adds a trace to the model.
+func (b *ModelBuilder) Add(trace models.SQLTrace) {
+	template := b.templates.Add(&trace)
+	sk, ok := b.sketches[template.GroupKey]
+	if !ok {
+		sk = &templateSketches{
+			params: b.analyzer.NewSketches(),
+			joint:  b.analyzer.NewJointSketch(),
+			ranges: b.analyzer.NewRangeSketches(template.RawSQL),
+		}
+		b.sketches[template.GroupKey] = sk
+	}
+	sk.params.Add(trace)
+	sk.joint.Add(trace)
+	sk.ranges.Add(trace)
+	if b.sessions != nil {
+		b.sessions.Add(trace, template.GroupKey)
+	}
+	b.temporal.Add(trace.Timestamp)
+}
+
+// Model returns the model of the traces added so far.
+func (b *ModelBuilder) Model() (*WorkloadModel, error) {
+	templates := b.templates.Templates()
+	if len(templates) == 0 {
+		return nil, fmt.Errorf("generation requires source traces")
+	}
+
+	// representative maps the GroupKey of a clustered template to its cluster's.
+	representative := make(map[string]string)
+	if b.req.Clustering.Enabled {
+		clusterer, err := services.NewTemplateClusterer(b.req.Clustering)
+		if err != nil {
+			return nil, err
+		}
+		// A representative's parameters are modeled from its own traces only.
+		var report services.ClusterReport
+		templates, report = clusterer.Cluster(templates)
+		for _, c := range report.Clusters {
+			for _, m := range c.Members {
+				representative[m.GroupKey] = c.Representative
+			}
+		}
+	}
+
+	workloadModel := models.NewWorkloadParameterModel()
+	if b.sessions != nil {
+		workloadModel.Sessions = b.sessions.Model(func(key string) string {
+			if rep, ok := representative[key]; ok {
+				return rep
+			}
+			return key
+		})
+	}
+
+	for _, tmpl := range templates {
+		if _, ok := workloadModel.TemplateParameters[tmpl.GroupKey]; !ok {
+			workloadModel.TemplateParameters[tmpl.GroupKey] = make(map[string]*models.ParameterModel)
+		}
+		sk, ok := b.sketches[tmpl.GroupKey]
+		if !ok {
+			continue
+		}
+		paramModels := b.analyzer.Models(sk.params)
+		for _, paramName := range tmpl.Parameters {
+			if model, exists := paramModels[paramName]; exists {
+				workloadModel.TemplateParameters[tmpl.GroupKey][paramName] = model
+			}
+		}
+		// Values that occur together, like a tenant and its users, are sampled together.
+		if joint := b.analyzer.JointModel(sk.joint); joint != nil {
+			workloadModel.TemplateJoints[tmpl.GroupKey] = joint
+		}
+		// The bounds of a range are kept in order, about as far apart as in the traces.
+		if ranges := b.analyzer.RangeModels(sk.ranges); len(ranges) > 0 {
+			workloadModel.TemplateRanges[tmpl.GroupKey] = ranges
+		}
+	}
+	// The rate over time is kept even when the workload is not shaped in time, so
+	// that a saved model can be generated from with temporal shaping later.
+	workloadModel.Temporal = b.temporal.Pattern()
+	return &WorkloadModel{Templates: templates, Parameters: workloadModel}, nil
+}
@@ -5,19 +5,63 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
+	"sort"
+	"time"
 
 	"github.com/turtacn/SQLTraceBench/internal/domain/models"
 	"github.com/turtacn/SQLTraceBench/internal/domain/services"
+	"github.com/turtacn/SQLTraceBench/internal/infrastructure/samplers"
+	"github.com/turtacn/SQLTraceBench/pkg/types"
 )
 
 // GenerateRequest encapsulates parameters for workload generation.
 type GenerateRequest struct {
 	SourceTraces []models.SQLTrace
 	Count        int
+	// Dialect selects the placeholder syntax of the generated queries; see
+	// models.SQLTemplate.GenerateQuery. The zero value renders '?' placeholders.
+	Dialect types.DatabaseType `yaml:"-"`
+	// SourceDialect is the SQL dialect of the source traces; the zero value reads them
+	// as MySQL.
+	SourceDialect types.DatabaseType `yaml:"-"`
+	// Clustering, when enabled, merges near-duplicate templates before sampling.
+	Clustering services.ClusterConfig `yaml:"clustering"`
+	// Model, when set, is generated from instead of SourceTraces, e.g. a model saved
+	// by an earlier run.
+	Model *WorkloadModel `yaml:"-"`
+	// ReferenceTime is the time datetime parameters are re-anchored to, keeping their
+	// offsets from the timestamps of the source traces. The zero value uses the time
+	// of generation.
+	ReferenceTime time.Time `yaml:"reference_time"`
+	// Sessions, when enabled, learns the order of the templates within the sessions of
+	// the source traces, and the workload is generated as ordered per-session streams.
+	Sessions services.SessionConfig `yaml:"sessions"`
+	// KeySource, when set, is the target database that the parameters looking up rows
+	// by key draw their values from, weighted to follow their learned distributions,
+	// so that the generated lookups find rows.
+	KeySource services.KeySource `yaml:"-"`
+	// KeyCandidates is the number of values read per key column; zero uses
+	// services.DefaultKeyCandidates.
+	KeyCandidates int `yaml:"key_candidates"`
+	// Temporal, when enabled, gives each query a time to issue it at, following the
+	// rate of the source traces over time, such as daily peaks and bursts, scaled to
+	// Temporal.Duration.
+	Temporal services.TemporalConfig `yaml:"temporal"`
+}
+
+// WorkloadModel is what a workload is generated from: the templates of the source
+// traces, weighted by frequency, and the distributions of their parameters.
+type WorkloadModel struct {
+	Templates  []models.SQLTemplate
+	Parameters *models.WorkloadParameterModel
 }
 
 // Service is the interface for the workload generation service.
 type Service interface {
+	// BuildModel extracts the templates and parameter model of the source traces.
+	BuildModel(ctx context.Context, req GenerateRequest) (*WorkloadModel, error)
+	// NewModelBuilder returns a builder of the same model from a stream of traces.
+	NewModelBuilder(req GenerateRequest) (*ModelBuilder, error)
 	GenerateWorkload(ctx context.Context, req GenerateRequest) (*models.BenchmarkWorkload, error)
 }
 
@@ -35,45 +79,110 @@ func NewService() Service {
 	}
 }
 
-func (s *DefaultService) GenerateWorkload(ctx context.Context, req GenerateRequest) (*models.BenchmarkWorkload, error) {
+func (s *DefaultService) BuildModel(ctx context.Context, req GenerateRequest) (*WorkloadModel, error) {
 	if len(req.SourceTraces) == 0 {
 		return nil, fmt.Errorf("generation requires source traces")
 	}
+	builder, err := s.NewModelBuilder(req)
+	if err != nil {
+		return nil, err
+	}
+	for _, tr := range req.SourceTraces {
+		builder.Add(tr)
+	}
+	return builder.Model()
+}
 
-	// 1. Parse traces to extract SQL templates and raw parameter values.
-	templates := s.templateSvc.ExtractTemplates(models.TraceCollection{Traces: req.SourceTraces})
+func (s *DefaultService) GenerateWorkload(ctx context.Context, req GenerateRequest) (*models.BenchmarkWorkload, error) {
+	model := req.Model
+	if model == nil {
+		var err error
+		if model, err = s.BuildModel(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+	templates := model.Templates
 	if len(templates) == 0 {
 		// Return a workload with no queries, but not an error.
 		return &models.BenchmarkWorkload{}, nil
 	}
-
-	// 2. Build the statistical model for parameters.
-	paramModels := s.analyzer.Analyze(req.SourceTraces)
-	workloadModel := &models.WorkloadParameterModel{
-		TemplateParameters: make(map[string]map[string]*models.ParameterModel),
-	}
-
-	// This is a simplified mapping. A more complex logic might be needed
-	// if parameters are shared across different template groups.
-	for _, tmpl := range templates {
-		if _, ok := workloadModel.TemplateParameters[tmpl.GroupKey]; !ok {
-			workloadModel.TemplateParameters[tmpl.GroupKey] = make(map[string]*models.ParameterModel)
-		}
-		for _, paramName := range tmpl.Parameters {
-			if model, exists := paramModels[paramName]; exists {
-				workloadModel.TemplateParameters[tmpl.GroupKey][paramName] = model
-			}
-		}
+	workloadModel := model.Parameters
+	if workloadModel == nil {
+		workloadModel = models.NewWorkloadParameterModel()
 	}
 
 	// 3. Synthesize the new workload.
 	synth := services.NewSynthesizer(workloadModel)
+	if !req.ReferenceTime.IsZero() {
+		synth.SetReferenceTime(req.ReferenceTime)
+	}
+	if req.KeySource != nil {
+		pools, err := services.NewKeySampler(req.KeySource, req.KeyCandidates).Pools(ctx, templates, workloadModel)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sample keys from the target database: %w", err)
+		}
+		synth.UseKeyPools(pools)
+	}
 	workload := &models.BenchmarkWorkload{
 		Queries: make([]models.QueryWithArgs, 0, req.Count),
 	}
 
-	// 4. Generate the requested number of queries.
-	if len(templates) > 0 && sumWeights(templates) > 0 {
+	// render fills a template's parameters with values sampled from the statistical
+	// model and binds them to its query.
+	render := func(template *models.SQLTemplate) (models.QueryWithArgs, bool) {
+		args, err := synth.FillParameters(template)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "warning: could not fill parameters for template %s: %v\n", template.GroupKey, err)
+			return models.QueryWithArgs{}, false
+		}
+
+		params := make(map[string]interface{}, len(args))
+		for j, name := range template.Parameters {
+			params[name] = args[j]
+		}
+		query, err := template.GenerateQuery(params, req.Dialect)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "warning: could not render template %s: %v\n", template.GroupKey, err)
+			return models.QueryWithArgs{}, false
+		}
+		query.Database = pickWeighted(template.Databases)
+		query.User = pickWeighted(template.Users)
+		return query, true
+	}
+
+	// 4. Generate the requested number of queries, as sessions that follow the learned
+	// flows between templates if there is a session model.
+	if workloadModel.Sessions != nil {
+		byKey := make(map[string]*models.SQLTemplate, len(templates))
+		for i := range templates {
+			byKey[templates[i].GroupKey] = &templates[i]
+		}
+		sessions := services.NewSessionSampler(workloadModel.Sessions, rand.New(rand.NewSource(rand.Int63())))
+		// As when templates are picked independently, a query that cannot be rendered
+		// is left out rather than retried.
+		attempts := 0
+		for n := 1; attempts < req.Count; n++ {
+			sessionID := fmt.Sprintf("session-%d", n)
+			var history []string
+			for ; attempts < req.Count; attempts++ {
+				key, think := sessions.Next(history)
+				template, ok := byKey[key]
+				if !ok {
+					break
+				}
+				history = append(history, key)
+				if query, ok := render(template); ok {
+					query.SessionID = sessionID
+					query.ThinkTime = think
+					workload.Queries = append(workload.Queries, query)
+				}
+			}
+			if len(history) == 0 {
+				// The model starts no session with a known template.
+				break
+			}
+		}
+	} else if len(templates) > 0 && sumWeights(templates) > 0 {
 		totalWeight := sumWeights(templates)
 
 		for i := 0; i < req.Count; i++ {
@@ -96,23 +205,92 @@ func (s *DefaultService) GenerateWorkload(ctx context.Context, req GenerateReque
 				continue
 			}
 
-			// Fill the template's parameters with values sampled from the statistical model.
-			args, err := synth.FillParameters(template)
-			if err != nil {
-				fmt.Fprintf(os.Stderr, "warning: could not fill parameters for template %s: %v\n", template.GroupKey, err)
-				continue
+			// Append the synthesized query to the workload.
+			if query, ok := render(template); ok {
+				workload.Queries = append(workload.Queries, query)
 			}
+		}
+	}
 
-			// Append the synthesized query to the workload.
-			workload.Queries = append(workload.Queries, models.QueryWithArgs{
-				Query: template.RawSQL,
-				Args:  args,
-			})
+	// 5. Spread the queries over time as the source traces were.
+	if req.Temporal.Enabled {
+		if workloadModel.Temporal == nil {
+			fmt.Fprintf(os.Stderr, "warning: no temporal pattern of the source traces (they need timestamps); queries are not scheduled\n")
+		} else {
+			scheduleQueries(workload, workloadModel.Temporal, req.Temporal.Duration)
 		}
 	}
 	return workload, nil
 }
 
+// scheduleQueries sets the time to issue each query at, drawn from a temporal pattern
+// and scaled so that the pattern spans duration, or kept at its own span if duration
+// is zero. A session starts at a drawn time and issues each further query after its
+// think time. The queries are then ordered by time, keeping each session in order.
+func scheduleQueries(workload *models.BenchmarkWorkload, pattern *models.TemporalPattern, duration time.Duration) {
+	span := pattern.Span()
+	if span <= 0 {
+		return
+	}
+	if duration <= 0 {
+		duration = span
+	}
+	sampler := samplers.NewTemporalSampler(pattern, time.Time{})
+	draw := func() time.Duration {
+		offset := sampler.SampleTimestamp().Sub(time.Time{})
+		return time.Duration(float64(offset) * float64(duration) / float64(span))
+	}
+
+	// Independent queries take the drawn times in order, as sessions take their
+	// start times.
+	starts := make([]time.Duration, 0, len(workload.Queries))
+	for i, q := range workload.Queries {
+		if q.SessionID == "" || i == 0 || workload.Queries[i-1].SessionID != q.SessionID {
+			starts = append(starts, draw())
+		}
+	}
+	sort.Slice(starts, func(i, j int) bool { return starts[i] < starts[j] })
+
+	next := 0
+	for i := range workload.Queries {
+		q := &workload.Queries[i]
+		if q.SessionID != "" && i > 0 && workload.Queries[i-1].SessionID == q.SessionID {
+			q.IssueAt = workload.Queries[i-1].IssueAt + q.ThinkTime
+			continue
+		}
+		q.IssueAt = starts[next]
+		next++
+	}
+	sort.SliceStable(workload.Queries, func(i, j int) bool { return workload.Queries[i].IssueAt < workload.Queries[j].IssueAt })
+	workload.Duration = duration
+}
+
+
+// pickWeighted draws a key with probability proportional to its count.
+// Keys are visited in sorted order so that a seeded generator is reproducible.
+func pickWeighted(counts map[string]int) string {
+	if len(counts) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(counts))
+	total := 0
+	for k, c := range counts {
+		keys = append(keys, k)
+		total += c
+	}
+	sort.Strings(keys)
+	if total <= 0 {
+		return keys[0]
+	}
+	r := rand.Intn(total)
+	for _, k := range keys {
+		r -= counts[k]
+		if r < 0 {
+			return k
+		}
+	}
+	return keys[len(keys)-1]
+}
 
 func sumWeights(templates []models.SQLTemplate) int {
 	totalWeight := 0
@@ -2,11 +2,17 @@ package generation
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+	"github.com/turtacn/SQLTraceBench/internal/domain/services"
+	"github.com/turtacn/SQLTraceBench/pkg/types"
 )
 
 func TestDefaultService_GenerateWorkload_Success(t *testing.T) {
@@ -37,6 +43,77 @@ func TestDefaultService_GenerateWorkload_Success(t *testing.T) {
 	assert.Len(t, workload.Queries, 10)
 }
 
+func TestDefaultService_GenerateWorkload_Dialect(t *testing.T) {
+	service := NewService()
+
+	req := GenerateRequest{
+		Count:   5,
+		Dialect: types.DatabasePostgreSQL,
+		SourceTraces: []models.SQLTrace{
+			{Query: "SELECT * FROM orders WHERE region = 'eu' AND id IN (1, 2) AND total > 10"},
+			{Query: "SELECT * FROM orders WHERE region = 'us' AND id IN (3, 4) AND total > 20"},
+		},
+	}
+
+	workload, err := service.GenerateWorkload(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, workload.Queries, 5)
+	for _, q := range workload.Queries {
+		// The parameter model samples single IN-list elements.
+		assert.Equal(t, "select * from orders where region = $1 and id in ($2) and total > $3", q.Query)
+		assert.Len(t, q.Args, 3)
+	}
+}
+
+func TestDefaultService_GenerateWorkload_SavedModel(t *testing.T) {
+	service := NewService()
+	ctx := context.Background()
+
+	model, err := service.BuildModel(ctx, GenerateRequest{SourceTraces: []models.SQLTrace{
+		{Query: "SELECT * FROM orders WHERE id = 7"},
+		{Query: "SELECT * FROM orders WHERE id = 7"},
+	}})
+	require.NoError(t, err)
+	require.Len(t, model.Templates, 1)
+	assert.Contains(t, model.Parameters.TemplateParameters, model.Templates[0].GroupKey)
+	assert.Equal(t, types.QuerySelect, model.Templates[0].QueryType)
+	assert.Equal(t, []string{"orders"}, model.Templates[0].Tables)
+
+	// The model replaces the source traces.
+	workload, err := service.GenerateWorkload(ctx, GenerateRequest{Count: 3, Model: model})
+	require.NoError(t, err)
+	require.Len(t, workload.Queries, 3)
+	for _, q := range workload.Queries {
+		assert.Equal(t, "select * from orders where id = ?", q.Query)
+		assert.Equal(t, []interface{}{int64(7)}, q.Args)
+	}
+}
+
+func TestDefaultService_GenerateWorkload_ReferenceTime(t *testing.T) {
+	// Traces captured last month look back one day from their own timestamps.
+	captured := time.Date(2025, 5, 10, 12, 0, 0, 0, time.UTC)
+	var traces []models.SQLTrace
+	for i := 0; i < 5; i++ {
+		ts := captured.Add(time.Duration(i) * time.Hour)
+		traces = append(traces, models.SQLTrace{
+			Query:     "SELECT * FROM events WHERE created_at >= '" + ts.Add(-24*time.Hour).Format("2006-01-02 15:04:05") + "'",
+			Timestamp: ts,
+		})
+	}
+	ref := time.Date(2025, 6, 20, 8, 30, 0, 0, time.UTC)
+
+	workload, err := NewService().GenerateWorkload(context.Background(), GenerateRequest{
+		SourceTraces:  traces,
+		Count:         5,
+		ReferenceTime: ref,
+	})
+	require.NoError(t, err)
+	require.Len(t, workload.Queries, 5)
+	for _, q := range workload.Queries {
+		assert.Equal(t, []interface{}{"2025-06-19 08:30:00"}, q.Args, "the last 24 hours before the reference time")
+	}
+}
+
 func TestDefaultService_GenerateWorkload_NoTraces(t *testing.T) {
 	// Create the service.
 	service := NewService()
@@ -76,3 +153,282 @@ func TestDefaultService_GenerateWorkload_NoTemplatesExtracted(t *testing.T) {
 	assert.NotNil(t, workload)
 	assert.Len(t, workload.Queries, 10) // Expect 10 queries, even if no parameters are extracted
 }
+
+func TestDefaultService_GenerateWorkload_SessionContext(t *testing.T) {
+	service := NewService()
+
+	req := GenerateRequest{
+		Count: 20,
+		SourceTraces: []models.SQLTrace{
+			{Query: "SELECT 1", Database: "shop", User: "app"},
+			{Query: "SELECT 1", Database: "shop", User: "app"},
+		},
+	}
+
+	workload, err := service.GenerateWorkload(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, workload.Queries, 20)
+	for _, q := range workload.Queries {
+		assert.Equal(t, "shop", q.Database)
+		assert.Equal(t, "app", q.User)
+	}
+}
+
+func TestDefaultService_GenerateWorkload_Sessions(t *testing.T) {
+	// Every session logs in, lists its cart and checks out, a second after each query.
+	start := time.Date(2025, 5, 10, 12, 0, 0, 0, time.UTC)
+	var traces []models.SQLTrace
+	for i := 0; i < 10; i++ {
+		ts := start.Add(time.Duration(i) * time.Minute)
+		for j, q := range []string{
+			"SELECT * FROM users WHERE name = 'u%d'",
+			"SELECT * FROM cart WHERE user_id = %d",
+			"UPDATE cart SET status = 'paid' WHERE user_id = %d",
+		} {
+			traces = append(traces, models.SQLTrace{
+				Query:     fmt.Sprintf(q, i),
+				SessionID: fmt.Sprint(i),
+				Timestamp: ts.Add(time.Duration(j) * time.Second),
+			})
+		}
+	}
+
+	workload, err := NewService().GenerateWorkload(context.Background(), GenerateRequest{
+		SourceTraces: traces,
+		Count:        10,
+		Sessions:     services.SessionConfig{Enabled: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, workload.Queries, 10)
+
+	// Sessions are emitted one after another, each in the order of the flow; the last
+	// one is cut short at the requested count.
+	flow := []string{"select * from users", "select * from cart", "update cart"}
+	for i, q := range workload.Queries {
+		assert.Equal(t, fmt.Sprintf("session-%d", i/3+1), q.SessionID)
+		assert.True(t, strings.HasPrefix(q.Query, flow[i%3]), q.Query)
+		if i%3 == 0 {
+			assert.Zero(t, q.ThinkTime)
+		} else {
+			assert.Equal(t, time.Second, q.ThinkTime)
+		}
+	}
+}
+
+// usersTable is a target database with a table of users.
+type usersTable []interface{}
+
+func (u usersTable) ListTables(ctx context.Context) ([]string, error) {
+	return []string{"users"}, nil
+}
+
+func (u usersTable) ListColumns(ctx context.Context, table string) ([]string, error) {
+	return []string{"id", "name"}, nil
+}
+
+func (u usersTable) SampleColumn(ctx context.Context, table, column string, limit int) ([]interface{}, error) {
+	return u, nil
+}
+
+func TestDefaultService_GenerateWorkload_KeySource(t *testing.T) {
+	// The traces look up users that are not in the benchmark database.
+	var traces []models.SQLTrace
+	for i := 0; i < 20; i++ {
+		traces = append(traces, models.SQLTrace{Query: fmt.Sprintf("SELECT * FROM users WHERE id = %d", 1000+i%4)})
+	}
+
+	workload, err := NewService().GenerateWorkload(context.Background(), GenerateRequest{
+		SourceTraces: traces,
+		Count:        50,
+		KeySource:    usersTable{int64(1), int64(2), int64(3), int64(4), int64(5)},
+	})
+	require.NoError(t, err)
+	require.Len(t, workload.Queries, 50)
+	for _, q := range workload.Queries {
+		require.Len(t, q.Args, 1)
+		assert.Contains(t, []interface{}{int64(1), int64(2), int64(3), int64(4), int64(5)}, q.Args[0])
+	}
+}
+
+func TestDefaultService_GenerateWorkload_Temporal(t *testing.T) {
+	// Nine in ten queries arrive in the first hour, none in the second and the rest in
+	// the third.
+	start := time.Date(2025, 5, 10, 0, 0, 0, 0, time.UTC)
+	var traces []models.SQLTrace
+	for i := 0; i < 100; i++ {
+		ts := start.Add(time.Duration(i) * 30 * time.Second)
+		if i >= 90 {
+			ts = start.Add(2*time.Hour + time.Duration(i)*time.Second)
+		}
+		traces = append(traces, models.SQLTrace{Query: fmt.Sprintf("SELECT * FROM users WHERE id = %d", i), Timestamp: ts})
+	}
+
+	workload, err := NewService().GenerateWorkload(context.Background(), GenerateRequest{
+		SourceTraces: traces,
+		Count:        1000,
+		Temporal:     services.TemporalConfig{Enabled: true, Window: time.Hour, Duration: 30 * time.Minute},
+	})
+	require.NoError(t, err)
+	require.Len(t, workload.Queries, 1000)
+	assert.Equal(t, 30*time.Minute, workload.Duration)
+
+	// The three hours are scaled to ten minutes each.
+	peak := 0
+	for i, q := range workload.Queries {
+		if i > 0 {
+			assert.GreaterOrEqual(t, q.IssueAt, workload.Queries[i-1].IssueAt, "queries are ordered by time")
+		}
+		assert.Less(t, q.IssueAt, 30*time.Minute)
+		assert.False(t, q.IssueAt >= 10*time.Minute && q.IssueAt < 20*time.Minute, "nothing is issued in the quiet hour")
+		if q.IssueAt < 10*time.Minute {
+			peak++
+		}
+	}
+	assert.InDelta(t, 0.9, float64(peak)/1000, 0.04)
+}
+
+func TestDefaultService_GenerateWorkload_TemporalSavedModel(t *testing.T) {
+	// The rate over time is part of the model even when it is built without shaping.
+	start := time.Date(2025, 5, 10, 0, 0, 0, 0, time.UTC)
+	service := NewService()
+	model, err := service.BuildModel(context.Background(), GenerateRequest{SourceTraces: []models.SQLTrace{
+		{Query: "SELECT * FROM users WHERE id = 1", Timestamp: start},
+		{Query: "SELECT * FROM users WHERE id = 2", Timestamp: start.Add(90 * time.Minute)},
+	}})
+	require.NoError(t, err)
+	require.NotNil(t, model.Parameters.Temporal)
+	assert.Equal(t, map[int]int{0: 1, 1: 1}, model.Parameters.Temporal.BinCounts)
+
+	workload, err := service.GenerateWorkload(context.Background(), GenerateRequest{
+		Model:    model,
+		Count:    10,
+		Temporal: services.TemporalConfig{Enabled: true},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Hour, workload.Duration)
+}
+
+func TestDefaultService_GenerateWorkload_TemporalSessions(t *testing.T) {
+	start := time.Date(2025, 5, 10, 12, 0, 0, 0, time.UTC)
+	var traces []models.SQLTrace
+	for i := 0; i < 10; i++ {
+		ts := start.Add(time.Duration(i) * time.Minute)
+		traces = append(traces,
+			models.SQLTrace{Query: fmt.Sprintf("SELECT * FROM cart WHERE user_id = %d", i), SessionID: fmt.Sprint(i), Timestamp: ts},
+			models.SQLTrace{Query: fmt.Sprintf("UPDATE cart SET status = 'paid' WHERE user_id = %d", i), SessionID: fmt.Sprint(i), Timestamp: ts.Add(3 * time.Second)},
+		)
+	}
+
+	workload, err := NewService().GenerateWorkload(context.Background(), GenerateRequest{
+		SourceTraces: traces,
+		Count:        40,
+		Sessions:     services.SessionConfig{Enabled: true},
+		Temporal:     services.TemporalConfig{Enabled: true, Window: time.Minute, Duration: time.Hour},
+	})
+	require.NoError(t, err)
+	require.Len(t, workload.Queries, 40)
+
+	// A session checks out its cart three seconds after listing it, whenever it starts.
+	listed := make(map[string]time.Duration)
+	for _, q := range workload.Queries {
+		if strings.HasPrefix(q.Query, "select") {
+			listed[q.SessionID] = q.IssueAt
+			continue
+		}
+		require.Contains(t, listed, q.SessionID, "a session stays in order")
+		assert.Equal(t, listed[q.SessionID]+3*time.Second, q.IssueAt)
+	}
+	assert.Len(t, listed, 20)
+}
+
+func TestDefaultService_GenerateWorkload_TemporalWithoutTimestamps(t *testing.T) {
+	workload, err := NewService().GenerateWorkload(context.Background(), GenerateRequest{
+		SourceTraces: []models.SQLTrace{{Query: "SELECT * FROM users WHERE id = 1"}},
+		Count:        5,
+		Temporal:     services.TemporalConfig{Enabled: true},
+	})
+	require.NoError(t, err)
+	assert.Zero(t, workload.Duration)
+	for _, q := range workload.Queries {
+		assert.Zero(t, q.IssueAt)
+	}
+}
+
+func TestDefaultService_GenerateWorkload_TemporalModelWithoutParameters(t *testing.T) {
+	service := NewService()
+	built, err := service.BuildModel(context.Background(), GenerateRequest{SourceTraces: []models.SQLTrace{
+		{Query: "SELECT * FROM users"},
+	}})
+	require.NoError(t, err)
+
+	// A model without parameters has no temporal pattern, so the workload is not scheduled.
+	workload, err := service.GenerateWorkload(context.Background(), GenerateRequest{
+		Model:    &WorkloadModel{Templates: built.Templates, Parameters: nil},
+		Count:    5,
+		Temporal: services.TemporalConfig{Enabled: true},
+	})
+	require.NoError(t, err)
+	assert.Zero(t, workload.Duration)
+}
+
+func TestModelBuilder(t *testing.T) {
+	// Sessions run in parallel and arrive interleaved, in timestamp order.
+	start := time.Date(2025, 5, 10, 12, 0, 0, 0, time.UTC)
+	var traces []models.SQLTrace
+	for i := 0; i < 50; i++ {
+		ts := start.Add(time.Duration(2*i) * time.Minute)
+		traces = append(traces,
+			models.SQLTrace{Query: fmt.Sprintf("SELECT * FROM orders WHERE tenant = %d AND user_id = %d AND day BETWEEN %d AND %d", i%3, i%3*10, i, i+7), SessionID: fmt.Sprint(i), Timestamp: ts},
+			models.SQLTrace{Query: fmt.Sprintf("UPDATE cart SET status = 'paid' WHERE user_id = %d", i), SessionID: fmt.Sprint(i), Timestamp: ts.Add(90 * time.Second)},
+		)
+	}
+	sort.SliceStable(traces, func(i, j int) bool { return traces[i].Timestamp.Before(traces[j].Timestamp) })
+	req := GenerateRequest{Sessions: services.SessionConfig{Enabled: true}, Temporal: services.TemporalConfig{Window: time.Hour}}
+
+	service := NewService()
+	builder, err := service.NewModelBuilder(req)
+	require.NoError(t, err)
+	for _, trace := range traces {
+		builder.Add(trace)
+	}
+	streamed, err := builder.Model()
+	require.NoError(t, err)
+
+	// The stream is modeled as the whole trace is.
+	req.SourceTraces = traces
+	whole, err := service.BuildModel(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, whole.Templates, len(streamed.Templates))
+	for key, params := range whole.Parameters.TemplateParameters {
+		for name, model := range params {
+			assert.Equal(t, model.Cardinality, streamed.Parameters.TemplateParameters[key][name].Cardinality)
+		}
+	}
+	assert.Equal(t, whole.Parameters.TemplateRanges, streamed.Parameters.TemplateRanges)
+	assert.Equal(t, whole.Parameters.Sessions, streamed.Parameters.Sessions)
+	assert.Equal(t, whole.Parameters.Temporal, streamed.Parameters.Temporal)
+
+	key := "select * from orders where tenant = :p1 and user_id = :p2 and day between :p3 and :p4"
+	assert.NotNil(t, streamed.Parameters.TemplateJoints[key])
+	assert.Len(t, streamed.Parameters.TemplateRanges[key], 1)
+	assert.Equal(t, map[int]int{0: 60, 1: 40}, streamed.Parameters.Temporal.BinCounts)
+	require.NotNil(t, streamed.Parameters.Sessions)
+
+	_, err = NewService().NewModelBuilder(GenerateRequest{Sessions: services.SessionConfig{Enabled: true, Order: 9}})
+	assert.Error(t, err)
+	builder, err = service.NewModelBuilder(GenerateRequest{})
+	require.NoError(t, err)
+	_, err = builder.Model()
+	assert.Error(t, err, "a model needs traces")
+}
+
+func TestPickWeighted(t *testing.T) {
+	assert.Equal(t, "", pickWeighted(nil))
+	assert.Equal(t, "only", pickWeighted(map[string]int{"only": 3}))
+
+	seen := map[string]bool{}
+	for i := 0; i < 200; i++ {
+		seen[pickWeighted(map[string]int{"a": 1, "b": 1})] = true
+	}
+	assert.True(t, seen["a"] && seen["b"])
+}
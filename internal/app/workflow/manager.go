@@ -13,9 +13,10 @@ import (
 	"github.com/turtacn/SQLTraceBench/internal/app/validation"
 	"github.com/turtacn/SQLTraceBench/internal/domain/models"
 	"github.com/turtacn/SQLTraceBench/internal/infrastructure/reporters"
-	"github.com/turtacn/SQLTraceBench/internal/utils"
 	"github.com/turtacn/SQLTraceBench/internal/utils/progress"
 	"github.com/turtacn/SQLTraceBench/internal/utils/terminal"
+	"github.com/turtacn/SQLTraceBench/pkg/types"
+	"github.com/turtacn/SQLTraceBench/pkg/utils"
 )
 
 // Manager coordinates the workflow pipeline.
@@ -60,8 +61,12 @@ func (m *Manager) Run(ctx context.Context, cfg WorkflowConfig) error {
 
 	// 1.1 Trace Conversion
 	traceReq := conversion.ConvertTraceRequest{
-		SourcePath:   cfg.InputTracePath,
-		TargetDBType: cfg.TargetPlugin,
+		SourcePath:    cfg.InputTracePath,
+		TargetDBType:  cfg.TargetPlugin,
+		Format:        cfg.InputTraceFormat,
+		Selection:     cfg.TraceSelection,
+		Anonymization: &cfg.Anonymization,
+		Clustering:    &cfg.Generation.Clustering,
 	}
 
 	// Simulation of progress for conversion (since streaming isn't fully exposed with progress callback yet)
@@ -79,6 +84,13 @@ func (m *Manager) Run(ctx context.Context, cfg WorkflowConfig) error {
 	}
 	p1Bar.Increment(20)
 
+	if convRes.Clusters != nil {
+		clusterReportPath := filepath.Join(cfg.OutputDir, "converted", "clusters.json")
+		if err := saveJSON(clusterReportPath, convRes.Clusters); err != nil {
+			return fmt.Errorf("failed to save cluster report: %w", err)
+		}
+	}
+
 	// 1.2 Schema Conversion (if schema path provided)
 	if cfg.InputSchemaPath != "" {
 		schemaOutPath := filepath.Join(cfg.OutputDir, "converted", "schema.sql")
@@ -107,6 +119,8 @@ func (m *Manager) Run(ctx context.Context, cfg WorkflowConfig) error {
 	// Update Generation Request with converted traces
 	genReq := cfg.Generation
 	genReq.SourceTraces = convRes.Traces
+	genReq.Dialect = types.DatabaseTypeFromString(cfg.TargetPlugin)
+	genReq.SourceDialect = convRes.Dialect
 
 	// TODO: Add progress callback to generation service if possible, currently we wait
 	workload, err := m.generationSvc.GenerateWorkload(ctx, genReq)
@@ -180,7 +194,13 @@ func (m *Manager) Run(ctx context.Context, cfg WorkflowConfig) error {
 				m.logger.Error("Failed to initialize HTML reporter", utils.Field{Key: "error", Value: err})
 			} else {
 				htmlPath := filepath.Join(cfg.OutputDir, "validation_report.html")
-				if err := htmlReporter.GenerateReport(report, cfg.TargetPlugin, htmlPath); err != nil {
+				validationResult := &models.ValidationResult{
+					BaseMetrics:      baseline.ToPerformanceMetrics(),
+					CandidateMetrics: result.ToPerformanceMetrics(),
+					Pass:             report.Status == "PASS",
+					Reason:           report.Status,
+				}
+				if err := htmlReporter.GenerateReport(validationResult, cfg.TargetPlugin, htmlPath); err != nil {
 					m.logger.Error("Failed to generate HTML report", utils.Field{Key: "error", Value: err})
 				} else {
 					fmt.Println(terminal.Success(fmt.Sprintf("HTML Report generated: %s", htmlPath)))
@@ -193,13 +213,13 @@ func (m *Manager) Run(ctx context.Context, cfg WorkflowConfig) error {
 				return fmt.Errorf("failed to save validation report: %w", err)
 			}
 
-			statusMsg := fmt.Sprintf("Phase 4: Validation complete. Status: %s", utils.SafeString(report.Pass))
-			if report.Pass {
+			statusMsg := fmt.Sprintf("Phase 4: Validation complete. Status: %s", report.Status)
+			if report.Status == "PASS" {
 				fmt.Println(terminal.Success(statusMsg))
 			} else {
 				fmt.Println(terminal.Error(statusMsg))
 			}
-			m.logger.Info("Phase 4: Validation complete", utils.Field{Key: "status", Value: report.Pass})
+			m.logger.Info("Phase 4: Validation complete", utils.Field{Key: "status", Value: report.Status})
 		}
 	} else {
 		m.logger.Info("Phase 4: Validation skipped (no baseline provided)")
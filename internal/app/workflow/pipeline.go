@@ -1,16 +1,24 @@
 package workflow
 
 import (
+	"github.com/turtacn/SQLTraceBench/internal/app/conversion"
 	"github.com/turtacn/SQLTraceBench/internal/app/execution"
 	"github.com/turtacn/SQLTraceBench/internal/app/generation"
+	"github.com/turtacn/SQLTraceBench/internal/domain/services"
 )
 
 // WorkflowConfig defines the configuration for the full pipeline.
 type WorkflowConfig struct {
 	// Paths
-	InputTracePath  string `yaml:"input_trace_path"`
-	InputSchemaPath string `yaml:"input_schema_path"`
-	OutputDir       string `yaml:"output_dir"`
+	InputTracePath   string `yaml:"input_trace_path"`
+	InputTraceFormat string `yaml:"input_trace_format"` // Optional, detected from the file content if empty
+	InputSchemaPath  string `yaml:"input_schema_path"`
+	OutputDir        string `yaml:"output_dir"`
+
+	// TraceSelection filters and samples the input traces before templating.
+	TraceSelection conversion.TraceSelection `yaml:"trace_selection"`
+	// Anonymization replaces PII in the input traces, and so in everything derived from them.
+	Anonymization services.AnonymizerConfig `yaml:"anonymization"`
 
 	// Settings
 	TargetPlugin string `yaml:"target_plugin"`
@@ -10,6 +10,9 @@ type SchemaConverter interface {
 	// ConvertDDL converts DDL statements to the target database format.
 	ConvertDDL(sourceDDL string, targetDB string) (string, error)
 
+	// ParseDDL parses the CREATE TABLE statements of the DDL into table structures.
+	ParseDDL(sourceDDL string) ([]*models.TableSchema, error)
+
 	// ConvertTable converts a single table structure.
 	ConvertTable(sourceTable *models.TableSchema, targetDB string) (*models.TableSchema, error)
 
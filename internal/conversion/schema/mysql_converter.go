@@ -111,6 +111,31 @@ func (c *MySQLConverter) ConvertDDL(sourceDDL string, targetDB string) (string,
 	return sb.String(), nil
 }
 
+// ParseDDL parses the CREATE TABLE statements of MySQL DDL into table structures.
+// Other statements are skipped.
+func (c *MySQLConverter) ParseDDL(sourceDDL string) ([]*models.TableSchema, error) {
+	var tables []*models.TableSchema
+	for _, stmtStr := range strings.Split(sourceDDL, ";") {
+		stmtStr = strings.TrimSpace(stmtStr)
+		if !strings.HasPrefix(strings.ToUpper(stmtStr), "CREATE TABLE") {
+			continue
+		}
+
+		var table *models.TableSchema
+		stmt, err := sqlparser.Parse(stmtStr)
+		if ddl, ok := stmt.(*sqlparser.DDL); err == nil && ok && ddl.TableSpec != nil {
+			table, err = c.parseTableSpec(ddl.NewName.Name.String(), ddl.TableSpec)
+		} else {
+			table, err = c.fallbackParseCreateTable(stmtStr)
+		}
+		if err != nil {
+			return nil, err
+		}
+		tables = append(tables, table)
+	}
+	return tables, nil
+}
+
 func (c *MySQLConverter) fallbackParseCreateTable(sql string) (*models.TableSchema, error) {
 	sql = strings.TrimSpace(sql)
 	parts := strings.SplitN(sql, "(", 2)
@@ -68,6 +68,24 @@ func (c *PostgresConverter) ConvertDDL(sourceDDL string, targetDB string) (strin
 	return sb.String(), nil
 }
 
+// ParseDDL parses the CREATE TABLE statements of Postgres DDL into table structures.
+// Other statements are skipped.
+func (c *PostgresConverter) ParseDDL(sourceDDL string) ([]*models.TableSchema, error) {
+	var tables []*models.TableSchema
+	for _, stmtStr := range strings.Split(sourceDDL, ";") {
+		stmtStr = strings.TrimSpace(stmtStr)
+		if !strings.HasPrefix(strings.ToUpper(stmtStr), "CREATE TABLE") {
+			continue
+		}
+		table, err := c.parseCreateTable(stmtStr)
+		if err != nil {
+			return nil, err
+		}
+		tables = append(tables, table)
+	}
+	return tables, nil
+}
+
 func (c *PostgresConverter) parseCreateTable(sql string) (*models.TableSchema, error) {
 	reName := regexp.MustCompile(`(?i)CREATE\s+TABLE\s+(?:IF\s+NOT\s+EXISTS\s+)?["']?(\w+)["']?`)
 	matches := reName.FindStringSubmatch(sql)
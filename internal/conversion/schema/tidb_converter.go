@@ -25,6 +25,11 @@ func (c *TiDBConverter) ConvertDDL(sourceDDL string, targetDB string) (string, e
 	return c.mysqlConverter.ConvertDDL(cleanDDL, targetDB)
 }
 
+// ParseDDL parses the CREATE TABLE statements of TiDB DDL into table structures.
+func (c *TiDBConverter) ParseDDL(sourceDDL string) ([]*models.TableSchema, error) {
+	return c.mysqlConverter.ParseDDL(c.preprocessTiDBDDL(sourceDDL))
+}
+
 func (c *TiDBConverter) preprocessTiDBDDL(ddl string) string {
 	lines := strings.Split(ddl, "\n")
 	var sb strings.Builder
@@ -27,6 +27,7 @@ type Job struct {
 // This is a simplified version of the main application config.
 type Config struct {
 	TracePath       string `json:"trace_path"`
+	TraceFormat     string `json:"trace_format,omitempty"` // Optional, detected from the file content if empty
 	SchemaPath      string `json:"schema_path"`
 	WorkloadPath    string `json:"workload_path"`
 	BaseMetricsPath string `json:"base_metrics_path"`
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/turtacn/SQLTraceBench/pkg/types"
 )
 
 func TestPerformanceMetrics_QPS(t *testing.T) {
@@ -50,7 +51,7 @@ func TestSQLTemplate_GenerateQuery(t *testing.T) {
 		":id":   1,
 		":name": "test",
 	}
-	q, err := tpl.GenerateQuery(params)
+	q, err := tpl.GenerateQuery(params, types.DatabaseMySQL)
 	assert.NoError(t, err)
 	assert.Equal(t, "SELECT * FROM users WHERE id = ? AND name = ?", q.Query)
 	assert.Equal(t, []interface{}{1, "test"}, q.Args)
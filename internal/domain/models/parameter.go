@@ -8,6 +8,9 @@ const (
 	DistZipfian DistributionType = "zipfian"
 	DistNormal  DistributionType = "normal"
 	DistEmpirical DistributionType = "empirical" // Fallback for arbitrary distribution
+	DistLogNormal DistributionType = "lognormal"
+	DistExponential DistributionType = "exponential"
+	DistHistogram DistributionType = "histogram"
 )
 
 // ParameterModel holds the detailed statistical model for a single parameter.
@@ -28,6 +31,74 @@ type ParameterModel struct {
 	// We store TopValues and TopFrequencies to support empirical sampling of hotspots.
 	TopValues      []interface{} `json:"top_values"`
 	TopFrequencies []int         `json:"top_frequencies"`
+
+	// Relative is set for datetime parameters modeled as offsets, in seconds, from the
+	// timestamps of their traces: TopValues and Continuous then describe the offsets,
+	// which generation adds to a reference time. TimeLayout is the layout of the values
+	// if they are strings.
+	Relative   bool   `json:"relative,omitempty"`
+	TimeLayout string `json:"time_layout,omitempty"`
+
+	// Continuous, when set, is the distribution fitted to a numeric or datetime
+	// parameter whose values rarely repeat; values are then drawn from it rather than
+	// from TopValues.
+	Continuous *ContinuousModel `json:"continuous,omitempty"`
+
+	// Strings, when set, holds the patterns of a high-cardinality string parameter, such
+	// as order numbers or SKUs, from which values that were not observed are synthesized.
+	Strings *StringModel `json:"strings,omitempty"`
+}
+
+// StringModel describes the shapes of the values of a string parameter.
+type StringModel struct {
+	// FreshRatio is the share of values to synthesize rather than replay from
+	// TopValues, estimated from the share of observations of values seen only once.
+	FreshRatio float64         `json:"fresh_ratio"`
+	Patterns   []StringPattern `json:"patterns"`
+}
+
+// StringPattern is a sequence of segments, such as a prefix, a separator and a run of
+// digits. Weight is the number of observed values of the pattern.
+type StringPattern struct {
+	Segments []StringSegment `json:"segments"`
+	Weight   int             `json:"weight"`
+}
+
+// StringSegment is a part of a StringPattern. A segment with few distinct texts, like a
+// prefix or separator, picks one of Choices; any other is a run of characters from
+// Alphabet whose length is drawn from Lengths.
+type StringSegment struct {
+	Choices     []string `json:"choices,omitempty"`
+	ChoiceFreqs []int    `json:"choice_freqs,omitempty"`
+	Alphabet    string   `json:"alphabet,omitempty"`
+	Lengths     []int    `json:"lengths,omitempty"`
+	LengthFreqs []int    `json:"length_freqs,omitempty"`
+}
+
+// ContinuousModel is a continuous distribution fitted to the values of a parameter.
+// DistType of the parameter model names the family: DistNormal, DistLogNormal,
+// DistExponential, DistUniform or DistHistogram. Times are fitted as Unix seconds.
+type ContinuousModel struct {
+	// ValueKind is the kind of the generated values: "int", "float" or "time".
+	ValueKind string `json:"value_kind"`
+	// Layout is the layout of times given as strings.
+	Layout string `json:"layout,omitempty"`
+	// Min and Max bound the observed values; generated values stay inside them.
+	Min float64 `json:"min"`
+	Max float64 `json:"max"`
+	// Mu and Sigma are the mean and standard deviation of a normal distribution, or
+	// of the logarithm of a lognormal one.
+	Mu    float64 `json:"mu,omitempty"`
+	Sigma float64 `json:"sigma,omitempty"`
+	// Rate is the rate of an exponential distribution starting at Min.
+	Rate float64 `json:"rate,omitempty"`
+	// BinEdges and BinCounts describe a histogram of equal-width bins.
+	BinEdges  []float64 `json:"bin_edges,omitempty"`
+	BinCounts []int     `json:"bin_counts,omitempty"`
+	// AIC and KS are the Akaike information criterion and Kolmogorov-Smirnov
+	// statistic of the fit.
+	AIC float64 `json:"aic"`
+	KS  float64 `json:"ks"`
 }
 
 // WorkloadParameterModel holds the statistical model of parameters for a set of SQL templates.
@@ -36,15 +107,82 @@ type WorkloadParameterModel struct {
 	// TemplateParameters maps a template's GroupKey to a map of its parameters.
 	// Each parameter is then mapped to its parameter model.
 	TemplateParameters map[string]map[string]*ParameterModel
+	// TemplateJoints maps a template's GroupKey to the joint distribution of its
+	// parameters, for templates with more than one parameter.
+	TemplateJoints map[string]*JointParameterModel
+	// TemplateRanges maps a template's GroupKey to the models of its range predicates.
+	TemplateRanges map[string][]*RangeParameterModel
+	// Sessions, when set, models the order of the templates within sessions.
+	Sessions *SessionModel
+	// Temporal, when set, is the rate of the source traces over time.
+	Temporal *TemporalPattern
 }
 
 // NewWorkloadParameterModel creates an empty workload parameter model.
 func NewWorkloadParameterModel() *WorkloadParameterModel {
 	return &WorkloadParameterModel{
 		TemplateParameters: make(map[string]map[string]*ParameterModel),
+		TemplateJoints:     make(map[string]*JointParameterModel),
+		TemplateRanges:     make(map[string][]*RangeParameterModel),
 	}
 }
 
+// JointParameterModel holds the joint distribution of the parameters of a template, so
+// that values observed together, like a tenant and one of its users, are generated
+// together rather than paired at random.
+//
+// The most frequent combinations of values are kept as tuples. The other observations
+// are modeled by the distribution of each parameter conditioned on the value of the
+// anchor, the parameter with the most distinct values. Observations covered by neither
+// are left to the independent per-parameter models.
+type JointParameterModel struct {
+	// Params names the parameters in the order of the tuple values.
+	Params           []string        `json:"params"`
+	Tuples           [][]interface{} `json:"tuples"`
+	TupleFrequencies []int           `json:"tuple_frequencies"`
+	// Anchor is the index in Params of the parameter the conditionals are keyed by.
+	Anchor       int                       `json:"anchor"`
+	Conditionals []ConditionalDistribution `json:"conditionals,omitempty"`
+	// Total is the number of observations, including those the tuples and
+	// conditionals do not cover.
+	Total int `json:"total"`
+}
+
+// ConditionalDistribution holds the values of the parameters observed with one value
+// of the anchor parameter.
+type ConditionalDistribution struct {
+	Given interface{} `json:"given"`
+	// Count is the number of observations of Given outside the tuples.
+	Count int `json:"count"`
+	// Values and Frequencies are indexed like JointParameterModel.Params; the entries of
+	// the anchor are empty.
+	Values      [][]interface{} `json:"values"`
+	Frequencies [][]int         `json:"frequencies"`
+}
+
+// RangeKind is the type of the bounds of a range predicate.
+type RangeKind string
+
+const (
+	RangeInt   RangeKind = "int"
+	RangeFloat RangeKind = "float"
+	RangeTime  RangeKind = "time" // Widths are in seconds
+)
+
+// RangeParameterModel models a pair of parameters bounding a column, as in
+// "col BETWEEN :a AND :b" or "col >= :lo AND col < :hi". The lower bound is sampled
+// from its own model and the upper bound is the lower plus a width learned from the
+// traces, so that generated ranges are never inverted and select about as many rows
+// as the traced ones.
+type RangeParameterModel struct {
+	Column string    `json:"column"`
+	Lower  string    `json:"lower"`
+	Upper  string    `json:"upper"`
+	Kind   RangeKind `json:"kind"`
+	// Width models the difference of the bounds.
+	Width *ParameterModel `json:"width"`
+}
+
 // ValueDistribution holds the observed values and their frequencies for a single parameter.
 // Deprecated: This struct is kept for temporary compatibility but should be replaced by ParameterModel.
 // Or we use this to accumulate data before converting to ParameterModel.
@@ -0,0 +1,164 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/turtacn/SQLTraceBench/pkg/types"
+)
+
+// placeholder is a named parameter (":name") found in a SQL query.
+type placeholder struct {
+	start, end int
+	name       string
+}
+
+// findPlaceholders returns the named parameters of a query in SQL order. String
+// literals, quoted identifiers, comments and "::" casts are skipped.
+func findPlaceholders(sql string) []placeholder {
+	var found []placeholder
+	for i := 0; i < len(sql); {
+		c := sql[i]
+		switch {
+		case c == '\'' || c == '"' || c == '`':
+			i = skipQuoted(sql, i)
+		case c == '-' && strings.HasPrefix(sql[i:], "--"), c == '#' && !strings.HasPrefix(sql[i:], "#>"):
+			if end := strings.IndexByte(sql[i:], '\n'); end >= 0 {
+				i += end + 1
+			} else {
+				i = len(sql)
+			}
+		case c == '/' && strings.HasPrefix(sql[i:], "/*"):
+			if end := strings.Index(sql[i+2:], "*/"); end >= 0 {
+				i += end + 4
+			} else {
+				i = len(sql)
+			}
+		case c == ':' && i+1 < len(sql) && sql[i+1] == ':':
+			i += 2
+		case c == ':' && i+1 < len(sql) && isParamStart(sql[i+1]):
+			end := i + 2
+			for end < len(sql) && isParamByte(sql[end]) {
+				end++
+			}
+			found = append(found, placeholder{start: i, end: end, name: sql[i:end]})
+			i = end
+		default:
+			i++
+		}
+	}
+	return found
+}
+
+// skipQuoted returns the index after the quoted section starting at i. A doubled
+// quote or a backslash escapes the quote character.
+func skipQuoted(sql string, i int) int {
+	quote := sql[i]
+	for j := i + 1; j < len(sql); j++ {
+		switch sql[j] {
+		case '\\':
+			j++
+		case quote:
+			if j+1 < len(sql) && sql[j+1] == quote {
+				j++
+				continue
+			}
+			return j + 1
+		}
+	}
+	return len(sql)
+}
+
+func isParamStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isParamByte(c byte) bool {
+	return isParamStart(c) || (c >= '0' && c <= '9')
+}
+
+// placeholderStyle renders the bound placeholders of a dialect.
+type placeholderStyle int
+
+const (
+	styleQuestion placeholderStyle = iota // ?
+	styleDollar                           // $1, $2, ...
+	styleNamed                            // {name:Type}
+)
+
+func placeholderStyleOf(dialect types.DatabaseType) placeholderStyle {
+	switch dialect {
+	case types.DatabasePostgreSQL:
+		return styleDollar
+	case types.DatabaseClickHouse:
+		return styleNamed
+	}
+	return styleQuestion
+}
+
+// clickHouseType returns the ClickHouse type of a query parameter value.
+func clickHouseType(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "Nullable(String)"
+	case bool:
+		return "Bool"
+	case int, int8, int16, int32, int64:
+		return "Int64"
+	case uint, uint8, uint16, uint32, uint64:
+		return "UInt64"
+	case float32, float64:
+		return "Float64"
+	case time.Time:
+		return "DateTime64(6)"
+	}
+	return "String"
+}
+
+// clickHouseParameter returns the text a query parameter value is sent to ClickHouse
+// as, which the server parses as the type given by clickHouseType.
+func clickHouseParameter(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return `\N`
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case time.Time:
+		return v.Format("2006-01-02 15:04:05.000000")
+	}
+	return fmt.Sprint(v)
+}
+
+// binder accumulates the placeholders and arguments of a rendered query.
+type binder struct {
+	style placeholderStyle
+	args  []interface{}
+	names []string
+	bound map[string]string
+}
+
+// bind returns the placeholder text for a value. For the numbered and named styles a
+// name that was bound before reuses its placeholder.
+func (b *binder) bind(name string, v interface{}) string {
+	if b.style == styleQuestion {
+		b.args = append(b.args, v)
+		return "?"
+	}
+	if text, ok := b.bound[name]; ok {
+		return text
+	}
+	var text string
+	if b.style == styleDollar {
+		text = "$" + strconv.Itoa(len(b.args)+1)
+	} else {
+		text = fmt.Sprintf("{%s:%s}", name, clickHouseType(v))
+		b.names = append(b.names, name)
+	}
+	b.args = append(b.args, v)
+	b.bound[name] = text
+	return text
+}
@@ -0,0 +1,238 @@
+package models
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/turtacn/SQLTraceBench/pkg/types"
+)
+
+func TestSQLTemplate_GenerateQuery_Dialects(t *testing.T) {
+	tpl := &SQLTemplate{RawSQL: "SELECT * FROM t WHERE a = :p2 AND b = :p10 AND c = :p2 AND d IN (:p1) AND e::text = ':p2'"}
+	tpl.ExtractParameters()
+	assert.Equal(t, []string{":p1", ":p10", ":p2"}, tpl.Parameters)
+	params := map[string]interface{}{":p1": []interface{}{int64(1), int64(2)}, ":p2": "x", ":p10": 1.5}
+
+	q, err := tpl.GenerateQuery(params, types.DatabaseStarRocks)
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT * FROM t WHERE a = ? AND b = ? AND c = ? AND d IN (?, ?) AND e::text = ':p2'", q.Query)
+	assert.Equal(t, []interface{}{"x", 1.5, "x", int64(1), int64(2)}, q.Args)
+	assert.Nil(t, q.ArgNames)
+
+	q, err = tpl.GenerateQuery(params, types.DatabasePostgreSQL)
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT * FROM t WHERE a = $1 AND b = $2 AND c = $1 AND d IN ($3, $4) AND e::text = ':p2'", q.Query)
+	assert.Equal(t, []interface{}{"x", 1.5, int64(1), int64(2)}, q.Args)
+
+	q, err = tpl.GenerateQuery(params, types.DatabaseClickHouse)
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT * FROM t WHERE a = {p2:String} AND b = {p10:Float64} AND c = {p2:String} AND d IN ({p1_1:Int64}, {p1_2:Int64}) AND e::text = ':p2'", q.Query)
+	assert.Equal(t, []interface{}{"x", 1.5, int64(1), int64(2)}, q.Args)
+	assert.Equal(t, []string{"p2", "p10", "p1_1", "p1_2"}, q.ArgNames)
+
+	_, err = tpl.GenerateQuery(map[string]interface{}{":p1": 1}, types.DatabaseMySQL)
+	assert.ErrorContains(t, err, "parameter :p2 not found")
+}
+
+func TestSQLTemplate_GenerateQuery_EmptyList(t *testing.T) {
+	tpl := &SQLTemplate{RawSQL: "SELECT * FROM t WHERE id IN (:p1)"}
+	q, err := tpl.GenerateQuery(map[string]interface{}{":p1": []interface{}{}}, types.DatabasePostgreSQL)
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT * FROM t WHERE id IN ($1)", q.Query)
+	assert.Equal(t, []interface{}{nil}, q.Args)
+}
+
+// TestSQLTemplate_GenerateQuery_Properties renders random templates in every dialect
+// and checks that substituting the arguments back into the placeholders yields the
+// template with its parameters substituted by name.
+func TestSQLTemplate_GenerateQuery_Properties(t *testing.T) {
+	dialects := []types.DatabaseType{types.DatabaseMySQL, types.DatabaseStarRocks, types.DatabasePostgreSQL, types.DatabaseClickHouse}
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 500; i++ {
+		sql, params := randomTemplate(rng)
+		tpl := &SQLTemplate{RawSQL: sql}
+		want := inlineNamed(t, sql, params)
+
+		for _, dialect := range dialects {
+			q, err := tpl.GenerateQuery(params, dialect)
+			require.NoError(t, err, sql)
+			assert.Equal(t, want, inlineRendered(t, q, dialect), "%s: %s -> %s", dialect, sql, q.Query)
+
+			switch dialect {
+			case types.DatabasePostgreSQL:
+				// Every argument is referenced, by consecutive numbers.
+				seen := map[string]bool{}
+				for _, ref := range renderedRefs(q.Query) {
+					seen[ref] = true
+				}
+				assert.Len(t, seen, len(q.Args), q.Query)
+				assert.Empty(t, findPlaceholders(q.Query), q.Query)
+			case types.DatabaseClickHouse:
+				assert.Len(t, q.ArgNames, len(q.Args))
+				names := map[string]bool{}
+				for _, name := range q.ArgNames {
+					names[name] = true
+				}
+				assert.Len(t, names, len(q.ArgNames), "argument names are distinct")
+			default:
+				assert.Len(t, renderedRefs(q.Query), len(q.Args))
+				assert.Empty(t, findPlaceholders(q.Query), q.Query)
+			}
+		}
+	}
+}
+
+// randomTemplate builds a query from conditions on a small pool of parameter names, so
+// that names repeat and sort differently from their SQL order, mixed with literals,
+// comments and casts that look like placeholders.
+func randomTemplate(rng *rand.Rand) (string, map[string]interface{}) {
+	noise := []string{"'it''s :p3 ?'", "x::int", "-- :p4 ?\n", "/* :p5 $1 */", "\"col:p6\"", "'{p1:Int64}'", "'a\\' :p7'"}
+	params := make(map[string]interface{})
+	var sb strings.Builder
+	sb.WriteString("SELECT * FROM t WHERE 1 = 1")
+	for n := rng.Intn(8); n >= 0; n-- {
+		name := ":p" + strconv.Itoa(rng.Intn(12)+1)
+		if _, ok := params[name]; !ok {
+			params[name] = randomValue(rng)
+		}
+		if _, isList := params[name].([]interface{}); isList {
+			fmt.Fprintf(&sb, " AND c%d IN (%s)", n, name)
+		} else {
+			fmt.Fprintf(&sb, " AND c%d = %s", n, name)
+		}
+		if rng.Intn(3) == 0 {
+			sb.WriteString(" AND " + noise[rng.Intn(len(noise))] + " IS NOT NULL")
+		}
+	}
+	return sb.String(), params
+}
+
+func randomValue(rng *rand.Rand) interface{} {
+	switch rng.Intn(6) {
+	case 0:
+		return rng.Int63n(1000)
+	case 1:
+		return rng.Float64()
+	case 2:
+		return "s" + strconv.Itoa(rng.Intn(100))
+	case 3:
+		return nil
+	case 4:
+		return rng.Intn(2) == 0
+	}
+	list := make([]interface{}, rng.Intn(4))
+	for i := range list {
+		list[i] = rng.Int63n(100)
+	}
+	return list
+}
+
+func literal(v interface{}) string {
+	return fmt.Sprintf("<%T:%v>", v, v)
+}
+
+// inlineNamed substitutes the parameters of a template by name.
+func inlineNamed(t *testing.T, sql string, params map[string]interface{}) string {
+	t.Helper()
+	var sb strings.Builder
+	last := 0
+	for _, p := range findPlaceholders(sql) {
+		sb.WriteString(sql[last:p.start])
+		last = p.end
+		list, ok := params[p.name].([]interface{})
+		if !ok {
+			sb.WriteString(literal(params[p.name]))
+			continue
+		}
+		if len(list) == 0 {
+			list = []interface{}{nil}
+		}
+		for i, v := range list {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(literal(v))
+		}
+	}
+	return sb.String() + sql[last:]
+}
+
+var renderedRe = regexp.MustCompile(`\?|\$[0-9]+|\{[a-z0-9_]+:[A-Za-z0-9()]+\}`)
+
+// renderedRefs returns the placeholders of a rendered query outside literals and comments.
+func renderedRefs(query string) []string {
+	var refs []string
+	for _, span := range renderedSpans(query) {
+		refs = append(refs, query[span[0]:span[1]])
+	}
+	return refs
+}
+
+func renderedSpans(query string) [][2]int {
+	var spans [][2]int
+	code := []byte(query)
+	// Blank out literals and comments so that only real placeholders match.
+	for i := 0; i < len(code); {
+		end := i + 1
+		switch {
+		case code[i] == '\'' || code[i] == '"' || code[i] == '`':
+			end = skipQuoted(query, i)
+		case strings.HasPrefix(query[i:], "--"):
+			end = i + strings.IndexByte(query[i:], '\n') + 1
+		case strings.HasPrefix(query[i:], "/*"):
+			end = i + strings.Index(query[i:], "*/") + 2
+		default:
+			i++
+			continue
+		}
+		for j := i; j < end; j++ {
+			code[j] = ' '
+		}
+		i = end
+	}
+	for _, m := range renderedRe.FindAllIndex(code, -1) {
+		spans = append(spans, [2]int{m[0], m[1]})
+	}
+	return spans
+}
+
+// inlineRendered substitutes the arguments of a rendered query into its placeholders.
+func inlineRendered(t *testing.T, q QueryWithArgs, dialect types.DatabaseType) string {
+	t.Helper()
+	var sb strings.Builder
+	last, next := 0, 0
+	for _, span := range renderedSpans(q.Query) {
+		sb.WriteString(q.Query[last:span[0]])
+		last = span[1]
+		ref := q.Query[span[0]:span[1]]
+		var arg interface{}
+		switch dialect {
+		case types.DatabasePostgreSQL:
+			n, err := strconv.Atoi(ref[1:])
+			require.NoError(t, err)
+			arg = q.Args[n-1]
+		case types.DatabaseClickHouse:
+			name := ref[1:strings.IndexByte(ref, ':')]
+			idx := -1
+			for i, argName := range q.ArgNames {
+				if argName == name {
+					idx = i
+				}
+			}
+			require.GreaterOrEqual(t, idx, 0, "unknown argument %s", name)
+			arg = q.Args[idx]
+			assert.Equal(t, "{"+name+":"+clickHouseType(arg)+"}", ref)
+		default:
+			arg = q.Args[next]
+			next++
+		}
+		sb.WriteString(literal(arg))
+	}
+	return sb.String() + q.Query[last:]
+}
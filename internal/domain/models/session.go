@@ -0,0 +1,26 @@
+package models
+
+// SessionModel is a Markov model of the order in which sessions issue templates, such
+// as "login, list cart, checkout", and of the think time between their queries.
+//
+// A state is the context of the last templates of a session, up to Order of them, and
+// holds the counts of the templates that followed it. States of every length up to
+// Order are kept, so that a context that was not observed backs off to a shorter one.
+type SessionModel struct {
+	Order  int            `json:"order"`
+	States []SessionState `json:"states"`
+}
+
+// SessionState is a context of a SessionModel and what followed it.
+type SessionState struct {
+	// Context holds the GroupKeys of the last templates of a session, oldest first. It
+	// is empty for the start of a session.
+	Context []string `json:"context"`
+	// Next holds the GroupKeys of the templates that followed the context, with the
+	// number of times in Counts; an empty key ends the session.
+	Next   []string `json:"next"`
+	Counts []int    `json:"counts"`
+	// ThinkTime is the distribution of the seconds between the end of the last query of
+	// the context and the start of the next one. It is nil for the start of a session.
+	ThinkTime *ParameterModel `json:"think_time,omitempty"`
+}
@@ -0,0 +1,72 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// String renders the pattern as a regular expression, e.g. "ORD-[0-9]{8}".
+func (p StringPattern) String() string {
+	var b strings.Builder
+	for _, seg := range p.Segments {
+		if len(seg.Choices) > 0 {
+			quoted := make([]string, len(seg.Choices))
+			for i, c := range seg.Choices {
+				quoted[i] = regexp.QuoteMeta(c)
+			}
+			if len(quoted) == 1 {
+				b.WriteString(quoted[0])
+			} else {
+				b.WriteString("(" + strings.Join(quoted, "|") + ")")
+			}
+			continue
+		}
+		b.WriteString(characterClass(seg.Alphabet))
+		if len(seg.Lengths) == 0 {
+			continue
+		}
+		lo, hi := seg.Lengths[0], seg.Lengths[0]
+		for _, n := range seg.Lengths {
+			lo, hi = min(lo, n), max(hi, n)
+		}
+		if lo == hi {
+			fmt.Fprintf(&b, "{%d}", lo)
+		} else {
+			fmt.Fprintf(&b, "{%d,%d}", lo, hi)
+		}
+	}
+	return b.String()
+}
+
+// characterClass renders a sorted alphabet as a bracket expression, folding runs of
+// three or more consecutive characters into ranges.
+func characterClass(alphabet string) string {
+	chars := []rune(alphabet)
+	var b strings.Builder
+	b.WriteString("[")
+	for i := 0; i < len(chars); {
+		j := i
+		for j+1 < len(chars) && chars[j+1] == chars[j]+1 {
+			j++
+		}
+		if j-i >= 2 {
+			b.WriteString(classChar(chars[i]) + "-" + classChar(chars[j]))
+		} else {
+			for k := i; k <= j; k++ {
+				b.WriteString(classChar(chars[k]))
+			}
+		}
+		i = j + 1
+	}
+	b.WriteString("]")
+	return b.String()
+}
+
+func classChar(r rune) string {
+	switch r {
+	case '\\', ']', '[', '^', '-':
+		return `\` + string(r)
+	}
+	return string(r)
+}
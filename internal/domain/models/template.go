@@ -2,12 +2,11 @@ package models
 
 import (
 	"fmt"
-	"regexp"
 	"sort"
-)
+	"strings"
 
-// paramRe is a regular expression used to identify named parameters in a SQL query.
-var paramRe = regexp.MustCompile(`:[a-zA-Z_][a-zA-Z0-9_]*`)
+	"github.com/turtacn/SQLTraceBench/pkg/types"
+)
 
 // SQLTemplate represents a normalized SQL query with its parameters extracted.
 type SQLTemplate struct {
@@ -15,14 +14,75 @@ type SQLTemplate struct {
 	GroupKey   string
 	Weight     int
 	Parameters []string
+
+	// Databases and Users count the source traces of the template by default database and user.
+	Databases map[string]int `json:",omitempty"`
+	Users     map[string]int `json:",omitempty"`
+	// Errors is the number of source traces of the template that failed.
+	Errors int `json:",omitempty"`
+
+	// QueryType is the statement type, ReadOnly whether the statement only reads
+	// data, and Tables the tables it references.
+	QueryType types.QueryType
+	ReadOnly  bool     `json:",omitempty"`
+	Tables    []string `json:",omitempty"`
+}
+
+// Observe records the session context of a source trace of the template.
+// It does not change the template's weight.
+func (t *SQLTemplate) Observe(trace SQLTrace) {
+	if trace.Database != "" {
+		if t.Databases == nil {
+			t.Databases = make(map[string]int)
+		}
+		t.Databases[trace.Database]++
+	}
+	if trace.User != "" {
+		if t.Users == nil {
+			t.Users = make(map[string]int)
+		}
+		t.Users[trace.User]++
+	}
+	if trace.Failed() {
+		t.Errors++
+	}
+}
+
+// Merge folds another template into this one: the weights, session contexts and
+// errors add up, the tables are united, and the template only reads data if both do.
+// The query and its parameters are kept.
+func (t *SQLTemplate) Merge(other SQLTemplate) {
+	t.Weight += other.Weight
+	t.Errors += other.Errors
+	t.Databases = mergeCounts(t.Databases, other.Databases)
+	t.Users = mergeCounts(t.Users, other.Users)
+	t.ReadOnly = t.ReadOnly && other.ReadOnly
+	for _, table := range other.Tables {
+		known := false
+		for _, have := range t.Tables {
+			known = known || have == table
+		}
+		if !known {
+			t.Tables = append(t.Tables, table)
+		}
+	}
+}
+
+func mergeCounts(dst, src map[string]int) map[string]int {
+	if len(src) > 0 && dst == nil {
+		dst = make(map[string]int, len(src))
+	}
+	for k, n := range src {
+		dst[k] += n
+	}
+	return dst
 }
 
 // ExtractParameters finds all named parameters in the RawSQL query.
 func (t *SQLTemplate) ExtractParameters() {
-	params := paramRe.FindAllString(t.RawSQL, -1)
 	paramSet := make(map[string]struct{})
-	for _, p := range params {
-		paramSet[p] = struct{}{}
+	for _, p := range findPlaceholders(t.RawSQL) {
+		paramSet[p.name] = struct{}{}
 	}
 
 	t.Parameters = make([]string, 0, len(paramSet))
@@ -32,22 +92,43 @@ func (t *SQLTemplate) ExtractParameters() {
 	sort.Strings(t.Parameters)
 }
 
-// GenerateQuery creates a QueryWithArgs struct from the template.
-// It replaces named parameters with '?' placeholders and populates the args slice.
-func (t *SQLTemplate) GenerateQuery(params map[string]interface{}) (QueryWithArgs, error) {
-	query := t.RawSQL
-	args := make([]interface{}, 0, len(t.Parameters))
-
-	// This is a simplified approach that assumes parameter order.
-	// This is a known limitation to be addressed with a better parser.
-	for _, pName := range t.Parameters {
-		val, ok := params[pName]
+// GenerateQuery creates a QueryWithArgs struct from the template, binding its named
+// parameters in SQL order with the placeholders of the target dialect: '?' for MySQL,
+// StarRocks and the other MySQL-compatible databases (the default), '$n' for PostgreSQL
+// and '{name:Type}' for ClickHouse.
+//
+// A repeated parameter binds the same value at each occurrence: it is passed again for
+// '?' and reuses its number or name otherwise. A slice value, such as a collapsed
+// IN-list, expands to one placeholder per element; an empty one binds a single NULL.
+func (t *SQLTemplate) GenerateQuery(params map[string]interface{}, dialect types.DatabaseType) (QueryWithArgs, error) {
+	b := &binder{style: placeholderStyleOf(dialect), bound: make(map[string]string)}
+	var query strings.Builder
+	last := 0
+	for _, p := range findPlaceholders(t.RawSQL) {
+		val, ok := params[p.name]
 		if !ok {
-			return QueryWithArgs{}, fmt.Errorf("parameter %s not found in params map", pName)
+			return QueryWithArgs{}, fmt.Errorf("parameter %s not found in params map", p.name)
+		}
+		query.WriteString(t.RawSQL[last:p.start])
+		last = p.end
+
+		name := p.name[1:]
+		list, isList := val.([]interface{})
+		if !isList {
+			query.WriteString(b.bind(name, val))
+			continue
+		}
+		if len(list) == 0 {
+			list = []interface{}{nil}
+		}
+		for i, elem := range list {
+			if i > 0 {
+				query.WriteString(", ")
+			}
+			query.WriteString(b.bind(fmt.Sprintf("%s_%d", name, i+1), elem))
 		}
-		args = append(args, val)
-		query = regexp.MustCompile(pName).ReplaceAllString(query, "?")
 	}
+	query.WriteString(t.RawSQL[last:])
 
-	return QueryWithArgs{Query: query, Args: args}, nil
-}
\ No newline at end of file
+	return QueryWithArgs{Query: query.String(), Args: b.args, ArgNames: b.names}, nil
+}
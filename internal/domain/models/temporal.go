@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// TemporalPattern is the rate of a trace over time: the number of queries in each
+// bin of Window since the first one.
+type TemporalPattern struct {
+	Window    time.Duration `json:"window"`
+	BinCounts map[int]int   `json:"bin_counts"` // key=time bin index, value=query count
+}
+
+// Span returns the time covered by the bins of the pattern, from the start of the
+// first to the end of the last.
+func (p *TemporalPattern) Span() time.Duration {
+	maxBin := -1
+	for idx := range p.BinCounts {
+		if idx > maxBin {
+			maxBin = idx
+		}
+	}
+	return time.Duration(maxBin+1) * p.Window
+}
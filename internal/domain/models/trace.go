@@ -9,6 +9,34 @@ type SQLTrace struct {
 	Timestamp  time.Time
 	Latency    time.Duration
 	Parameters map[string]interface{}
+
+	// User and Host identify the client that issued the query, when the source records it.
+	User string `json:",omitempty"`
+	Host string `json:",omitempty"`
+	// RowsExamined is the number of rows the server read to answer the query.
+	RowsExamined int64 `json:",omitempty"`
+	// MemoryUsage is the peak memory, in bytes, the server used for the query.
+	MemoryUsage int64 `json:",omitempty"`
+	// QueryKind is the statement kind reported by the source (e.g. ClickHouse's "Select", "Insert").
+	QueryKind string `json:",omitempty"`
+
+	// SessionID identifies the connection or session that issued the query.
+	SessionID string `json:",omitempty"`
+	// TxnID identifies the transaction the query ran in. It is empty for autocommit
+	// statements and for sources that do not record transactions.
+	TxnID string `json:",omitempty"`
+	// Database is the default database (schema) of the session.
+	Database string `json:",omitempty"`
+	// RowsAffected is the number of rows inserted, updated or deleted by the query.
+	RowsAffected int64 `json:",omitempty"`
+	// ErrorCode is the server error code (MySQL errno, SQLSTATE, ClickHouse exception code)
+	// of a failed query. It is empty when the query succeeded.
+	ErrorCode string `json:",omitempty"`
+}
+
+// Failed reports whether the query ended with an error.
+func (t *SQLTrace) Failed() bool {
+	return t.ErrorCode != ""
 }
 
 // TraceCollection holds a collection of SQLTraces.
@@ -84,6 +84,19 @@ type BenchmarkResult struct {
 	QPS       float64
 }
 
+// ToPerformanceMetrics adapts a raw benchmark result to the PerformanceMetrics shape used by reports.
+func (r *BenchmarkResult) ToPerformanceMetrics() *PerformanceMetrics {
+	pm := &PerformanceMetrics{
+		QueriesExecuted: int64(len(r.Latencies)),
+		Latencies:       append([]time.Duration(nil), r.Latencies...),
+	}
+	if r.QPS > 0 {
+		pm.Duration = time.Duration(float64(pm.QueriesExecuted) / r.QPS * float64(time.Second))
+	}
+	pm.CalculatePercentiles()
+	return pm
+}
+
 type ValidationReport struct {
 	Status         string
 	QPSDeviation   float64
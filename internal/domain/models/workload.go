@@ -1,16 +1,68 @@
 package models
 
+import (
+	"database/sql"
+	"fmt"
+	"time"
+)
+
 // QueryWithArgs represents a single query to be executed, with its parameters separated
 // to allow for safe execution using prepared statements.
 type QueryWithArgs struct {
-	// Query is the SQL statement with '?' placeholders for parameters.
+	// Query is the SQL statement with placeholders for parameters in the syntax of the
+	// target dialect ('?', '$n' or '{name:Type}').
 	Query string `json:"query"`
 	// Args is a slice of arguments to be bound to the query's placeholders.
 	Args []interface{} `json:"args"`
+	// ArgNames names the arguments of dialects with named placeholders (ClickHouse).
+	ArgNames []string `json:"arg_names,omitempty"`
+	// Database and User are the session context to issue the query with, sampled
+	// from the context observed for its template. Empty when the source had none.
+	Database string `json:"database,omitempty"`
+	User     string `json:"user,omitempty"`
+	// SessionID groups the queries generated for one session, in the order they are
+	// issued. It is empty for queries generated independently of each other.
+	SessionID string `json:"session_id,omitempty"`
+	// ThinkTime is the pause between the end of the previous query of the session and
+	// this one.
+	ThinkTime time.Duration `json:"think_time,omitempty"`
+	// IssueAt is the time to issue the query at, as an offset from the start of the
+	// run, in workloads shaped in time (see BenchmarkWorkload.Duration).
+	IssueAt time.Duration `json:"issue_at,omitempty"`
+}
+
+// BindArgs returns the arguments to execute the query with through database/sql. With
+// named placeholders each argument is passed as a sql.Named value, in the text form
+// ClickHouse parses query parameters from.
+func (q QueryWithArgs) BindArgs() []interface{} {
+	if len(q.ArgNames) == 0 {
+		return q.Args
+	}
+	args := make([]interface{}, len(q.Args))
+	for i, arg := range q.Args {
+		args[i] = sql.Named(q.ArgNames[i], clickHouseParameter(arg))
+	}
+	return args
+}
+
+// TextArgs returns the arguments of the query as text, for the plugin protocol.
+func (q QueryWithArgs) TextArgs() []string {
+	args := make([]string, len(q.Args))
+	for i, arg := range q.Args {
+		if len(q.ArgNames) > 0 {
+			args[i] = clickHouseParameter(arg)
+		} else {
+			args[i] = fmt.Sprintf("%v", arg)
+		}
+	}
+	return args
 }
 
 // BenchmarkWorkload represents a set of queries to be executed by the benchmark.
 type BenchmarkWorkload struct {
 	// Queries is a list of all the SQL queries and their arguments for the workload.
 	Queries []QueryWithArgs `json:"queries"`
+	// Duration, when set, is the time the queries are spread over following the
+	// temporal pattern of the source traces; they are ordered by IssueAt.
+	Duration time.Duration `json:"duration,omitempty"`
 }
\ No newline at end of file
@@ -0,0 +1,536 @@
+package services
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultAnonymizerConfigPath is the anonymization configuration used by the CLI.
+const DefaultAnonymizerConfigPath = "configs/anonymization.yaml"
+
+// DefaultAnonymizerSecretEnv is the environment variable holding the pseudonym key
+// when the configuration does not name another one.
+const DefaultAnonymizerSecretEnv = "SQLTRACEBENCH_ANONYMIZE_KEY"
+
+// PII detectors accepted in AnonymizerConfig.Detectors.
+const (
+	DetectEmail      = "email"
+	DetectPhone      = "phone"
+	DetectToken      = "token"
+	DetectIPv4       = "ipv4"
+	DetectCreditCard = "credit_card"
+)
+
+// piiDetector finds one kind of PII in free text.
+type piiDetector struct {
+	name string
+	re   *regexp.Regexp
+	// valid, when set, rejects matches that only look like PII.
+	valid func(match string) bool
+}
+
+// piiDetectors are tried in order; earlier detectors win on overlapping matches.
+var piiDetectors = []piiDetector{
+	{name: DetectEmail, re: regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9-]+(?:\.[A-Za-z0-9-]+)*\.[A-Za-z]{2,}`)},
+	// API keys, session tokens, hashes: long runs mixing letters and digits.
+	{name: DetectToken, re: regexp.MustCompile(`[A-Za-z0-9_-]{20,}`), valid: isMixedAlphanumeric},
+	// Card numbers start with 3-6 (Amex, Visa, Mastercard, Discover) and pass the Luhn check.
+	{name: DetectCreditCard, re: regexp.MustCompile(`\b[3-6]\d(?:[ -]?\d){11,17}\b`), valid: luhnValid},
+	{name: DetectIPv4, re: ipv4Re},
+	// International numbers with a leading +, and North American (555) 123-4567 / 555-123-4567.
+	{name: DetectPhone, re: regexp.MustCompile(`\+\d{1,3}[ .-]?(?:\(\d{1,4}\)[ .-]?)?\d{1,4}(?:[ .-]?\d{2,4}){1,4}|\(\d{3}\) ?\d{3}[ .-]\d{4}\b|\b\d{3}[.-]\d{3}[.-]\d{4}\b`), valid: func(m string) bool {
+		n := countDigits(m)
+		return n >= 7 && n <= 15
+	}},
+}
+
+var ipv4Re = regexp.MustCompile(`\b(?:(?:25[0-5]|2[0-4]\d|1?\d?\d)\.){3}(?:25[0-5]|2[0-4]\d|1?\d?\d)\b`)
+
+// AnonymizerConfig mirrors the "anonymization" section of configs/anonymization.yaml.
+type AnonymizerConfig struct {
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// SecretEnv names the environment variable holding the pseudonym key.
+	// The key itself is never read from a file.
+	SecretEnv string `yaml:"secret_env" json:"secret_env,omitempty"`
+	// Detectors lists the PII patterns replaced wherever they occur in string
+	// literals and parameter values. Empty enables all of them.
+	Detectors []string `yaml:"detectors" json:"detectors,omitempty"`
+	// Fields are column or parameter names whose values are always replaced,
+	// whatever they look like.
+	Fields []string `yaml:"fields" json:"fields,omitempty"`
+
+	// Secret overrides SecretEnv; it is meant for tests and API callers.
+	Secret string `yaml:"-" json:"-"`
+}
+
+// LoadAnonymizerConfig reads an anonymization configuration file.
+func LoadAnonymizerConfig(path string) (*AnonymizerConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file struct {
+		Anonymization AnonymizerConfig `yaml:"anonymization"`
+	}
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return &file.Anonymization, nil
+}
+
+// Anonymizer replaces PII in traces with format-preserving pseudonyms. A pseudonym
+// is derived from the original value with HMAC-SHA256 under a secret key: letters
+// map to letters of the same case, digits to digits and everything else is kept, so
+// the same input always yields the same output. Value frequencies, and with them the
+// Zipf and hotspot structure seen by ParameterAnalyzer, are therefore preserved,
+// while the originals cannot be recovered without the key.
+type Anonymizer struct {
+	key       []byte
+	detectors []piiDetector
+	fields    map[string]bool
+}
+
+// NewAnonymizer creates an Anonymizer. The key is taken from cfg.Secret or the
+// environment variable named by cfg.SecretEnv; an empty key is an error, since
+// unkeyed pseudonyms could be reversed by hashing candidate values.
+func NewAnonymizer(cfg AnonymizerConfig) (*Anonymizer, error) {
+	secret := cfg.Secret
+	if secret == "" {
+		env := cfg.SecretEnv
+		if env == "" {
+			env = DefaultAnonymizerSecretEnv
+		}
+		secret = os.Getenv(env)
+		if secret == "" {
+			return nil, fmt.Errorf("anonymization requires a secret key in $%s", env)
+		}
+	}
+
+	a := &Anonymizer{key: []byte(secret), fields: make(map[string]bool)}
+	enabled := make(map[string]bool)
+	for _, name := range cfg.Detectors {
+		enabled[strings.ToLower(name)] = true
+	}
+	known := make(map[string]bool)
+	for _, d := range piiDetectors {
+		known[d.name] = true
+		if len(enabled) == 0 || enabled[d.name] {
+			a.detectors = append(a.detectors, d)
+		}
+	}
+	for name := range enabled {
+		if !known[name] {
+			return nil, fmt.Errorf("unknown PII detector %q", name)
+		}
+	}
+	for _, f := range cfg.Fields {
+		a.fields[normalizeFieldName(f)] = true
+	}
+	return a, nil
+}
+
+// AnonymizeTrace returns a copy of the trace with PII replaced in the query's
+// literals, the parameter values and the client host.
+func (a *Anonymizer) AnonymizeTrace(tr models.SQLTrace) models.SQLTrace {
+	tr.Query = a.AnonymizeQuery(tr.Query)
+	if tr.Parameters != nil {
+		params := make(map[string]interface{}, len(tr.Parameters))
+		for name, value := range tr.Parameters {
+			params[name] = a.AnonymizeValue(name, value)
+		}
+		tr.Parameters = params
+	}
+	tr.Host = a.scrub(tr.Host)
+	return tr
+}
+
+// AnonymizeValue anonymizes a parameter value. Values of configured fields are
+// always replaced; other strings only where they contain PII.
+func (a *Anonymizer) AnonymizeValue(name string, value interface{}) interface{} {
+	sensitive := a.fields[normalizeFieldName(name)]
+	switch v := value.(type) {
+	case string:
+		if sensitive {
+			return a.Pseudonym(v)
+		}
+		return a.scrub(v)
+	case float64:
+		if sensitive && v == float64(int64(v)) {
+			return float64(a.pseudonymInt(int64(v)))
+		}
+	case int:
+		if sensitive {
+			return int(a.pseudonymInt(int64(v)))
+		}
+	case int64:
+		if sensitive {
+			return a.pseudonymInt(v)
+		}
+	}
+	return value
+}
+
+// AnonymizeQuery replaces PII in the string literals of a SQL query, and every
+// literal compared with, assigned or inserted into a configured column.
+func (a *Anonymizer) AnonymizeQuery(query string) string {
+	var out strings.Builder
+	out.Grow(len(query))
+	sc := sqlLiteralScanner{}
+	for i := 0; i < len(query); {
+		c := query[i]
+		switch {
+		case c == '`' || c == '"' && sc.quotedIdentifier(query, i):
+			end := scanQuoted(query, i)
+			sc.word(query[i+1 : max(end-1, i+1)])
+			out.WriteString(query[i:end])
+			i = end
+		case c == '\'' || c == '"':
+			end := scanQuoted(query, i)
+			if end-i < 2 || query[end-1] != c {
+				out.WriteString(query[i:]) // Unterminated; keep the rest as is.
+				i = end
+				continue
+			}
+			// The literal is rewritten character class for character class, so quotes
+			// and escapes inside it stay where they were.
+			literal := query[i+1 : end-1]
+			if a.fields[sc.column()] {
+				literal = a.Pseudonym(literal)
+			} else {
+				literal = a.scrub(literal)
+			}
+			out.WriteByte(c)
+			out.WriteString(literal)
+			out.WriteByte(c)
+			i = end
+		case c >= '0' && c <= '9':
+			end := i
+			for end < len(query) && (query[end] >= '0' && query[end] <= '9' || query[end] == '.') {
+				end++
+			}
+			number := query[i:end]
+			if a.fields[sc.column()] {
+				number = a.Pseudonym(number)
+			}
+			out.WriteString(number)
+			i = end
+		case isSQLWordByte(c):
+			end := i
+			for end < len(query) && (isSQLWordByte(query[end]) || query[end] == '.') {
+				end++
+			}
+			sc.word(query[i:end])
+			out.WriteString(query[i:end])
+			i = end
+		default:
+			sc.punct(c)
+			out.WriteByte(c)
+			i++
+		}
+	}
+	return out.String()
+}
+
+// Pseudonym returns the format-preserving pseudonym of a value. The top-level
+// domain of an email address is kept so pseudonymized addresses stay plausible,
+// hexadecimal strings (hashes, UUIDs) stay hexadecimal and IPv4 addresses stay valid.
+func (a *Anonymizer) Pseudonym(value string) string {
+	if at := strings.LastIndexByte(value, '@'); at > 0 {
+		if dot := strings.LastIndexByte(value, '.'); dot > at {
+			return a.pseudonym(value[:dot], value, false) + value[dot:]
+		}
+	}
+	if ipv4Re.FindString(value) == value {
+		ks := newKeystream(a.key, value)
+		return fmt.Sprintf("%d.%d.%d.%d", ks.next()%256, ks.next()%256, ks.next()%256, ks.next()%256)
+	}
+	return a.pseudonym(value, value, isHex(value))
+}
+
+// pseudonym maps the letters and digits of value using a keystream derived from
+// seed. With hex set, letters are drawn from a-f only.
+func (a *Anonymizer) pseudonym(value, seed string, hex bool) string {
+	letters := uint32(26)
+	if hex {
+		letters = 6
+	}
+	ks := newKeystream(a.key, seed)
+	out := []byte(value)
+	for i, c := range out {
+		switch {
+		case c >= '0' && c <= '9':
+			// Keep a leading non-zero digit non-zero so numbers keep their length.
+			if i == 0 && c != '0' {
+				out[i] = '1' + byte(ks.next()%9)
+			} else {
+				out[i] = '0' + byte(ks.next()%10)
+			}
+		case c >= 'a' && c <= 'z':
+			out[i] = 'a' + byte(ks.next()%letters)
+		case c >= 'A' && c <= 'Z':
+			out[i] = 'A' + byte(ks.next()%letters)
+		}
+	}
+	return string(out)
+}
+
+func (a *Anonymizer) pseudonymInt(v int64) int64 {
+	s := strconv.FormatInt(v, 10)
+	sign := ""
+	if v < 0 {
+		sign, s = "-", s[1:]
+	}
+	n, err := strconv.ParseInt(sign+a.pseudonym(s, s, false), 10, 64)
+	if err != nil {
+		return v
+	}
+	return n
+}
+
+// scrub replaces the PII detected in free text.
+func (a *Anonymizer) scrub(text string) string {
+	if text == "" {
+		return text
+	}
+	type span struct{ start, end int }
+	var spans []span
+	for _, d := range a.detectors {
+		for _, m := range d.re.FindAllStringIndex(text, -1) {
+			if d.valid != nil && !d.valid(text[m[0]:m[1]]) {
+				continue
+			}
+			overlaps := false
+			for _, s := range spans {
+				if m[0] < s.end && s.start < m[1] {
+					overlaps = true
+					break
+				}
+			}
+			if !overlaps {
+				spans = append(spans, span{m[0], m[1]})
+			}
+		}
+	}
+	if len(spans) == 0 {
+		return text
+	}
+	sort.Slice(spans, func(i, j int) bool { return spans[i].start < spans[j].start })
+	var out strings.Builder
+	last := 0
+	for _, s := range spans {
+		out.WriteString(text[last:s.start])
+		out.WriteString(a.Pseudonym(text[s.start:s.end]))
+		last = s.end
+	}
+	out.WriteString(text[last:])
+	return out.String()
+}
+
+// keystream expands HMAC-SHA256(key, seed) into as many pseudo-random values as needed.
+type keystream struct {
+	key     []byte
+	seed    string
+	counter uint32
+	block   []byte
+}
+
+func newKeystream(key []byte, seed string) *keystream {
+	return &keystream{key: key, seed: seed}
+}
+
+func (k *keystream) next() uint32 {
+	if len(k.block) < 4 {
+		h := hmac.New(sha256.New, k.key)
+		var ctr [4]byte
+		binary.BigEndian.PutUint32(ctr[:], k.counter)
+		h.Write(ctr[:])
+		h.Write([]byte(k.seed))
+		k.block = h.Sum(nil)
+		k.counter++
+	}
+	v := binary.BigEndian.Uint32(k.block)
+	k.block = k.block[4:]
+	return v
+}
+
+// sqlLiteralScanner tracks which column the literals of a SQL statement belong to:
+// the left-hand side of a comparison, assignment, IN list or BETWEEN, or the
+// column at the same position of an INSERT column list.
+type sqlLiteralScanner struct {
+	lastWord   string // last identifier, candidate for the next comparison
+	current    string // column the following literals are compared with
+	between    bool   // inside BETWEEN x AND y
+	insert     bool
+	insertCols []string
+	colsDepth  int // paren depth of the INSERT column list, 0 when not collecting
+	inValues   bool
+	depth      int
+	position   int // column position within the current VALUES tuple
+}
+
+var sqlComparisonKeywords = map[string]bool{"in": true, "like": true, "ilike": true, "between": true}
+
+var sqlTransparentKeywords = map[string]bool{"not": true, "binary": true, "collate": true}
+
+func (s *sqlLiteralScanner) column() string {
+	if s.inValues && s.depth >= 1 && s.position < len(s.insertCols) {
+		return s.insertCols[s.position]
+	}
+	return s.current
+}
+
+// quotedIdentifier reports whether the double-quoted string at i is an ANSI quoted
+// identifier rather than a MySQL string: it is in an INSERT column list or is
+// followed by a dot, a comparison or a comparison keyword.
+func (s *sqlLiteralScanner) quotedIdentifier(query string, i int) bool {
+	if s.colsDepth > 0 {
+		return true
+	}
+	rest := strings.TrimLeft(query[scanQuoted(query, i):], " \t\r\n")
+	if rest != "" && strings.IndexByte(".=<>!", rest[0]) >= 0 {
+		return true
+	}
+	end := 0
+	for end < len(rest) && isSQLWordByte(rest[end]) {
+		end++
+	}
+	next := strings.ToLower(rest[:end])
+	return sqlComparisonKeywords[next] || next == "not"
+}
+
+func (s *sqlLiteralScanner) word(w string) {
+	lower := strings.ToLower(w)
+	switch {
+	case lower == "insert" || lower == "replace":
+		s.insert = true
+	case lower == "values" && s.insert:
+		s.inValues = true
+		s.depth = 0
+	case sqlComparisonKeywords[lower]:
+		s.current = s.lastWord
+		s.between = lower == "between"
+	case lower == "and" && s.between:
+		s.between = false // The upper bound still belongs to the same column.
+	case sqlTransparentKeywords[lower]:
+	default:
+		if dot := strings.LastIndexByte(lower, '.'); dot >= 0 {
+			lower = lower[dot+1:]
+		}
+		s.lastWord = normalizeFieldName(lower)
+		s.current = ""
+		if s.colsDepth > 0 {
+			s.insertCols = append(s.insertCols, s.lastWord)
+		}
+	}
+}
+
+func (s *sqlLiteralScanner) punct(c byte) {
+	switch c {
+	case '=', '<', '>', '!':
+		s.current = s.lastWord
+	case '(':
+		s.depth++
+		if s.insert && !s.inValues && s.insertCols == nil {
+			s.colsDepth = s.depth
+		}
+		if s.inValues && s.depth == 1 {
+			s.position = 0
+		}
+	case ')':
+		if s.colsDepth == s.depth {
+			s.colsDepth = 0
+		}
+		s.depth--
+	case ',':
+		if s.inValues && s.depth == 1 {
+			s.position++
+		}
+	}
+}
+
+// scanQuoted returns the index just past the quoted string starting at i. Doubled
+// quotes and backslash escapes are part of the string.
+func scanQuoted(query string, i int) int {
+	quote := query[i]
+	for j := i + 1; j < len(query); j++ {
+		switch query[j] {
+		case '\\':
+			j++
+		case quote:
+			if j+1 < len(query) && query[j+1] == quote {
+				j++
+				continue
+			}
+			return j + 1
+		}
+	}
+	return len(query)
+}
+
+func isSQLWordByte(c byte) bool {
+	return c == '_' || c == '$' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+// normalizeFieldName lowercases a column or parameter name and strips the
+// placeholder prefix (:name, @name, $name) and quoting.
+func normalizeFieldName(name string) string {
+	return strings.ToLower(strings.Trim(name, ":@$`\"[] "))
+}
+
+func luhnValid(number string) bool {
+	sum, n := 0, 0
+	for i := len(number) - 1; i >= 0; i-- {
+		c := number[i]
+		if c < '0' || c > '9' {
+			continue
+		}
+		d := int(c - '0')
+		if n%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		n++
+	}
+	return n >= 13 && sum%10 == 0
+}
+
+func isMixedAlphanumeric(s string) bool {
+	return strings.ContainsAny(s, "0123456789") &&
+		strings.ContainsAny(strings.ToLower(s), "abcdefghijklmnopqrstuvwxyz")
+}
+
+func isHex(s string) bool {
+	letters := false
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F':
+			letters = true
+		case c >= 'g' && c <= 'z' || c >= 'G' && c <= 'Z':
+			return false
+		}
+	}
+	return letters
+}
+
+func countDigits(s string) int {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		if s[i] >= '0' && s[i] <= '9' {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,275 @@
+package services
+
+import (
+	"math"
+	"sort"
+
+	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+)
+
+// minContinuousDistinct is the number of distinct values a numeric or datetime
+// parameter needs before a continuous distribution is fitted to it.
+const minContinuousDistinct = 20
+
+// maxHistogramBins bounds the number of bins of a fitted histogram.
+const maxHistogramBins = 100
+
+// weightedPoint is an observed value and the number of its observations.
+type weightedPoint struct {
+	x float64
+	n float64
+}
+
+// fitContinuous fits the continuous families to the values of a parameter and returns
+// the one with the lowest AIC. It returns nil for parameters that are not numbers or
+// times, that have fewer than minContinuousDistinct values, or whose values mostly
+// repeat: such hot keys are better replayed from TopValues.
+func fitContinuous(stats *ParameterStats) (*models.ContinuousModel, models.DistributionType) {
+	if stats.Type != ParamTypeInt && stats.Type != ParamTypeFloat && stats.Type != ParamTypeDatetime {
+		return nil, ""
+	}
+
+	fit := &models.ContinuousModel{ValueKind: "int"}
+	if stats.Type == ParamTypeDatetime {
+		fit.ValueKind = "time"
+	}
+	points := make([]weightedPoint, 0, len(stats.ValueCounts))
+	counted := 0
+	for v, c := range stats.ValueCounts {
+		var x float64
+		if fit.ValueKind == "time" {
+			t, layout, ok := toTime(v)
+			if !ok || len(points) > 0 && layout != fit.Layout {
+				return nil, ""
+			}
+			fit.Layout = layout
+			x = float64(t.UnixNano()) / 1e9
+		} else {
+			f, ok := toFloat64(v)
+			if !ok {
+				return nil, ""
+			}
+			if _, isInt := toInt64(v); !isInt {
+				fit.ValueKind = "float"
+			}
+			x = f
+		}
+		points = append(points, weightedPoint{x: x, n: float64(c)})
+		counted += c
+	}
+	if stats.Quantiles != nil {
+		// Beyond the heavy hitters of a sketch, the values are summarized by the
+		// centroids of its digest, which must hold every value.
+		if len(points) == 0 || stats.Quantiles.Count() < float64(stats.TotalCount) {
+			return nil, ""
+		}
+		if stats.Type == ParamTypeFloat {
+			fit.ValueKind = "float"
+		}
+		if stats.Distinct < minContinuousDistinct || 2*stats.Distinct < stats.TotalCount {
+			return nil, ""
+		}
+		points = append([]weightedPoint(nil), stats.Quantiles.Centroids()...)
+	} else if len(points) < minContinuousDistinct || 2*len(points) < counted {
+		return nil, ""
+	}
+	sort.Slice(points, func(i, j int) bool { return points[i].x < points[j].x })
+
+	n, mean := 0.0, 0.0
+	for _, p := range points {
+		n += p.n
+		mean += p.x * p.n
+	}
+	mean /= n
+	variance := 0.0
+	for _, p := range points {
+		variance += (p.x - mean) * (p.x - mean) * p.n
+	}
+	variance /= n
+	fit.Min, fit.Max = points[0].x, points[len(points)-1].x
+	if stats.Quantiles != nil {
+		fit.Min, fit.Max = stats.Quantiles.Min(), stats.Quantiles.Max()
+	}
+
+	type candidate struct {
+		dist  models.DistributionType
+		model models.ContinuousModel
+		logL  float64
+		k     int
+	}
+	var candidates []candidate
+	add := func(dist models.DistributionType, m models.ContinuousModel, logL float64, k int) {
+		if !math.IsNaN(logL) && !math.IsInf(logL, 0) {
+			candidates = append(candidates, candidate{dist, m, logL, k})
+		}
+	}
+
+	// Normal
+	if variance > 0 {
+		m := *fit
+		m.Mu, m.Sigma = mean, math.Sqrt(variance)
+		logL := 0.0
+		for _, p := range points {
+			logL += p.n * normalLogPDF(p.x, m.Mu, m.Sigma)
+		}
+		add(models.DistNormal, m, logL, 2)
+	}
+
+	// Lognormal
+	if fit.Min > 0 {
+		logMean, logVar := 0.0, 0.0
+		for _, p := range points {
+			logMean += math.Log(p.x) * p.n
+		}
+		logMean /= n
+		for _, p := range points {
+			d := math.Log(p.x) - logMean
+			logVar += d * d * p.n
+		}
+		logVar /= n
+		if logVar > 0 {
+			m := *fit
+			m.Mu, m.Sigma = logMean, math.Sqrt(logVar)
+			logL := 0.0
+			for _, p := range points {
+				logL += p.n * (normalLogPDF(math.Log(p.x), m.Mu, m.Sigma) - math.Log(p.x))
+			}
+			add(models.DistLogNormal, m, logL, 2)
+		}
+	}
+
+	// Exponential, starting at the smallest value
+	if mean > fit.Min {
+		m := *fit
+		m.Rate = 1 / (mean - fit.Min)
+		logL := 0.0
+		for _, p := range points {
+			logL += p.n * (math.Log(m.Rate) - m.Rate*(p.x-fit.Min))
+		}
+		add(models.DistExponential, m, logL, 2)
+	}
+
+	// Uniform
+	width := fit.Max - fit.Min
+	if width > 0 {
+		add(models.DistUniform, *fit, -n*math.Log(width), 2)
+
+		// Histogram of equal-width bins
+		bins := int(math.Ceil(2 * math.Cbrt(n)))
+		if bins < 2 {
+			bins = 2
+		}
+		if bins > maxHistogramBins {
+			bins = maxHistogramBins
+		}
+		m := *fit
+		m.BinEdges = make([]float64, bins+1)
+		m.BinCounts = make([]int, bins)
+		for i := range m.BinEdges {
+			m.BinEdges[i] = fit.Min + width*float64(i)/float64(bins)
+		}
+		m.BinEdges[bins] = fit.Max
+		for _, p := range points {
+			m.BinCounts[histogramBin(m.BinEdges, p.x)] += int(p.n)
+		}
+		binWidth := width / float64(bins)
+		logL := 0.0
+		for _, c := range m.BinCounts {
+			if c > 0 {
+				logL += float64(c) * math.Log(float64(c)/(n*binWidth))
+			}
+		}
+		add(models.DistHistogram, m, logL, bins+1)
+	}
+
+	if len(candidates) == 0 {
+		return nil, ""
+	}
+	best := -1
+	for i := range candidates {
+		candidates[i].model.AIC = 2*float64(candidates[i].k) - 2*candidates[i].logL
+		if best < 0 || candidates[i].model.AIC < candidates[best].model.AIC {
+			best = i
+		}
+	}
+	chosen := candidates[best]
+	chosen.model.KS = ksDistance(points, n, func(x float64) float64 { return continuousCDF(chosen.dist, &chosen.model, x) })
+	return &chosen.model, chosen.dist
+}
+
+func normalLogPDF(x, mu, sigma float64) float64 {
+	z := (x - mu) / sigma
+	return -0.5*z*z - math.Log(sigma) - 0.5*math.Log(2*math.Pi)
+}
+
+// histogramBin returns the bin of x, counting the last edge into the last bin.
+func histogramBin(edges []float64, x float64) int {
+	i := sort.SearchFloat64s(edges, x)
+	if i < len(edges) && edges[i] == x {
+		i++
+	}
+	i--
+	if i < 0 {
+		return 0
+	}
+	if i >= len(edges)-1 {
+		return len(edges) - 2
+	}
+	return i
+}
+
+// continuousCDF is the cumulative distribution function of a fitted model.
+func continuousCDF(dist models.DistributionType, m *models.ContinuousModel, x float64) float64 {
+	switch dist {
+	case models.DistNormal:
+		return 0.5 * (1 + math.Erf((x-m.Mu)/(m.Sigma*math.Sqrt2)))
+	case models.DistLogNormal:
+		if x <= 0 {
+			return 0
+		}
+		return 0.5 * (1 + math.Erf((math.Log(x)-m.Mu)/(m.Sigma*math.Sqrt2)))
+	case models.DistExponential:
+		if x < m.Min {
+			return 0
+		}
+		return 1 - math.Exp(-m.Rate*(x-m.Min))
+	case models.DistHistogram:
+		if x < m.Min {
+			return 0
+		}
+		if x >= m.Max {
+			return 1
+		}
+		total := 0
+		for _, c := range m.BinCounts {
+			total += c
+		}
+		i := histogramBin(m.BinEdges, x)
+		below := 0
+		for _, c := range m.BinCounts[:i] {
+			below += c
+		}
+		frac := (x - m.BinEdges[i]) / (m.BinEdges[i+1] - m.BinEdges[i])
+		return (float64(below) + frac*float64(m.BinCounts[i])) / float64(total)
+	default: // uniform
+		if x <= m.Min {
+			return 0
+		}
+		if x >= m.Max {
+			return 1
+		}
+		return (x - m.Min) / (m.Max - m.Min)
+	}
+}
+
+// ksDistance is the Kolmogorov-Smirnov statistic of sorted weighted points against a CDF.
+func ksDistance(points []weightedPoint, n float64, cdf func(float64) float64) float64 {
+	d, cum := 0.0, 0.0
+	for _, p := range points {
+		f := cdf(p.x)
+		d = math.Max(d, math.Abs(f-cum/n))
+		cum += p.n
+		d = math.Max(d, math.Abs(f-cum/n))
+	}
+	return d
+}
@@ -0,0 +1,107 @@
+package services
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+)
+
+func continuousStats(typ ParamType, n int, gen func(r *rand.Rand) interface{}) *ParameterStats {
+	r := rand.New(rand.NewSource(42))
+	stats := &ParameterStats{ParamName: ":p1", Type: typ, ValueCounts: make(map[interface{}]int)}
+	for i := 0; i < n; i++ {
+		stats.ValueCounts[gen(r)]++
+		stats.TotalCount++
+	}
+	return stats
+}
+
+func TestFitContinuous_Families(t *testing.T) {
+	cases := []struct {
+		name string
+		want models.DistributionType
+		gen  func(r *rand.Rand) interface{}
+	}{
+		{"normal", models.DistNormal, func(r *rand.Rand) interface{} { return 100 + 15*r.NormFloat64() }},
+		{"lognormal", models.DistLogNormal, func(r *rand.Rand) interface{} { return math.Exp(3 + r.NormFloat64()) }},
+		{"exponential", models.DistExponential, func(r *rand.Rand) interface{} { return 5 + r.ExpFloat64()*20 }},
+		{"uniform", models.DistUniform, func(r *rand.Rand) interface{} { return r.Float64() * 1000 }},
+		{"bimodal", models.DistHistogram, func(r *rand.Rand) interface{} {
+			if r.Intn(2) == 0 {
+				return 10 + r.NormFloat64()
+			}
+			return 50 + r.NormFloat64()
+		}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			fit, dist := fitContinuous(continuousStats(ParamTypeFloat, 5000, c.gen))
+			require.NotNil(t, fit)
+			assert.Equal(t, c.want, dist)
+			assert.Equal(t, "float", fit.ValueKind)
+			assert.Less(t, fit.KS, 0.05)
+		})
+	}
+}
+
+func TestFitContinuous_Skipped(t *testing.T) {
+	// Hot keys repeat and are replayed from the top values.
+	fit, _ := fitContinuous(continuousStats(ParamTypeInt, 5000, func(r *rand.Rand) interface{} { return r.Intn(100) }))
+	assert.Nil(t, fit)
+	// Too few distinct values.
+	fit, _ = fitContinuous(continuousStats(ParamTypeFloat, 10, func(r *rand.Rand) interface{} { return r.Float64() }))
+	assert.Nil(t, fit)
+	// Not numbers or times.
+	fit, _ = fitContinuous(continuousStats(ParamTypeString, 100, func(r *rand.Rand) interface{} { return string(rune('a' + r.Intn(26))) }))
+	assert.Nil(t, fit)
+}
+
+func TestContinuousSampler(t *testing.T) {
+	detector := NewHotspotDetector()
+	sampler := NewSeededContinuousSampler(1)
+
+	t.Run("Integers", func(t *testing.T) {
+		model := detector.DetectDistribution(continuousStats(ParamTypeInt, 2000, func(r *rand.Rand) interface{} { return 1000 + r.Intn(100000) }))
+		require.NotNil(t, model.Continuous)
+		assert.Equal(t, "INT", model.DataType)
+
+		observed := make(map[interface{}]bool)
+		for _, v := range model.TopValues {
+			observed[v] = true
+		}
+		unseen := 0
+		for i := 0; i < 1000; i++ {
+			v, err := sampler.Sample(model)
+			require.NoError(t, err)
+			n := v.(int)
+			assert.GreaterOrEqual(t, float64(n), model.Continuous.Min)
+			assert.LessOrEqual(t, float64(n), model.Continuous.Max)
+			if !observed[n] {
+				unseen++
+			}
+		}
+		assert.Greater(t, unseen, 500, "values outside the observed ones are generated")
+	})
+
+	t.Run("Times", func(t *testing.T) {
+		base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		model := detector.DetectDistribution(continuousStats(ParamTypeDatetime, 2000, func(r *rand.Rand) interface{} {
+			return base.Add(time.Duration(r.Intn(30*86400)) * time.Second).Format("2006-01-02 15:04:05")
+		}))
+		require.NotNil(t, model.Continuous)
+		assert.Equal(t, "time", model.Continuous.ValueKind)
+
+		for i := 0; i < 100; i++ {
+			v, err := sampler.Sample(model)
+			require.NoError(t, err)
+			ts, err := time.Parse("2006-01-02 15:04:05", v.(string))
+			require.NoError(t, err)
+			assert.False(t, ts.Before(base) || ts.After(base.Add(30*24*time.Hour)), ts)
+		}
+	})
+}
@@ -0,0 +1,716 @@
+package services
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/turtacn/SQLTraceBench/pkg/types"
+	"github.com/xwb1989/sqlparser"
+)
+
+// QueryFingerprint is the normalized form of a SQL statement shared by all executions
+// that differ only in their literal values.
+type QueryFingerprint struct {
+	// Query is the statement with its literals replaced by named placeholders.
+	Query string
+	// Parameters holds the value of each placeholder. A collapsed IN list or
+	// multi-row VALUES column holds all of its values as a []interface{}.
+	Parameters map[string]interface{}
+}
+
+// positionalParamRe matches the placeholders numbered by position: :p1 (rewritten
+// from $1 or prepared statement markers) and :v1 (the parser's name for ?).
+var positionalParamRe = regexp.MustCompile(`^:[pv][0-9]+$`)
+
+// FingerprintQuery normalizes a SQL statement. Literals are replaced with :p1, :p2, ...
+// in order of appearance, IN lists and the rows of a multi-row INSERT are collapsed
+// to a single placeholder each, comments are dropped and whitespace and keyword
+// case are normalized. params holds the bound values of placeholders already in
+// the query; positional placeholders are renumbered along with the literals, named
+// ones keep their names.
+//
+// SELECT, INSERT, UPDATE and DELETE statements are normalized from their syntax
+// tree. Other statements, and those the MySQL grammar cannot parse (e.g. PostgreSQL
+// casts), are normalized token by token.
+func FingerprintQuery(query string, params map[string]interface{}) QueryFingerprint {
+	return fingerprintQuery(query, params, types.DatabaseNone)
+}
+
+// fingerprintQuery normalizes a statement of the given dialect. The MySQL grammar
+// reads double-quoted text as a string literal, so statements of the dialects in
+// which it is an identifier are always normalized token by token.
+func fingerprintQuery(query string, params map[string]interface{}, dialect types.DatabaseType) QueryFingerprint {
+	f := &fingerprinter{params: params, out: make(map[string]interface{})}
+	normalized, ok := "", false
+	if dialect != types.DatabasePostgreSQL && dialect != types.DatabaseClickHouse {
+		normalized, ok = f.fromSyntaxTree(query)
+	}
+	if !ok {
+		f = &fingerprinter{params: params, out: make(map[string]interface{})}
+		normalized = f.fromTokens(query)
+	}
+	fp := QueryFingerprint{Query: normalized}
+	if len(f.out) > 0 {
+		fp.Parameters = f.out
+	}
+	return fp
+}
+
+type fingerprinter struct {
+	params map[string]interface{}
+	out    map[string]interface{}
+	n      int
+	// lists holds the values of the placeholders that replaced collapsed lists.
+	lists map[*sqlparser.SQLVal][]interface{}
+	// ordinals are the column positions of GROUP BY and ORDER BY clauses, which
+	// are not values.
+	ordinals map[*sqlparser.SQLVal]bool
+}
+
+// nextName returns the next positional placeholder name.
+func (f *fingerprinter) nextName() string {
+	f.n++
+	return ":p" + strconv.Itoa(f.n)
+}
+
+func (f *fingerprinter) fromSyntaxTree(query string) (string, bool) {
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return "", false
+	}
+	switch stmt.(type) {
+	case *sqlparser.Select, *sqlparser.Union, *sqlparser.ParenSelect,
+		*sqlparser.Insert, *sqlparser.Update, *sqlparser.Delete:
+	default:
+		// The parser keeps only a summary of other statements.
+		return "", false
+	}
+	f.lists = make(map[*sqlparser.SQLVal][]interface{})
+	f.ordinals = make(map[*sqlparser.SQLVal]bool)
+	_ = sqlparser.Walk(f.visit, stmt)
+	return sqlparser.String(stmt), true
+}
+
+func (f *fingerprinter) visit(node sqlparser.SQLNode) (bool, error) {
+	switch node := node.(type) {
+	case *sqlparser.Select:
+		node.Comments = nil
+	case *sqlparser.Insert:
+		node.Comments = nil
+		f.collapseRows(node)
+	case *sqlparser.Update:
+		node.Comments = nil
+	case *sqlparser.Delete:
+		node.Comments = nil
+	case sqlparser.GroupBy:
+		for _, expr := range node {
+			f.markOrdinal(expr)
+		}
+	case *sqlparser.Order:
+		f.markOrdinal(node.Expr)
+	case *sqlparser.FuncExpr:
+		node.Name = sqlparser.NewColIdent(node.Name.Lowered())
+	case *sqlparser.UnaryExpr:
+		// Fold the sign into a negative number so it becomes part of the value.
+		if val, ok := node.Expr.(*sqlparser.SQLVal); ok && node.Operator == sqlparser.UMinusStr &&
+			(val.Type == sqlparser.IntVal || val.Type == sqlparser.FloatVal) {
+			val.Val = append([]byte("-"), val.Val...)
+			node.Operator = ""
+		}
+	case *sqlparser.ComparisonExpr:
+		if node.Operator == sqlparser.InStr || node.Operator == sqlparser.NotInStr {
+			if tuple, ok := node.Right.(sqlparser.ValTuple); ok && len(tuple) > 1 {
+				if values, ok := f.tupleValues(tuple); ok {
+					node.Right = sqlparser.ValTuple{f.listPlaceholder(values)}
+				}
+			}
+		}
+	case *sqlparser.SQLVal:
+		f.bind(node)
+	}
+	return true, nil
+}
+
+func (f *fingerprinter) markOrdinal(expr sqlparser.Expr) {
+	if val, ok := expr.(*sqlparser.SQLVal); ok && val.Type == sqlparser.IntVal {
+		f.ordinals[val] = true
+	}
+}
+
+// collapseRows reduces the rows of a multi-row INSERT to one. Each column of
+// literals or placeholders becomes a single placeholder holding the values of all
+// rows; other columns must be written identically in every row.
+func (f *fingerprinter) collapseRows(ins *sqlparser.Insert) {
+	rows, ok := ins.Rows.(sqlparser.Values)
+	if !ok || len(rows) < 2 {
+		return
+	}
+	width := len(rows[0])
+	for _, row := range rows[1:] {
+		if len(row) != width {
+			return
+		}
+	}
+	collapsed := make(sqlparser.ValTuple, width)
+	for col := 0; col < width; col++ {
+		column := make(sqlparser.ValTuple, len(rows))
+		for i, row := range rows {
+			column[i] = row[col]
+		}
+		if values, ok := f.tupleValues(column); ok {
+			collapsed[col] = f.listPlaceholder(values)
+			continue
+		}
+		first := sqlparser.String(column[0])
+		for _, expr := range column[1:] {
+			if sqlparser.String(expr) != first {
+				return
+			}
+		}
+		collapsed[col] = column[0]
+	}
+	ins.Rows = sqlparser.Values{collapsed}
+}
+
+// tupleValues returns the values of a list made only of literals and positional
+// placeholders. Placeholders without a bound value are left out.
+func (f *fingerprinter) tupleValues(tuple sqlparser.ValTuple) ([]interface{}, bool) {
+	values := make([]interface{}, 0, len(tuple))
+	for _, expr := range tuple {
+		if unary, ok := expr.(*sqlparser.UnaryExpr); ok && unary.Operator == sqlparser.UMinusStr {
+			val, ok := unary.Expr.(*sqlparser.SQLVal)
+			if !ok || val.Type != sqlparser.IntVal && val.Type != sqlparser.FloatVal {
+				return nil, false
+			}
+			values = append(values, literalValue(val.Type, "-"+string(val.Val)))
+			continue
+		}
+		val, ok := expr.(*sqlparser.SQLVal)
+		if !ok {
+			return nil, false
+		}
+		switch {
+		case val.Type == sqlparser.StrVal || val.Type == sqlparser.IntVal || val.Type == sqlparser.FloatVal:
+			values = append(values, literalValue(val.Type, string(val.Val)))
+		case val.Type == sqlparser.ValArg && positionalParamRe.Match(val.Val):
+			if v, ok := f.params[string(val.Val)]; ok {
+				values = append(values, v)
+			}
+		default:
+			return nil, false
+		}
+	}
+	return values, true
+}
+
+func (f *fingerprinter) listPlaceholder(values []interface{}) *sqlparser.SQLVal {
+	marker := &sqlparser.SQLVal{Type: sqlparser.ValArg}
+	f.lists[marker] = values
+	return marker
+}
+
+// bind replaces a literal with the next placeholder and records its value.
+func (f *fingerprinter) bind(val *sqlparser.SQLVal) {
+	if values, ok := f.lists[val]; ok {
+		name := f.nextName()
+		val.Val = []byte(name)
+		if len(values) > 0 {
+			f.out[name] = values
+		}
+		return
+	}
+	if f.ordinals[val] {
+		return
+	}
+	switch val.Type {
+	case sqlparser.StrVal, sqlparser.IntVal, sqlparser.FloatVal:
+		name := f.nextName()
+		f.out[name] = literalValue(val.Type, string(val.Val))
+		val.Type, val.Val = sqlparser.ValArg, []byte(name)
+	case sqlparser.ValArg:
+		val.Val = []byte(f.placeholder(string(val.Val)))
+	}
+}
+
+// placeholder renumbers a positional placeholder and carries over its bound value.
+func (f *fingerprinter) placeholder(name string) string {
+	renamed := name
+	if positionalParamRe.MatchString(name) {
+		renamed = f.nextName()
+	}
+	if v, ok := f.params[name]; ok {
+		f.out[renamed] = v
+	}
+	return renamed
+}
+
+func literalValue(typ sqlparser.ValType, text string) interface{} {
+	switch typ {
+	case sqlparser.IntVal:
+		if v, err := strconv.ParseInt(text, 10, 64); err == nil {
+			return v
+		}
+	case sqlparser.FloatVal:
+		if v, err := strconv.ParseFloat(text, 64); err == nil {
+			return v
+		}
+	}
+	return text
+}
+
+// fpToken is a token of the lexical fallback.
+type fpToken struct {
+	kind  fpTokenKind
+	text  string
+	value interface{}
+	// values holds the values of a collapsed IN list or VALUES column.
+	values []interface{}
+}
+
+type fpTokenKind int
+
+const (
+	fpWord fpTokenKind = iota
+	fpQuoted
+	fpString
+	fpNumber
+	fpParam
+	fpList
+	fpPunct
+)
+
+// fpOperators are the multi-character operators kept as one token.
+var fpOperators = []string{"::", "<=>", "<>", "!=", "<=", ">=", "||", "->>", "->", "#>>", "#>", "@>", "<@", ":="}
+
+// fpSignContext holds the keywords after which a minus sign starts a negative number.
+var fpSignContext = map[string]bool{
+	"select": true, "where": true, "and": true, "or": true, "not": true, "in": true, "values": true,
+	"between": true, "like": true, "when": true, "then": true, "else": true, "by": true, "set": true,
+	"limit": true, "offset": true, "return": true, "is": true,
+}
+
+// fpSpacedKeywords are followed by a space before an opening parenthesis; other
+// words directly followed by one are function names.
+var fpSpacedKeywords = map[string]bool{
+	"in": true, "values": true, "from": true, "join": true, "where": true, "and": true, "or": true,
+	"not": true, "exists": true, "as": true, "on": true, "using": true, "select": true, "set": true,
+	"into": true, "table": true, "with": true, "union": true, "all": true, "any": true, "some": true,
+	"over": true, "having": true, "when": true, "then": true, "else": true, "by": true, "returning": true,
+	"lateral": true, "distinct": true, "is": true, "like": true, "between": true,
+}
+
+func (f *fingerprinter) fromTokens(query string) string {
+	tokens := lexFingerprint(query)
+	markOrdinals(tokens)
+	tokens = collapseInLists(tokens, f.params)
+	tokens = collapseValuesRows(tokens, f.params)
+
+	var b strings.Builder
+	var prev *fpToken
+	for i := range tokens {
+		tok := &tokens[i]
+		text := tok.text
+		switch tok.kind {
+		case fpString, fpNumber:
+			text = f.nextName()
+			f.out[text] = tok.value
+		case fpList:
+			text = f.nextName()
+			if len(tok.values) > 0 {
+				f.out[text] = tok.values
+			}
+		case fpParam:
+			text = f.placeholder(tok.text)
+		}
+		if prev != nil && fpSpaced(prev, tok) {
+			b.WriteByte(' ')
+		}
+		b.WriteString(text)
+		prev = tok
+	}
+	return b.String()
+}
+
+// fpSpaced reports whether a space separates two tokens of the normalized query.
+func fpSpaced(prev, tok *fpToken) bool {
+	if prev.kind == fpPunct && (prev.text == "(" || prev.text == "." || prev.text == "::") {
+		return false
+	}
+	if tok.kind == fpPunct {
+		switch tok.text {
+		case ",", ")", ".", "::", ";":
+			return false
+		case "(":
+			return !(prev.kind == fpWord && !fpSpacedKeywords[prev.text] || prev.kind == fpQuoted)
+		}
+	}
+	return true
+}
+
+// lexFingerprint splits a statement into tokens, dropping comments and a trailing
+// semicolon. Unquoted words are lowercased; $n placeholders become :pn.
+func lexFingerprint(query string) []fpToken {
+	var tokens []fpToken
+	qmarks := 0
+	signAllowed := func() bool {
+		if len(tokens) == 0 {
+			return true
+		}
+		last := tokens[len(tokens)-1]
+		switch last.kind {
+		case fpPunct:
+			return last.text != ")"
+		case fpWord:
+			return fpSignContext[last.text]
+		}
+		return false
+	}
+
+	for i := 0; i < len(query); {
+		c := query[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			i++
+		case c == '-' && i+1 < len(query) && query[i+1] == '-', c == '#' && (i+1 >= len(query) || query[i+1] != '>'):
+			for i < len(query) && query[i] != '\n' {
+				i++
+			}
+		case c == '/' && i+1 < len(query) && query[i+1] == '*':
+			end := strings.Index(query[i+2:], "*/")
+			if end < 0 {
+				i = len(query)
+			} else {
+				i += end + 4
+			}
+		case c == '\'':
+			end := scanQuoted(query, i)
+			tokens = append(tokens, fpToken{kind: fpString, value: unquoteSQLString(query[i:end])})
+			i = end
+		case c == '"' || c == '`':
+			end := scanQuoted(query, i)
+			tokens = append(tokens, fpToken{kind: fpQuoted, text: query[i:end]})
+			i = end
+		case c >= '0' && c <= '9', c == '.' && i+1 < len(query) && isDigit(query[i+1]),
+			c == '-' && i+1 < len(query) && (isDigit(query[i+1]) || query[i+1] == '.') && signAllowed():
+			end := scanNumber(query, i)
+			text := query[i:end]
+			tokens = append(tokens, fpToken{kind: fpNumber, text: text, value: numberValue(text)})
+			i = end
+		case c == '?':
+			qmarks++
+			tokens = append(tokens, fpToken{kind: fpParam, text: ":v" + strconv.Itoa(qmarks)})
+			i++
+		case c == '$' && i+1 < len(query) && isDigit(query[i+1]):
+			end := i + 1
+			for end < len(query) && isDigit(query[end]) {
+				end++
+			}
+			tokens = append(tokens, fpToken{kind: fpParam, text: ":p" + query[i+1:end]})
+			i = end
+		case c == ':' && i+1 < len(query) && isIdentStart(query[i+1]) && (i == 0 || query[i-1] != ':'):
+			end := i + 1
+			for end < len(query) && isSQLWordByte(query[end]) {
+				end++
+			}
+			tokens = append(tokens, fpToken{kind: fpParam, text: query[i:end]})
+			i = end
+		case isSQLWordByte(c):
+			end := i
+			for end < len(query) && isSQLWordByte(query[end]) {
+				end++
+			}
+			tokens = append(tokens, fpToken{kind: fpWord, text: strings.ToLower(query[i:end])})
+			i = end
+		default:
+			op := string(c)
+			for _, candidate := range fpOperators {
+				if strings.HasPrefix(query[i:], candidate) {
+					op = candidate
+					break
+				}
+			}
+			tokens = append(tokens, fpToken{kind: fpPunct, text: op})
+			i += len(op)
+		}
+	}
+	for len(tokens) > 0 && tokens[len(tokens)-1].kind == fpPunct && tokens[len(tokens)-1].text == ";" {
+		tokens = tokens[:len(tokens)-1]
+	}
+	return tokens
+}
+
+// markOrdinals turns the column positions of GROUP BY and ORDER BY clauses, which
+// are not values, into plain words.
+func markOrdinals(tokens []fpToken) {
+	ordering := false
+	for i := 1; i < len(tokens); i++ {
+		tok, prev := &tokens[i], tokens[i-1]
+		switch {
+		case tok.kind == fpWord && tok.text == "by":
+			ordering = prev.text == "group" || prev.text == "order"
+		case tok.kind == fpNumber && ordering && (prev.text == "by" || prev.text == ","):
+			tok.kind = fpWord
+		case tok.kind == fpWord && fpClauseEnd[tok.text], tok.kind == fpPunct && tok.text == ")":
+			ordering = false
+		}
+	}
+}
+
+// fpClauseEnd holds the keywords that end a GROUP BY or ORDER BY clause.
+var fpClauseEnd = map[string]bool{
+	"having": true, "limit": true, "offset": true, "union": true, "window": true, "for": true, "fetch": true,
+}
+
+// collapseInLists replaces IN lists of two or more literals or positional
+// placeholders with a single list token.
+func collapseInLists(tokens []fpToken, params map[string]interface{}) []fpToken {
+	out := make([]fpToken, 0, len(tokens))
+	for i := 0; i < len(tokens); i++ {
+		out = append(out, tokens[i])
+		if tokens[i].kind != fpWord || tokens[i].text != "in" {
+			continue
+		}
+		if values, end, ok := inListValues(tokens, i+1, params); ok {
+			out = append(out, tokens[i+1], fpToken{kind: fpList, values: values}, tokens[end])
+			i = end
+		}
+	}
+	return out
+}
+
+// inListValues reads a parenthesized list of two or more literals or positional
+// placeholders starting at tokens[i]. end is the index of the closing parenthesis.
+// Placeholders without a bound value are left out of values.
+func inListValues(tokens []fpToken, i int, params map[string]interface{}) (values []interface{}, end int, ok bool) {
+	if i >= len(tokens) || tokens[i].kind != fpPunct || tokens[i].text != "(" {
+		return nil, 0, false
+	}
+	n := 0
+	for j := i + 1; j+1 < len(tokens); j += 2 {
+		tok := tokens[j]
+		switch {
+		case tok.kind == fpString || tok.kind == fpNumber:
+			values = append(values, tok.value)
+		case tok.kind == fpParam && positionalParamRe.MatchString(tok.text):
+			if v, ok := params[tok.text]; ok {
+				values = append(values, v)
+			}
+		default:
+			return nil, 0, false
+		}
+		n++
+		switch sep := tokens[j+1]; {
+		case sep.kind == fpPunct && sep.text == ")":
+			return values, j + 1, n >= 2
+		case sep.kind != fpPunct || sep.text != ",":
+			return nil, 0, false
+		}
+	}
+	return nil, 0, false
+}
+
+// collapseValuesRows reduces the rows of a multi-row VALUES list to one, as
+// collapseRows does for the syntax tree. Each column of literals or positional
+// placeholders becomes a single list token; other columns must be written
+// identically in every row.
+func collapseValuesRows(tokens []fpToken, params map[string]interface{}) []fpToken {
+	out := make([]fpToken, 0, len(tokens))
+	for i := 0; i < len(tokens); i++ {
+		out = append(out, tokens[i])
+		if tokens[i].kind != fpWord || tokens[i].text != "values" {
+			continue
+		}
+		rows, end := valuesRows(tokens, i+1)
+		if len(rows) < 2 {
+			continue
+		}
+		if row, ok := collapseColumns(rows, params); ok {
+			out = append(out, fpToken{kind: fpPunct, text: "("})
+			for col, cell := range row {
+				if col > 0 {
+					out = append(out, fpToken{kind: fpPunct, text: ","})
+				}
+				out = append(out, cell...)
+			}
+			out = append(out, fpToken{kind: fpPunct, text: ")"})
+			i = end
+		}
+	}
+	return out
+}
+
+// valuesRows reads the comma-separated parenthesized rows starting at tokens[i],
+// each split into the tokens of its cells. end is the index of the closing
+// parenthesis of the last row.
+func valuesRows(tokens []fpToken, i int) (rows [][][]fpToken, end int) {
+	for i < len(tokens) && tokens[i].kind == fpPunct && tokens[i].text == "(" {
+		row, j := valuesRow(tokens, i+1)
+		if row == nil {
+			break
+		}
+		rows, end = append(rows, row), j
+		if j+1 >= len(tokens) || tokens[j+1].kind != fpPunct || tokens[j+1].text != "," {
+			break
+		}
+		i = j + 2
+	}
+	return rows, end
+}
+
+// valuesRow splits the cells of a row starting after its opening parenthesis at
+// top-level commas. end is the index of its closing parenthesis.
+func valuesRow(tokens []fpToken, i int) (row [][]fpToken, end int) {
+	var cell []fpToken
+	depth := 0
+	for j := i; j < len(tokens); j++ {
+		tok := tokens[j]
+		if tok.kind == fpPunct {
+			switch {
+			case tok.text == "(":
+				depth++
+			case tok.text == ")" && depth == 0:
+				if len(cell) == 0 {
+					return nil, 0
+				}
+				return append(row, cell), j
+			case tok.text == ")":
+				depth--
+			case tok.text == "," && depth == 0:
+				if len(cell) == 0 {
+					return nil, 0
+				}
+				row, cell = append(row, cell), nil
+				continue
+			}
+		}
+		cell = append(cell, tok)
+	}
+	return nil, 0
+}
+
+// collapseColumns merges rows of equal width into one, or reports false if a
+// column is neither made of values nor written identically in every row.
+func collapseColumns(rows [][][]fpToken, params map[string]interface{}) ([][]fpToken, bool) {
+	width := len(rows[0])
+	for _, row := range rows[1:] {
+		if len(row) != width {
+			return nil, false
+		}
+	}
+	collapsed := make([][]fpToken, width)
+	for col := 0; col < width; col++ {
+		if values, ok := columnValues(rows, col, params); ok {
+			collapsed[col] = []fpToken{{kind: fpList, values: values}}
+			continue
+		}
+		for _, row := range rows[1:] {
+			if !sameTokens(row[col], rows[0][col]) {
+				return nil, false
+			}
+		}
+		collapsed[col] = rows[0][col]
+	}
+	return collapsed, true
+}
+
+// columnValues returns the values of a column whose every cell is a single literal
+// or positional placeholder. Placeholders without a bound value are left out.
+func columnValues(rows [][][]fpToken, col int, params map[string]interface{}) ([]interface{}, bool) {
+	values := make([]interface{}, 0, len(rows))
+	for _, row := range rows {
+		if len(row[col]) != 1 {
+			return nil, false
+		}
+		switch tok := row[col][0]; {
+		case tok.kind == fpString || tok.kind == fpNumber:
+			values = append(values, tok.value)
+		case tok.kind == fpParam && positionalParamRe.MatchString(tok.text):
+			if v, ok := params[tok.text]; ok {
+				values = append(values, v)
+			}
+		default:
+			return nil, false
+		}
+	}
+	return values, true
+}
+
+// sameTokens reports whether two cells are written identically.
+func sameTokens(a, b []fpToken) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].kind != b[i].kind || a[i].text != b[i].text {
+			return false
+		}
+		if (a[i].kind == fpString || a[i].kind == fpNumber) && a[i].value != b[i].value {
+			return false
+		}
+	}
+	return true
+}
+
+func scanNumber(query string, i int) int {
+	end := i
+	if query[end] == '-' {
+		end++
+	}
+	for end < len(query) && (isDigit(query[end]) || query[end] == '.') {
+		end++
+	}
+	if end < len(query) && (query[end] == 'e' || query[end] == 'E') {
+		exp := end + 1
+		if exp < len(query) && (query[exp] == '+' || query[exp] == '-') {
+			exp++
+		}
+		if exp < len(query) && isDigit(query[exp]) {
+			end = exp
+			for end < len(query) && isDigit(query[end]) {
+				end++
+			}
+		}
+	}
+	return end
+}
+
+func numberValue(text string) interface{} {
+	if v, err := strconv.ParseInt(text, 10, 64); err == nil {
+		return v
+	}
+	if v, err := strconv.ParseFloat(text, 64); err == nil {
+		return v
+	}
+	return text
+}
+
+// unquoteSQLString returns the content of a single-quoted literal, resolving
+// doubled quotes and backslash escapes.
+func unquoteSQLString(literal string) string {
+	if len(literal) < 2 || literal[len(literal)-1] != '\'' {
+		return strings.TrimPrefix(literal, "'")
+	}
+	body := literal[1 : len(literal)-1]
+	if !strings.ContainsAny(body, `'\`) {
+		return body
+	}
+	var b strings.Builder
+	for i := 0; i < len(body); i++ {
+		c := body[i]
+		if (c == '\\' || c == '\'') && i+1 < len(body) {
+			i++
+			c = body[i]
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
@@ -0,0 +1,79 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/turtacn/SQLTraceBench/pkg/types"
+)
+
+func TestFingerprintQuery(t *testing.T) {
+	fp := FingerprintQuery("SELECT count(*), Name FROM Users AS u /* hint */ WHERE u.id IN (1, 2, 3) AND x = -5 AND y = 'it''s' LIMIT 10", nil)
+	assert.Equal(t, "select count(*), Name from Users as u where u.id in (:p1) and x = :p2 and y = :p3 limit :p4", fp.Query)
+	assert.Equal(t, map[string]interface{}{
+		":p1": []interface{}{int64(1), int64(2), int64(3)},
+		":p2": int64(-5),
+		":p3": "it's",
+		":p4": int64(10),
+	}, fp.Parameters)
+
+	// IN-lists of any length share a fingerprint.
+	assert.Equal(t, fp.Query, FingerprintQuery("select COUNT(*), Name from Users as u where u.id in (7) and x = 1 and y = 'a' limit 5", nil).Query)
+}
+
+func TestFingerprintQuery_BoundParameters(t *testing.T) {
+	fp := FingerprintQuery("SELECT * FROM t WHERE a = 'x' AND b = :p1 AND c = :name", map[string]interface{}{":p1": 7, ":name": "n"})
+	assert.Equal(t, "select * from t where a = :p1 and b = :p2 and c = :name", fp.Query)
+	assert.Equal(t, map[string]interface{}{":p1": "x", ":p2": 7, ":name": "n"}, fp.Parameters)
+
+	// A fingerprinted trace fingerprints to itself.
+	again := FingerprintQuery(fp.Query, fp.Parameters)
+	assert.Equal(t, fp, again)
+}
+
+func TestFingerprintQuery_Ordinals(t *testing.T) {
+	fp := FingerprintQuery("select region, count(*) from orders where total > 100 group by 1 order by 2 desc", nil)
+	assert.Equal(t, "select region, count(*) from orders where total > :p1 group by 1 order by 2 desc", fp.Query)
+	assert.Equal(t, map[string]interface{}{":p1": int64(100)}, fp.Parameters)
+}
+
+func TestFingerprintQuery_MultiRowInsert(t *testing.T) {
+	fp := FingerprintQuery("INSERT INTO t (a, b, c) VALUES (1, 'x', now()), (2, 'y', now())", nil)
+	assert.Equal(t, "insert into t(a, b, c) values (:p1, :p2, now())", fp.Query)
+	assert.Equal(t, []interface{}{int64(1), int64(2)}, fp.Parameters[":p1"])
+	assert.Equal(t, []interface{}{"x", "y"}, fp.Parameters[":p2"])
+
+	// The tokenizer collapses the rows of PostgreSQL inserts the same way.
+	fp = NewTemplateService().ForDialect(types.DatabasePostgreSQL).Fingerprint(
+		"INSERT INTO t (a, b, c) VALUES (1, 'x', now()::date), (2, $1, now()::date), (-3, 'z', now()::date) RETURNING id",
+		map[string]interface{}{":p1": "y"})
+	assert.Equal(t, "insert into t(a, b, c) values (:p1, :p2, now()::date) returning id", fp.Query)
+	assert.Equal(t, []interface{}{int64(1), int64(2), int64(-3)}, fp.Parameters[":p1"])
+	assert.Equal(t, []interface{}{"x", "y", "z"}, fp.Parameters[":p2"])
+
+	// Rows of any number share a fingerprint; differing expressions are kept.
+	two := NewTemplateService().ForDialect(types.DatabasePostgreSQL).Fingerprint("INSERT INTO t (a, b, c) VALUES (7, 'q', now()::date), (8, 'r', now()::date) RETURNING id", nil)
+	assert.Equal(t, fp.Query, two.Query)
+	mixed := NewTemplateService().ForDialect(types.DatabasePostgreSQL).Fingerprint("INSERT INTO t (a, b) VALUES (1, now()), (2, 'x')", nil)
+	assert.Equal(t, "insert into t(a, b) values (:p1, now()), (:p2, :p3)", mixed.Query)
+}
+
+func TestFingerprintQuery_Fallback(t *testing.T) {
+	// PostgreSQL casts are not understood by the parser; the tokenizer still
+	// fingerprints the query.
+	fp := FingerprintQuery("SELECT created_at::date, COUNT(*) FROM orders -- daily\nWHERE region = 'eu' AND id IN ($1, $2) GROUP BY 1;", nil)
+	assert.Equal(t, "select created_at::date, count(*) from orders where region = :p1 and id in (:p2) group by 1", fp.Query)
+	assert.Equal(t, "eu", fp.Parameters[":p1"])
+}
+
+func TestTemplateService_Fingerprint_Dialect(t *testing.T) {
+	query := `SELECT "Name" FROM users WHERE id = 1`
+
+	// MySQL reads double-quoted text as a string, PostgreSQL as an identifier.
+	fp := NewTemplateService().Fingerprint(query, nil)
+	assert.Equal(t, "select :p1 from users where id = :p2", fp.Query)
+
+	fp = NewTemplateService().ForDialect(types.DatabasePostgreSQL).Fingerprint(query, nil)
+	assert.Equal(t, `select "Name" from users where id = :p1`, fp.Query)
+	assert.Equal(t, map[string]interface{}{":p1": int64(1)}, fp.Parameters)
+}
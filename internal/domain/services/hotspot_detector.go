@@ -22,10 +22,15 @@ func (d *HotspotDetector) DetectDistribution(stats *ParameterStats) *models.Para
 		ParamName:   stats.ParamName,
 		Cardinality: len(stats.ValueCounts),
 	}
+	if stats.Distinct > 0 {
+		model.Cardinality = stats.Distinct
+	}
 
 	switch stats.Type {
 	case ParamTypeInt:
 		model.DataType = "INT"
+	case ParamTypeFloat:
+		model.DataType = "FLOAT"
 	case ParamTypeDatetime:
 		model.DataType = "DATETIME"
 	default:
@@ -62,14 +67,22 @@ func (d *HotspotDetector) DetectDistribution(stats *ParameterStats) *models.Para
 	}
 
 	// Calculate Hotspot Ratio
-	top20Count := int(math.Ceil(float64(len(valueFreqs)) * 0.2))
+	top20Count := int(math.Ceil(float64(model.Cardinality) * 0.2))
 	if top20Count < 1 {
 		top20Count = 1
 	}
 
-	headSum := 0
-	for i := 0; i < top20Count && i < len(valueFreqs); i++ {
-		headSum += valueFreqs[i].Count
+	headSum, tracked := 0, 0
+	for i, vf := range valueFreqs {
+		if i < top20Count {
+			headSum += vf.Count
+		}
+		tracked += vf.Count
+	}
+	// When only the heavy hitters of a sketch are tracked, the rest of the head is
+	// counted at most as frequent as the least frequent of them.
+	if missing := top20Count - len(valueFreqs); missing > 0 && len(valueFreqs) > 0 {
+		headSum += min(missing*valueFreqs[len(valueFreqs)-1].Count, stats.TotalCount-tracked)
 	}
 
 	ratio := float64(headSum) / float64(stats.TotalCount)
@@ -90,6 +103,16 @@ func (d *HotspotDetector) DetectDistribution(stats *ParameterStats) *models.Para
 		model.DistType = models.DistUniform
 	}
 
+	// Numbers and times that rarely repeat are drawn from a fitted continuous
+	// distribution, which also yields values that were not observed.
+	if fit, dist := fitContinuous(stats); fit != nil {
+		model.Continuous = fit
+		model.DistType = dist
+	}
+	// High-cardinality strings, like order numbers, also get fresh values that fit
+	// their patterns.
+	model.Strings = learnStringPatterns(stats)
+
 	return model
 }
 
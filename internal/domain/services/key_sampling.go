@@ -0,0 +1,467 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+)
+
+// DefaultKeyCandidates is the number of values read per column when
+// KeySampler is not given a limit.
+const DefaultKeyCandidates = 10000
+
+// KeySource reads the tables, columns and values of the target database.
+type KeySource interface {
+	// ListTables returns the tables of the database.
+	ListTables(ctx context.Context) ([]string, error)
+	// ListColumns returns the columns of a table.
+	ListColumns(ctx context.Context, table string) ([]string, error)
+	// SampleColumn returns up to limit distinct non-null values of a column.
+	SampleColumn(ctx context.Context, table, column string, limit int) ([]interface{}, error)
+}
+
+// KeyColumn is a placeholder of a template compared for equality with a column, as in
+// "col = :p" or "t.col IN (:p)". Tables are the tables the column may belong to: the
+// one its qualifier names, or all those the query reads when it is unqualified.
+type KeyColumn struct {
+	Param  string
+	Column string
+	Tables []string
+}
+
+// keyAliasStop holds the keywords that can follow a table reference in place of an
+// alias.
+var keyAliasStop = map[string]bool{
+	"where": true, "on": true, "using": true, "join": true, "inner": true, "left": true, "right": true,
+	"full": true, "outer": true, "cross": true, "natural": true, "straight_join": true, "group": true,
+	"order": true, "limit": true, "having": true, "union": true, "set": true, "values": true,
+	"select": true, "window": true, "for": true, "force": true, "use": true, "ignore": true, "final": true,
+	"prewhere": true, "sample": true, "array": true, "partition": true,
+}
+
+// FindKeyColumns returns the placeholders of a query that look up rows by the value of
+// a column. Assignments of UPDATE ... SET and NOT IN lists are not lookups.
+func FindKeyColumns(query string) []KeyColumn {
+	tokens := lexFingerprint(query)
+	tables, aliases := scanTableRefs(tokens)
+
+	var keys []KeyColumn
+	seen := make(map[string]bool)
+	inSet := false
+	for i, tok := range tokens {
+		switch {
+		case tok.kind == fpWord && tok.text == "set":
+			inSet = true
+		case tok.kind == fpWord && tok.text == "where":
+			inSet = false
+		}
+		if tok.kind != fpParam || inSet || seen[tok.text] {
+			continue
+		}
+		column := ""
+		switch {
+		case i > 0 && tokens[i-1].kind == fpPunct && tokens[i-1].text == "=":
+			column = rangeColumnBefore(tokens, i-1)
+		case i+1 < len(tokens) && tokens[i+1].kind == fpPunct && tokens[i+1].text == "=":
+			column, _ = rangeColumnAfter(tokens, i+2)
+		case i >= 2 && i+1 < len(tokens) && tokens[i-1].text == "(" && tokens[i-2].kind == fpWord &&
+			tokens[i-2].text == "in" && tokens[i+1].text == ")" && (i < 3 || tokens[i-3].text != "not"):
+			column = rangeColumnBefore(tokens, i-2)
+		}
+		if column == "" {
+			continue
+		}
+		key := KeyColumn{Param: tok.text, Tables: tables}
+		if dot := strings.LastIndexByte(column, '.'); dot >= 0 {
+			table, ok := aliases[unquoteIdent(column[:dot])]
+			if !ok {
+				continue
+			}
+			key.Tables = []string{table}
+			column = column[dot+1:]
+		}
+		key.Column = unquoteIdent(column)
+		seen[tok.text] = true
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// ReferencedTables returns the tables a query reads or writes, in order of first
+// reference, by scanning its tokens; it is used when no SQL parser is at hand.
+func ReferencedTables(query string) []string {
+	tables, _ := scanTableRefs(lexFingerprint(query))
+	return tables
+}
+
+// scanTableRefs returns the tables referenced by a query and the table each of their
+// names and aliases stands for. Table references follow FROM, JOIN, UPDATE and INTO,
+// and commas within a FROM clause; an alias may follow them.
+func scanTableRefs(tokens []fpToken) ([]string, map[string]string) {
+	aliases := make(map[string]string)
+	var tables []string
+	inFrom := false
+	for i := 0; i < len(tokens); i++ {
+		tok := tokens[i]
+		ref := false
+		switch {
+		case tok.kind == fpWord && (tok.text == "from" || tok.text == "join"):
+			inFrom, ref = true, true
+		case tok.kind == fpWord && (tok.text == "update" || tok.text == "into"):
+			ref = true
+		case tok.kind == fpPunct && tok.text == ",":
+			ref = inFrom
+		case tok.kind == fpPunct && tok.text == "(", tok.kind == fpWord && keyAliasStop[tok.text] && tok.text != "join":
+			inFrom = false
+		}
+		if !ref {
+			continue
+		}
+		table, ok := rangeColumnAfter(tokens, i+1)
+		if !ok || keyAliasStop[table] || table == "select" {
+			continue
+		}
+		table = unquoteIdent(table)
+		tables = appendUnique(tables, table)
+		aliases[table] = table
+		aliases[lastSegment(table)] = table
+		end := i + 2 + 2*strings.Count(table, ".")
+		if end < len(tokens) && tokens[end].kind == fpWord && tokens[end].text == "as" {
+			end++
+		}
+		if end < len(tokens) && isColumnToken(tokens[end]) && !keyAliasStop[tokens[end].text] {
+			aliases[unquoteIdent(tokens[end].text)] = table
+		}
+	}
+	return tables, aliases
+}
+
+// unquoteIdent strips the quotes of each part of a possibly qualified identifier.
+func unquoteIdent(ident string) string {
+	parts := strings.Split(ident, ".")
+	for i, p := range parts {
+		if len(p) >= 2 && (p[0] == '"' || p[0] == '`') && p[len(p)-1] == p[0] {
+			parts[i] = p[1 : len(p)-1]
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+// lastSegment returns the name of a table without its schema or database.
+func lastSegment(table string) string {
+	return table[strings.LastIndexByte(table, '.')+1:]
+}
+
+func appendUnique(list []string, s string) []string {
+	for _, have := range list {
+		if have == s {
+			return list
+		}
+	}
+	return append(list, s)
+}
+
+// KeySampler builds KeyPools from the values of the target database, so that the
+// parameters that look up rows by key are drawn from keys that exist.
+type KeySampler struct {
+	source KeySource
+	limit  int
+}
+
+// NewKeySampler returns a KeySampler that reads up to limit values per column from a
+// source; a limit of zero uses DefaultKeyCandidates.
+func NewKeySampler(source KeySource, limit int) *KeySampler {
+	if limit <= 0 {
+		limit = DefaultKeyCandidates
+	}
+	return &KeySampler{source: source, limit: limit}
+}
+
+// Pools returns the key pools of the templates' lookup parameters, by GroupKey and
+// parameter name. A parameter gets a pool when its column is found in a table of the
+// database, matching names case-insensitively; an unqualified column must belong to
+// exactly one of the tables of the query. Parameters modeled relative to their traces'
+// timestamps keep their model.
+func (k *KeySampler) Pools(ctx context.Context, templates []models.SQLTemplate, params *models.WorkloadParameterModel) (map[string]map[string]*KeyPool, error) {
+	names, err := k.source.ListTables(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tables: %w", err)
+	}
+	tables := make(map[string]string, len(names))
+	for _, name := range names {
+		tables[strings.ToLower(name)] = name
+		if _, ok := tables[strings.ToLower(lastSegment(name))]; !ok {
+			tables[strings.ToLower(lastSegment(name))] = name
+		}
+	}
+	resolveTable := func(ref string) (string, bool) {
+		if name, ok := tables[strings.ToLower(ref)]; ok {
+			return name, true
+		}
+		name, ok := tables[strings.ToLower(lastSegment(ref))]
+		return name, ok
+	}
+
+	columns := make(map[string]map[string]string)
+	columnsOf := func(table string) (map[string]string, error) {
+		if cols, ok := columns[table]; ok {
+			return cols, nil
+		}
+		list, err := k.source.ListColumns(ctx, table)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the columns of %s: %w", table, err)
+		}
+		cols := make(map[string]string, len(list))
+		for _, c := range list {
+			cols[strings.ToLower(c)] = c
+		}
+		columns[table] = cols
+		return cols, nil
+	}
+
+	values := make(map[string][]interface{})
+	pools := make(map[string]map[string]*KeyPool)
+	for _, tmpl := range templates {
+		for _, key := range FindKeyColumns(tmpl.RawSQL) {
+			model := params.TemplateParameters[tmpl.GroupKey][key.Param]
+			if model == nil || model.Relative {
+				continue
+			}
+			refs := key.Tables
+			if len(refs) == 0 {
+				refs = tmpl.Tables
+			}
+			table, column := "", ""
+			for _, ref := range refs {
+				name, ok := resolveTable(ref)
+				if !ok {
+					continue
+				}
+				cols, err := columnsOf(name)
+				if err != nil {
+					return nil, err
+				}
+				if c, ok := cols[strings.ToLower(key.Column)]; ok {
+					if table != "" && table != name {
+						table = ""
+						break
+					}
+					table, column = name, c
+				}
+			}
+			if table == "" {
+				continue
+			}
+
+			id := table + "\x00" + column
+			candidates, ok := values[id]
+			if !ok {
+				if candidates, err = k.source.SampleColumn(ctx, table, column, k.limit); err != nil {
+					return nil, fmt.Errorf("failed to read %s.%s: %w", table, column, err)
+				}
+				values[id] = candidates
+			}
+			if len(candidates) == 0 {
+				continue
+			}
+			if pools[tmpl.GroupKey] == nil {
+				pools[tmpl.GroupKey] = make(map[string]*KeyPool)
+			}
+			pools[tmpl.GroupKey][key.Param] = NewKeyPool(table, column, candidates, model)
+		}
+	}
+	return pools, nil
+}
+
+// KeyPool holds values of a column of the target database, weighted so that drawing
+// them follows the learned distribution of a parameter.
+type KeyPool struct {
+	Table   string
+	Column  string
+	Values  []interface{}
+	Weights []float64
+
+	index map[string]int
+	cum   []float64
+}
+
+// NewKeyPool weights the values of a column by a parameter model. Values the model
+// observed keep their frequency, and the frequencies of observed values missing from
+// the column are given to other values, one each, so that hot keys stay hot. The
+// remaining values share the long tail of an incomplete model. A continuous model
+// weights each value by the probability of the values closer to it than to any other.
+// Values read as text are converted to numbers when the parameter is numeric.
+func NewKeyPool(table, column string, values []interface{}, model *models.ParameterModel) *KeyPool {
+	p := &KeyPool{Table: table, Column: column, index: make(map[string]int, len(values))}
+	numeric := model != nil && (model.Continuous != nil && model.Continuous.ValueKind != "time" ||
+		len(model.TopValues) > 0 && isNumericType(inferType(model.TopValues[0])))
+	for _, v := range values {
+		if s, ok := v.(string); ok && numeric {
+			if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+				v = n
+			} else if f, err := strconv.ParseFloat(s, 64); err == nil {
+				v = f
+			}
+		}
+		key := keyPoolKey(v)
+		if _, dup := p.index[key]; dup {
+			continue
+		}
+		p.index[key] = len(p.Values)
+		p.Values = append(p.Values, v)
+	}
+
+	switch {
+	case model == nil:
+	case model.Continuous != nil:
+		p.Weights = continuousKeyWeights(p.Values, model)
+	case len(model.TopValues) > 0:
+		p.Weights = p.discreteWeights(model)
+	}
+	total := 0.0
+	for _, w := range p.Weights {
+		total += w
+	}
+	if total <= 0 {
+		p.Weights = make([]float64, len(p.Values))
+		for i := range p.Weights {
+			p.Weights[i] = 1
+		}
+	}
+
+	p.cum = make([]float64, len(p.Weights))
+	sum := 0.0
+	for i, w := range p.Weights {
+		sum += w
+		p.cum[i] = sum
+	}
+	return p
+}
+
+func (p *KeyPool) discreteWeights(model *models.ParameterModel) []float64 {
+	weights := make([]float64, len(p.Values))
+	matched := make([]bool, len(p.Values))
+	var missing []float64
+	for i, v := range model.TopValues {
+		f := 1.0
+		if i < len(model.TopFrequencies) {
+			f = float64(model.TopFrequencies[i])
+		}
+		if j, ok := p.index[keyPoolKey(v)]; ok {
+			// The observed value is used as it is, in the type it was learned in.
+			p.Values[j] = v
+			weights[j] += f
+			matched[j] = true
+		} else {
+			missing = append(missing, f)
+		}
+	}
+
+	// The values that were not observed stand in for the missing ones in a stable
+	// order, the most frequent first.
+	var unmatched []int
+	for j := range p.Values {
+		if !matched[j] {
+			unmatched = append(unmatched, j)
+		}
+	}
+	sort.Slice(unmatched, func(a, b int) bool {
+		return hashValue(p.Values[unmatched[a]]) < hashValue(p.Values[unmatched[b]])
+	})
+	sort.Sort(sort.Reverse(sort.Float64Slice(missing)))
+	for i, f := range missing {
+		if len(unmatched) == 0 {
+			break
+		}
+		weights[unmatched[i%len(unmatched)]] += f
+	}
+
+	// Values beyond the observed ones make up the tail, most of which is seen once.
+	if model.Cardinality > len(model.TopValues) || model.Strings != nil {
+		for _, j := range unmatched[min(len(missing), len(unmatched)):] {
+			weights[j] = 1
+		}
+	}
+	return weights
+}
+
+// continuousKeyWeights weights each value by the probability that the fitted
+// distribution, truncated to the observed range as ContinuousSampler draws it, falls
+// closer to it than to any other value.
+func continuousKeyWeights(values []interface{}, model *models.ParameterModel) []float64 {
+	c := model.Continuous
+	points := make([]float64, len(values))
+	order := make([]int, len(values))
+	for i, v := range values {
+		x, ok := sketchPoint(v)
+		if !ok {
+			return nil
+		}
+		points[i], order[i] = x, i
+	}
+	sort.Slice(order, func(a, b int) bool { return points[order[a]] < points[order[b]] })
+
+	lo, hi := continuousCDF(model.DistType, c, c.Min), continuousCDF(model.DistType, c, c.Max)
+	if hi <= lo {
+		return nil
+	}
+	cdf := func(x float64) float64 {
+		return (continuousCDF(model.DistType, c, math.Min(math.Max(x, c.Min), c.Max)) - lo) / (hi - lo)
+	}
+	weights := make([]float64, len(values))
+	below := 0.0
+	for k, i := range order {
+		above := 1.0
+		if k+1 < len(order) {
+			above = cdf((points[i] + points[order[k+1]]) / 2)
+		}
+		weights[i] = math.Max(above-below, 0)
+		below = above
+	}
+	return weights
+}
+
+// keyPoolKey identifies a value regardless of how it was read: numbers, including
+// numeric text, by their value, and times by their instant.
+func keyPoolKey(v interface{}) string {
+	if b, ok := v.([]byte); ok {
+		v = string(b)
+	}
+	if f, ok := toFloat64(v); ok {
+		return "n:" + strconv.FormatFloat(f, 'g', -1, 64)
+	}
+	if s, ok := v.(string); ok {
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			return "n:" + strconv.FormatFloat(f, 'g', -1, 64)
+		}
+	}
+	if t, _, ok := toTime(v); ok {
+		return "t:" + strconv.FormatInt(t.UnixNano(), 10)
+	}
+	return fmt.Sprintf("s:%v", v)
+}
+
+// Contains reports whether a value is in the pool.
+func (p *KeyPool) Contains(v interface{}) bool {
+	_, ok := p.index[keyPoolKey(v)]
+	return ok
+}
+
+// Sample draws a value by weight.
+func (p *KeyPool) Sample(r *rand.Rand) interface{} {
+	if len(p.Values) == 0 {
+		return nil
+	}
+	x := r.Float64() * p.cum[len(p.cum)-1]
+	i := sort.SearchFloat64s(p.cum, x)
+	for i < len(p.cum)-1 && p.Weights[i] == 0 {
+		i++
+	}
+	return p.Values[i]
+}
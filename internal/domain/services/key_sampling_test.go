@@ -0,0 +1,130 @@
+package services
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+)
+
+// fakeKeySource serves the values of the columns of its tables.
+type fakeKeySource map[string]map[string][]interface{}
+
+func (f fakeKeySource) ListTables(ctx context.Context) ([]string, error) {
+	var tables []string
+	for t := range f {
+		tables = append(tables, t)
+	}
+	sort.Strings(tables)
+	return tables, nil
+}
+
+func (f fakeKeySource) ListColumns(ctx context.Context, table string) ([]string, error) {
+	var columns []string
+	for c := range f[table] {
+		columns = append(columns, c)
+	}
+	return columns, nil
+}
+
+func (f fakeKeySource) SampleColumn(ctx context.Context, table, column string, limit int) ([]interface{}, error) {
+	values := f[table][column]
+	return values[:min(limit, len(values))], nil
+}
+
+func TestFindKeyColumns(t *testing.T) {
+	keys := FindKeyColumns("SELECT o.id FROM orders AS o JOIN `shop`.`customers` c ON c.id = o.customer_id " +
+		"WHERE c.id = :p1 AND o.status IN (:p2) AND o.total > :p3 AND region NOT IN (:p4) AND :p5 = sku")
+	assert.Equal(t, []KeyColumn{
+		{Param: ":p1", Column: "id", Tables: []string{"shop.customers"}},
+		{Param: ":p2", Column: "status", Tables: []string{"orders"}},
+		{Param: ":p5", Column: "sku", Tables: []string{"orders", "shop.customers"}},
+	}, keys)
+
+	// Assignments are not lookups.
+	keys = FindKeyColumns("UPDATE cart SET status = :p1 WHERE user_id = :p2")
+	assert.Equal(t, []KeyColumn{{Param: ":p2", Column: "user_id", Tables: []string{"cart"}}}, keys)
+
+	keys = FindKeyColumns("SELECT * FROM a, b WHERE b.x = :p1")
+	assert.Equal(t, []KeyColumn{{Param: ":p1", Column: "x", Tables: []string{"b"}}}, keys)
+}
+
+func TestNewKeyPool(t *testing.T) {
+	model := &models.ParameterModel{
+		Cardinality:    10,
+		TopValues:      []interface{}{1, 2, 3},
+		TopFrequencies: []int{50, 30, 10},
+	}
+	// Keys are read as text; 2 and 3 are gone from the table.
+	pool := NewKeyPool("orders", "customer_id", []interface{}{"1", "7", "8", "9", "1"}, model)
+	require.Len(t, pool.Values, 4)
+	assert.Equal(t, 1, pool.Values[0], "the observed key keeps its learned type")
+	assert.True(t, pool.Contains(int64(1)))
+	assert.False(t, pool.Contains(2))
+
+	weights := make(map[float64]int)
+	for i, v := range pool.Values {
+		if i > 0 {
+			assert.IsType(t, int64(0), v)
+		}
+		weights[pool.Weights[i]]++
+	}
+	// The missing hot keys are taken over by existing ones, and the last one is tail.
+	assert.Equal(t, map[float64]int{50: 1, 30: 1, 10: 1, 1: 1}, weights)
+
+	r := rand.New(rand.NewSource(1))
+	hits := 0
+	for i := 0; i < 10000; i++ {
+		if pool.Sample(r) == 1 {
+			hits++
+		}
+	}
+	assert.InDelta(t, 50.0/91, float64(hits)/10000, 0.02)
+
+	// A continuous model weights each key by the probability of the values nearest to it.
+	uniform := &models.ParameterModel{DistType: models.DistUniform, Continuous: &models.ContinuousModel{ValueKind: "int", Min: 0, Max: 100}}
+	pool = NewKeyPool("orders", "amount", []interface{}{int64(100), int64(0), int64(10)}, uniform)
+	assert.InDeltaSlice(t, []float64{0.45, 0.05, 0.5}, pool.Weights, 1e-9)
+
+	// Without a model the keys are drawn uniformly.
+	pool = NewKeyPool("orders", "id", []interface{}{"a", "b"}, nil)
+	assert.Equal(t, []float64{1, 1}, pool.Weights)
+}
+
+func TestKeySampler(t *testing.T) {
+	source := fakeKeySource{
+		"Orders":    {"ID": {int64(1), int64(2), int64(3)}, "customer_id": {int64(5), int64(6)}},
+		"customers": {"id": {int64(5), int64(6), int64(7)}, "name": {"ann", "bob"}},
+	}
+	templates := []models.SQLTemplate{{
+		GroupKey:   "join",
+		RawSQL:     "SELECT * FROM orders o JOIN customers ON customers.id = o.customer_id WHERE o.id = :p1 AND name = :p2 AND id = :p3",
+		Parameters: []string{":p1", ":p2", ":p3"},
+	}}
+	learned := &models.ParameterModel{Cardinality: 100, TopValues: []interface{}{int64(42)}, TopFrequencies: []int{9}}
+	params := &models.WorkloadParameterModel{TemplateParameters: map[string]map[string]*models.ParameterModel{
+		"join": {":p1": learned, ":p2": {TopValues: []interface{}{"zed"}, TopFrequencies: []int{1}}, ":p3": learned},
+	}}
+
+	pools, err := NewKeySampler(source, 0).Pools(context.Background(), templates, params)
+	require.NoError(t, err)
+	require.Contains(t, pools, "join")
+	assert.Equal(t, "Orders", pools["join"][":p1"].Table)
+	assert.Equal(t, "ID", pools["join"][":p1"].Column)
+	assert.Equal(t, "customers", pools["join"][":p2"].Table, "only customers has the unqualified column")
+	assert.NotContains(t, pools["join"], ":p3", "a column of both tables is ambiguous")
+
+	synth := NewSynthesizer(params)
+	synth.UseKeyPools(pools)
+	for i := 0; i < 100; i++ {
+		args, err := synth.FillParameters(&templates[0])
+		require.NoError(t, err)
+		assert.Contains(t, []interface{}{int64(1), int64(2), int64(3)}, args[0])
+		assert.Contains(t, []interface{}{"ann", "bob"}, args[1])
+		assert.Equal(t, int64(42), args[2], "the ambiguous parameter keeps its model")
+	}
+}
@@ -1,7 +1,11 @@
 package services
 
 import (
+	"fmt"
+	"math"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/turtacn/SQLTraceBench/internal/domain/models"
@@ -14,6 +18,7 @@ const (
 	ParamTypeInt
 	ParamTypeString
 	ParamTypeDatetime
+	ParamTypeFloat
 )
 
 type ParameterStats struct {
@@ -21,82 +26,374 @@ type ParameterStats struct {
 	Type        ParamType
 	ValueCounts map[interface{}]int
 	TotalCount  int
+	// Distinct estimates the number of distinct values when ValueCounts only holds the
+	// heavy hitters of a sketch; it is zero when ValueCounts is exact.
+	Distinct int
+	// Quantiles summarizes the values of a sketch that are numbers or times when
+	// ValueCounts is not exact.
+	Quantiles *TDigest
 }
 
 type ParameterAnalyzer struct {
 	MaxCardinality int // Max unique values to track per parameter
-	detector       *HotspotDetector
+	JointTopK      int // Max value combinations kept as tuples by AnalyzeJoint
+	// RelativeTimes models datetime parameters as offsets from the timestamps of their
+	// traces, so that generation can re-anchor them to another time.
+	RelativeTimes bool
+	detector      *HotspotDetector
 }
 
 func NewParameterAnalyzer() *ParameterAnalyzer {
 	return &ParameterAnalyzer{
 		MaxCardinality: 10000,
+		JointTopK:      1000,
+		RelativeTimes:  true,
 		detector:       NewHotspotDetector(),
 	}
 }
 
-// Analyze processes traces and returns statistical models for each parameter.
+// Analyze processes traces and returns statistical models for each parameter. The
+// traces are summarized in a single pass by sketches of bounded size, see NewSketches.
 func (a *ParameterAnalyzer) Analyze(traces []models.SQLTrace) map[string]*models.ParameterModel {
-	statsMap := make(map[string]*ParameterStats)
-	limit := a.MaxCardinality
-	if limit <= 0 {
-		limit = 10000
+	sketches := a.NewSketches()
+	for _, trace := range traces {
+		sketches.Add(trace)
+	}
+	return a.Models(sketches)
+}
+
+// Models converts the sketches of a set of traces to statistical models for each
+// parameter.
+func (a *ParameterAnalyzer) Models(sketches *ParameterSketches) map[string]*models.ParameterModel {
+	result := make(map[string]*models.ParameterModel)
+	for paramName, sketch := range sketches.params {
+		if offsets := sketches.offsets[paramName]; sketch.Type == ParamTypeDatetime && offsets.ok && offsets.TotalCount > 0 {
+			model := a.detector.DetectDistribution(offsets.Stats())
+			model.DataType = "DATETIME"
+			model.Relative = true
+			model.TimeLayout = offsets.layout
+			result[paramName] = model
+			continue
+		}
+		result[paramName] = a.detector.DetectDistribution(sketch.Stats())
+	}
+	return result
+}
+
+// dateLayout is the layout of dates without a time of day, which are offset by whole days.
+const dateLayout = "2006-01-02"
+
+// TimeOffset returns the offset in seconds of a datetime parameter from the timestamp
+// of its trace, rounded to the second; dates are offset by whole days.
+func TimeOffset(t, ts time.Time, layout string) float64 {
+	if layout == dateLayout {
+		anchor := ts.UTC().Truncate(24 * time.Hour)
+		return math.Round(t.Sub(anchor).Hours()/24) * 86400
+	}
+	return math.Round(t.Sub(ts).Seconds())
+}
+
+// ReanchorTime returns the time at an offset from a reference time, see TimeOffset,
+// formatted in the layout of the parameter's values if they are strings.
+func ReanchorTime(offset float64, reference time.Time, layout string) interface{} {
+	t := reference.UTC().Truncate(time.Second)
+	if layout == dateLayout {
+		t = t.Truncate(24 * time.Hour)
+	}
+	t = t.Add(time.Duration(math.Round(offset * float64(time.Second))))
+	if layout == "" {
+		return t
 	}
+	return t.Format(layout)
+}
 
-	// 1. Accumulate Statistics
+// AnalyzeJoint models the parameters of the traces of one template together. Only the
+// parameters bound to a single value in every trace take part, and nil is returned when
+// fewer than two do. The traces are summarized in a single pass by a JointSketch.
+func (a *ParameterAnalyzer) AnalyzeJoint(traces []models.SQLTrace) *models.JointParameterModel {
+	sketch := a.NewJointSketch()
 	for _, trace := range traces {
-		if trace.Parameters == nil {
+		sketch.Add(trace)
+	}
+	return a.JointModel(sketch)
+}
+
+// JointSketch summarizes the combinations of parameter values of a stream of traces of
+// one template in bounded memory: a SpaceSaving counter over the combinations keeps the
+// MaxCardinality most frequent ones.
+type JointSketch struct {
+	capacity int
+	total    int
+	seen     map[string]int
+	lists    map[string]bool
+	tuples   *SpaceSaving
+	// values holds the values of the combinations counted by tuples, and of some
+	// evicted ones until they are pruned.
+	values map[string]*jointTuple
+}
+
+// NewJointSketch returns an empty sketch of the parameter combinations of a template.
+func (a *ParameterAnalyzer) NewJointSketch() *JointSketch {
+	capacity := a.MaxCardinality
+	if capacity <= 0 {
+		capacity = 10000
+	}
+	return &JointSketch{
+		capacity: capacity,
+		seen:     make(map[string]int),
+		lists:    make(map[string]bool),
+		tuples:   NewSpaceSaving(capacity),
+		values:   make(map[string]*jointTuple),
+	}
+}
+
+// Add adds the combination of the scalar parameter values of a trace.
+func (s *JointSketch) Add(trace models.SQLTrace) {
+	if len(trace.Parameters) == 0 {
+		return
+	}
+	s.total++
+	t := &jointTuple{count: 1}
+	for name, v := range trace.Parameters {
+		s.seen[name]++
+		if _, ok := v.([]interface{}); ok {
+			s.lists[name] = true
 			continue
 		}
-		for paramName, value := range trace.Parameters {
-			stats, ok := statsMap[paramName]
-			if !ok {
-				stats = &ParameterStats{
-					ParamName:   paramName,
-					Type:        ParamTypeUnknown,
-					ValueCounts: make(map[interface{}]int),
-				}
-				statsMap[paramName] = stats
+		t.names = append(t.names, name)
+	}
+	sort.Strings(t.names)
+	t.values = make([]interface{}, len(t.names))
+	t.keys = make([]string, len(t.names))
+	for i, name := range t.names {
+		t.values[i] = trace.Parameters[name]
+		t.keys[i] = name + "=" + valueKey(t.values[i])
+	}
+	t.key = strings.Join(t.keys, "\x00")
+	s.tuples.Add(t.key, 1)
+	if _, ok := s.values[t.key]; !ok {
+		s.values[t.key] = t
+		s.prune()
+	}
+}
+
+// Merge adds the combinations summarized by the sketch of another shard.
+func (s *JointSketch) Merge(o *JointSketch) {
+	s.total += o.total
+	for name, n := range o.seen {
+		s.seen[name] += n
+	}
+	for name := range o.lists {
+		s.lists[name] = true
+	}
+	s.tuples.Merge(o.tuples)
+	for key, t := range o.values {
+		if _, ok := s.values[key]; !ok {
+			s.values[key] = t
+		}
+	}
+	s.prune()
+}
+
+// prune drops the values of evicted combinations once they take as much room as the
+// counted ones.
+func (s *JointSketch) prune() {
+	if len(s.values) <= 2*s.capacity {
+		return
+	}
+	for key := range s.values {
+		if _, ok := s.tuples.index[key]; !ok {
+			delete(s.values, key)
+		}
+	}
+}
+
+// JointModel converts a JointSketch to a joint model of the parameters bound to a single
+// value in every trace, or nil if fewer than two are. The JointTopK most frequent
+// combinations of their values are kept as tuples; the other counted combinations are
+// conditioned on the anchor parameter, the one with the most distinct values.
+func (a *ParameterAnalyzer) JointModel(s *JointSketch) *models.JointParameterModel {
+	topK := a.JointTopK
+	if topK <= 0 {
+		topK = 1000
+	}
+
+	// 1. Select the parameters with a scalar value in every trace.
+	var params []string
+	for name, n := range s.seen {
+		if n == s.total && !s.lists[name] {
+			params = append(params, name)
+		}
+	}
+	if len(params) < 2 {
+		return nil
+	}
+	sort.Strings(params)
+
+	// 2. Project the counted combinations on the selected parameters, and count the
+	// distinct values of each.
+	tuples := make(map[string]*jointTuple)
+	distinct := make([]map[string]bool, len(params))
+	for i := range distinct {
+		distinct[i] = make(map[string]bool)
+	}
+	for key, count := range s.tuples.Counts() {
+		full := s.values[key.(string)]
+		t := &jointTuple{values: make([]interface{}, len(params)), keys: make([]string, len(params))}
+		for i, j := 0, 0; i < len(params); j++ {
+			if full.names[j] != params[i] {
+				continue
 			}
+			t.values[i] = full.values[j]
+			t.keys[i] = valueKey(full.values[j])
+			distinct[i][t.keys[i]] = true
+			i++
+		}
+		t.key = strings.Join(t.keys, "\x00")
+		if known, ok := tuples[t.key]; ok {
+			known.count += count
+		} else {
+			t.count = count
+			tuples[t.key] = t
+		}
+	}
 
-			// Type inference logic
-			if stats.Type == ParamTypeUnknown {
-				stats.Type = inferType(value)
-			} else if stats.Type != ParamTypeString {
-				currentType := inferType(value)
-				if currentType != stats.Type {
-					// Fallback to string if mixed types
-					stats.Type = ParamTypeString
-				}
+	ranked := make([]*jointTuple, 0, len(tuples))
+	for _, t := range tuples {
+		ranked = append(ranked, t)
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].count != ranked[j].count {
+			return ranked[i].count > ranked[j].count
+		}
+		return ranked[i].key < ranked[j].key
+	})
+
+	// 3. Keep the top tuples and condition the rest on the anchor.
+	model := &models.JointParameterModel{Params: params, Total: s.total}
+	for i := range params {
+		if len(distinct[i]) > len(distinct[model.Anchor]) {
+			model.Anchor = i
+		}
+	}
+	var overflow []*jointTuple
+	for i, t := range ranked {
+		if i == topK {
+			overflow = ranked[i:]
+			break
+		}
+		model.Tuples = append(model.Tuples, t.values)
+		model.TupleFrequencies = append(model.TupleFrequencies, t.count)
+	}
+	model.Conditionals = conditionOnAnchor(overflow, len(params), model.Anchor, s.capacity)
+	return model
+}
+
+// jointTuple is a combination of parameter values and the number of its observations.
+type jointTuple struct {
+	key    string
+	names  []string
+	keys   []string
+	values []interface{}
+	count  int
+}
+
+// conditionOnAnchor builds the distribution of each parameter given each value of the
+// anchor, for at most limit values of the anchor.
+func conditionOnAnchor(tuples []*jointTuple, width, anchor, limit int) []models.ConditionalDistribution {
+	type valueCount struct {
+		key   string
+		value interface{}
+		count int
+	}
+	type conditional struct {
+		key    string
+		given  interface{}
+		count  int
+		values []map[string]*valueCount
+	}
+	byAnchor := make(map[string]*conditional)
+	for _, t := range tuples {
+		c, ok := byAnchor[t.keys[anchor]]
+		if !ok {
+			if len(byAnchor) >= limit {
+				continue
+			}
+			c = &conditional{key: t.keys[anchor], given: t.values[anchor], values: make([]map[string]*valueCount, width)}
+			for i := range c.values {
+				c.values[i] = make(map[string]*valueCount)
 			}
+			byAnchor[c.key] = c
+		}
+		c.count += t.count
+		for i := range t.values {
+			if i == anchor {
+				continue
+			}
+			vc, ok := c.values[i][t.keys[i]]
+			if !ok {
+				vc = &valueCount{key: t.keys[i], value: t.values[i]}
+				c.values[i][t.keys[i]] = vc
+			}
+			vc.count += t.count
+		}
+	}
 
-			// Count value
-			if len(stats.ValueCounts) < limit {
-				stats.ValueCounts[value]++
-			} else {
-				if _, exists := stats.ValueCounts[value]; exists {
-					stats.ValueCounts[value]++
+	conds := make([]*conditional, 0, len(byAnchor))
+	for _, c := range byAnchor {
+		conds = append(conds, c)
+	}
+	sort.Slice(conds, func(i, j int) bool {
+		if conds[i].count != conds[j].count {
+			return conds[i].count > conds[j].count
+		}
+		return conds[i].key < conds[j].key
+	})
+	out := make([]models.ConditionalDistribution, len(conds))
+	for n, c := range conds {
+		d := models.ConditionalDistribution{
+			Given:       c.given,
+			Count:       c.count,
+			Values:      make([][]interface{}, width),
+			Frequencies: make([][]int, width),
+		}
+		for i, counts := range c.values {
+			vcs := make([]*valueCount, 0, len(counts))
+			for _, vc := range counts {
+				vcs = append(vcs, vc)
+			}
+			sort.Slice(vcs, func(a, b int) bool {
+				if vcs[a].count != vcs[b].count {
+					return vcs[a].count > vcs[b].count
 				}
-				// else: ignore tail
+				return vcs[a].key < vcs[b].key
+			})
+			for _, vc := range vcs {
+				d.Values[i] = append(d.Values[i], vc.value)
+				d.Frequencies[i] = append(d.Frequencies[i], vc.count)
 			}
-			stats.TotalCount++
 		}
+		out[n] = d
 	}
+	return out
+}
 
-	// 2. Convert to ParameterModel using HotspotDetector
-	result := make(map[string]*models.ParameterModel)
-	for paramName, stats := range statsMap {
-		model := a.detector.DetectDistribution(stats)
-		result[paramName] = model
-	}
+// valueKey identifies a parameter value by its type and text, so that values of any
+// type can be compared.
+func valueKey(v interface{}) string {
+	return fmt.Sprintf("%T:%v", v, v)
+}
 
-	return result
+func isNumericType(t ParamType) bool {
+	return t == ParamTypeInt || t == ParamTypeFloat
 }
 
 func inferType(value interface{}) ParamType {
 	switch v := value.(type) {
 	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
 		return ParamTypeInt
+	case float32, float64:
+		return ParamTypeFloat
 	case time.Time:
 		return ParamTypeDatetime
 	case string:
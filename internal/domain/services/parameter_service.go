@@ -39,6 +39,7 @@ func (s *ParameterService) BuildModel(tc models.TraceCollection, templates []mod
 	// Assuming traces have their query. We match by normalizing.
 
 	templateMap := make(map[string]*models.SQLTemplate)
+	templatesByKey := make(map[string]*models.SQLTemplate)
 	tracesByTemplate := make(map[string][]models.SQLTrace)
 
 	for i := range templates {
@@ -50,6 +51,7 @@ func (s *ParameterService) BuildModel(tc models.TraceCollection, templates []mod
 
 		key := normalizeQuery(t.RawSQL)
 		templateMap[key] = t
+		templatesByKey[t.GroupKey] = t
 
 		// Initialize the map entry for this template
 		if _, ok := pm.TemplateParameters[t.GroupKey]; !ok {
@@ -58,6 +60,15 @@ func (s *ParameterService) BuildModel(tc models.TraceCollection, templates []mod
 	}
 
 	for _, trace := range tc.Traces {
+		// Templates extracted by TemplateService are keyed by the query fingerprint,
+		// which also yields the parameter values.
+		if fp := FingerprintQuery(trace.Query, trace.Parameters); templatesByKey[fp.Query] != nil {
+			t := trace
+			t.Parameters = fp.Parameters
+			tracesByTemplate[fp.Query] = append(tracesByTemplate[fp.Query], t)
+			continue
+		}
+
 		key := normalizeQuery(trace.Query)
 		template, ok := templateMap[key]
 		if !ok {
@@ -83,6 +94,12 @@ func (s *ParameterService) BuildModel(tc models.TraceCollection, templates []mod
 		// Use ParameterAnalyzer
 		paramModels := s.analyzer.Analyze(traces)
 		pm.TemplateParameters[groupKey] = paramModels
+		if joint := s.analyzer.AnalyzeJoint(traces); joint != nil {
+			pm.TemplateJoints[groupKey] = joint
+		}
+		if ranges := s.analyzer.AnalyzeRanges(templatesByKey[groupKey].RawSQL, traces); len(ranges) > 0 {
+			pm.TemplateRanges[groupKey] = ranges
+		}
 	}
 
 	// 3. Handle templates with no traces (Default/Uniform)
@@ -0,0 +1,192 @@
+package services
+
+import (
+	"time"
+
+	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+)
+
+// ParameterSketch summarizes the values of one parameter in a single pass and bounded
+// memory: the heavy hitters, the number of distinct values and, for numbers and times,
+// the quantiles. Sketches built from shards of a trace merge into the sketch of the
+// whole trace.
+type ParameterSketch struct {
+	ParamName  string
+	Type       ParamType
+	TotalCount int
+	Heavy      *SpaceSaving
+	Distinct   *HyperLogLog
+	Quantiles  *TDigest // numbers, and times in seconds
+}
+
+// NewParameterSketch returns an empty sketch tracking up to capacity heavy hitters.
+func NewParameterSketch(name string, capacity int) *ParameterSketch {
+	return &ParameterSketch{
+		ParamName: name,
+		Type:      ParamTypeUnknown,
+		Heavy:     NewSpaceSaving(capacity),
+		Distinct:  NewHyperLogLog(),
+		Quantiles: NewTDigest(),
+	}
+}
+
+func (s *ParameterSketch) Add(value interface{}) {
+	s.Type = mergeParamType(s.Type, inferType(value))
+	s.Heavy.Add(value, 1)
+	s.Distinct.Add(value)
+	if x, ok := sketchPoint(value); ok {
+		s.Quantiles.Add(x, 1)
+	}
+	s.TotalCount++
+}
+
+// Merge adds the values summarized by another sketch of the same parameter.
+func (s *ParameterSketch) Merge(o *ParameterSketch) {
+	if o.Type != ParamTypeUnknown {
+		s.Type = mergeParamType(s.Type, o.Type)
+	}
+	s.Heavy.Merge(o.Heavy)
+	s.Distinct.Merge(o.Distinct)
+	s.Quantiles.Merge(o.Quantiles)
+	s.TotalCount += o.TotalCount
+}
+
+// Stats returns the statistics of the sketch for the HotspotDetector. They are exact
+// until the heavy hitters overflow; after that ValueCounts holds the guaranteed counts
+// of the heavy hitters, with the distinct values and quantiles estimated.
+func (s *ParameterSketch) Stats() *ParameterStats {
+	stats := &ParameterStats{
+		ParamName:   s.ParamName,
+		Type:        s.Type,
+		ValueCounts: s.Heavy.Counts(),
+		TotalCount:  s.TotalCount,
+	}
+	if !s.Heavy.Exact() {
+		stats.Distinct = max(s.Distinct.Estimate(), len(stats.ValueCounts))
+		stats.Quantiles = s.Quantiles
+	}
+	return stats
+}
+
+// mergeParamType returns the type of a parameter with values of both types: numbers
+// widen to floats and any other mix falls back to strings.
+func mergeParamType(current, next ParamType) ParamType {
+	switch {
+	case current == ParamTypeUnknown:
+		return next
+	case current == ParamTypeString || current == next:
+		return current
+	case isNumericType(current) && isNumericType(next):
+		return ParamTypeFloat
+	}
+	return ParamTypeString
+}
+
+// sketchPoint returns the number a value is summarized by in quantiles: numbers as
+// they are and times in seconds, as in fitContinuous.
+func sketchPoint(v interface{}) (float64, bool) {
+	if f, ok := toFloat64(v); ok {
+		return f, true
+	}
+	if t, _, ok := toTime(v); ok {
+		return float64(t.UnixNano()) / 1e9, true
+	}
+	return 0, false
+}
+
+// ParameterSketches holds the sketches of the parameters of a set of traces, see
+// ParameterAnalyzer.NewSketches.
+type ParameterSketches struct {
+	capacity int
+	relative bool // see ParameterAnalyzer.RelativeTimes
+	params   map[string]*ParameterSketch
+	offsets  map[string]*timeOffsetSketch
+}
+
+// NewSketches returns empty sketches for the parameters of a stream of traces. Traces
+// are added one at a time, and the sketches of shards are merged before Models.
+func (a *ParameterAnalyzer) NewSketches() *ParameterSketches {
+	capacity := a.MaxCardinality
+	if capacity <= 0 {
+		capacity = 10000
+	}
+	return &ParameterSketches{
+		capacity: capacity,
+		relative: a.RelativeTimes,
+		params:   make(map[string]*ParameterSketch),
+		offsets:  make(map[string]*timeOffsetSketch),
+	}
+}
+
+// Add adds the parameters of a trace.
+func (s *ParameterSketches) Add(trace models.SQLTrace) {
+	for paramName, param := range trace.Parameters {
+		sketch, ok := s.params[paramName]
+		if !ok {
+			sketch = NewParameterSketch(paramName, s.capacity)
+			s.params[paramName] = sketch
+			s.offsets[paramName] = &timeOffsetSketch{
+				ParameterSketch: NewParameterSketch(paramName, s.capacity),
+				ok:              s.relative,
+			}
+		}
+		offsets := s.offsets[paramName]
+
+		// A collapsed IN list or multi-row VALUES column contributes each of its values.
+		values := []interface{}{param}
+		if list, ok := param.([]interface{}); ok {
+			values = list
+		}
+		for _, value := range values {
+			sketch.Add(value)
+			offsets.add(value, trace.Timestamp)
+		}
+	}
+}
+
+// Merge adds the parameters summarized by the sketches of another shard.
+func (s *ParameterSketches) Merge(o *ParameterSketches) {
+	for name, sketch := range o.params {
+		mine, ok := s.params[name]
+		if !ok {
+			mine = NewParameterSketch(name, s.capacity)
+			s.params[name] = mine
+			s.offsets[name] = &timeOffsetSketch{ParameterSketch: NewParameterSketch(name, s.capacity), ok: s.relative}
+		}
+		mine.Merge(sketch)
+		s.offsets[name].merge(o.offsets[name])
+	}
+}
+
+// timeOffsetSketch summarizes the offsets, in seconds, of a datetime parameter from the
+// timestamps of its traces. ok is cleared by a value that is not a time in the layout
+// of the others, or by a trace without a timestamp.
+type timeOffsetSketch struct {
+	*ParameterSketch
+	layout string
+	ok     bool
+}
+
+func (s *timeOffsetSketch) add(value interface{}, ts time.Time) {
+	if !s.ok {
+		return
+	}
+	t, layout, isTime := toTime(value)
+	if !isTime || ts.IsZero() || s.TotalCount > 0 && layout != s.layout {
+		s.ok = false
+		return
+	}
+	s.layout = layout
+	s.Add(TimeOffset(t, ts, layout))
+}
+
+func (s *timeOffsetSketch) merge(o *timeOffsetSketch) {
+	if !s.ok || !o.ok || s.TotalCount > 0 && o.TotalCount > 0 && s.layout != o.layout {
+		s.ok = false
+		return
+	}
+	if o.TotalCount > 0 {
+		s.layout = o.layout
+	}
+	s.Merge(o.ParameterSketch)
+}
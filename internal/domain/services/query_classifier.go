@@ -0,0 +1,71 @@
+package services
+
+import "github.com/turtacn/SQLTraceBench/pkg/types"
+
+// queryTypeKeywords maps the leading keyword of a statement to its type.
+var queryTypeKeywords = map[string]types.QueryType{
+	"select":   types.QuerySelect,
+	"values":   types.QuerySelect,
+	"table":    types.QuerySelect,
+	"insert":   types.QueryInsert,
+	"replace":  types.QueryInsert,
+	"update":   types.QueryUpdate,
+	"delete":   types.QueryDelete,
+	"create":   types.QueryDDL,
+	"alter":    types.QueryDDL,
+	"drop":     types.QueryDDL,
+	"truncate": types.QueryDDL,
+	"rename":   types.QueryDDL,
+	"comment":  types.QueryDDL,
+}
+
+// readOnlyKeywords lead statements of type QueryOther that only read data.
+var readOnlyKeywords = map[string]bool{"show": true, "explain": true, "describe": true, "desc": true}
+
+// ClassifyQuery returns the statement type of a query and whether it only reads data.
+// The main statement of a WITH query decides its type; a data-modifying CTE or a
+// locking clause (FOR UPDATE, FOR SHARE, LOCK IN SHARE MODE) makes it a write.
+// Statements other than DML and DDL, such as SET or CALL, are QueryOther and are
+// treated as writes, except SHOW, EXPLAIN and DESCRIBE.
+func ClassifyQuery(query string) (types.QueryType, bool) {
+	tokens := lexFingerprint(query)
+	depth := 0
+	main := ""
+	modifies, locks := false, false
+	for i, tok := range tokens {
+		switch {
+		case tok.kind == fpPunct && tok.text == "(":
+			depth++
+		case tok.kind == fpPunct && tok.text == ")":
+			depth--
+		case tok.kind != fpWord:
+		case main == "" && tokens[0].text == "with":
+			// Skip the CTE names and options up to the main statement.
+			if qt, ok := queryTypeKeywords[tok.text]; ok && depth == 0 && qt != types.QueryDDL && tok.text != "table" {
+				main = tok.text
+			}
+		case main == "":
+			main = tok.text
+		case depth == 0 && tok.text == "for" && i+1 < len(tokens):
+			switch tokens[i+1].text {
+			case "update", "share", "no", "key":
+				locks = true
+			}
+		case depth == 0 && tok.text == "lock" && i+1 < len(tokens) && tokens[i+1].text == "in":
+			locks = true
+		}
+		// A CTE body that inserts, updates or deletes.
+		if tok.kind == fpWord && depth > 0 && i > 0 && tokens[i-1].text == "(" {
+			switch tok.text {
+			case "insert", "update", "delete", "merge":
+				modifies = true
+			}
+		}
+	}
+
+	qt, ok := queryTypeKeywords[main]
+	if !ok {
+		return types.QueryOther, readOnlyKeywords[main]
+	}
+	return qt, qt == types.QuerySelect && !modifies && !locks
+}
@@ -0,0 +1,38 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/turtacn/SQLTraceBench/pkg/types"
+)
+
+func TestClassifyQuery(t *testing.T) {
+	tests := []struct {
+		query    string
+		want     types.QueryType
+		readOnly bool
+	}{
+		{"SELECT * FROM orders WHERE id = :p1", types.QuerySelect, true},
+		{"/* app */ (select 1) union (select 2)", types.QuerySelect, true},
+		{"select * from orders where id = 1 for update", types.QuerySelect, false},
+		{"SELECT * FROM orders LOCK IN SHARE MODE", types.QuerySelect, false},
+		{"insert into orders (id) values (1)", types.QueryInsert, false},
+		{"REPLACE INTO orders VALUES (1)", types.QueryInsert, false},
+		{"update orders set total = 1", types.QueryUpdate, false},
+		{"DELETE FROM orders", types.QueryDelete, false},
+		{"CREATE TABLE t (id int)", types.QueryDDL, false},
+		{"truncate table orders", types.QueryDDL, false},
+		{"WITH recent AS (SELECT * FROM orders) SELECT count(*) FROM recent", types.QuerySelect, true},
+		{"with t(n) as (select 1) update orders set n = (select n from t)", types.QueryUpdate, false},
+		{"WITH moved AS (DELETE FROM orders RETURNING *) SELECT * FROM moved", types.QuerySelect, false},
+		{"SHOW TABLES", types.QueryOther, true},
+		{"SET NAMES utf8mb4", types.QueryOther, false},
+		{"", types.QueryOther, false},
+	}
+	for _, tt := range tests {
+		qt, readOnly := ClassifyQuery(tt.query)
+		assert.Equal(t, tt.want, qt, tt.query)
+		assert.Equal(t, tt.readOnly, readOnly, tt.query)
+	}
+}
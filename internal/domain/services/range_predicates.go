@@ -0,0 +1,361 @@
+package services
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+)
+
+// RangePair is a pair of placeholders bounding the same column, as in
+// "col BETWEEN :a AND :b" or "col >= :lo AND col < :hi".
+type RangePair struct {
+	Column string
+	Lower  string
+	Upper  string
+}
+
+// FindRangePairs returns the range predicates of a template whose bounds are both
+// placeholders. Comparisons pair up when they bound the same column from opposite
+// sides at the same nesting level, in either operand order.
+func FindRangePairs(query string) []RangePair {
+	tokens := lexFingerprint(query)
+	var pairs []RangePair
+	type bound struct {
+		column, param string
+		depth         int
+	}
+	var lowers, uppers []bound
+	depth := 0
+
+	for i := 0; i < len(tokens); i++ {
+		tok := tokens[i]
+		if tok.kind == fpPunct {
+			switch tok.text {
+			case "(":
+				depth++
+			case ")":
+				depth--
+			}
+		}
+
+		// col [NOT] BETWEEN :a AND :b
+		if tok.kind == fpWord && tok.text == "between" && i+3 < len(tokens) &&
+			tokens[i+1].kind == fpParam && tokens[i+2].text == "and" && tokens[i+3].kind == fpParam {
+			end := i
+			if end > 0 && tokens[end-1].kind == fpWord && tokens[end-1].text == "not" {
+				end--
+			}
+			if col := rangeColumnBefore(tokens, end); col != "" {
+				pairs = append(pairs, RangePair{Column: col, Lower: tokens[i+1].text, Upper: tokens[i+3].text})
+			}
+			i += 3
+			continue
+		}
+
+		if tok.kind != fpPunct || i == 0 || i+1 >= len(tokens) {
+			continue
+		}
+		var lower bool
+		switch tok.text {
+		case ">", ">=":
+			lower = true
+		case "<", "<=":
+			lower = false
+		default:
+			continue
+		}
+		// col > :p bounds col from below; :p > col from above.
+		if next := tokens[i+1]; next.kind == fpParam {
+			if col := rangeColumnBefore(tokens, i); col != "" {
+				b := bound{column: col, param: next.text, depth: depth}
+				if lower {
+					lowers = append(lowers, b)
+				} else {
+					uppers = append(uppers, b)
+				}
+			}
+		} else if prev := tokens[i-1]; prev.kind == fpParam {
+			if col, ok := rangeColumnAfter(tokens, i+1); ok {
+				b := bound{column: col, param: prev.text, depth: depth}
+				if lower {
+					uppers = append(uppers, b)
+				} else {
+					lowers = append(lowers, b)
+				}
+			}
+		}
+	}
+
+	used := make([]bool, len(uppers))
+	for _, lo := range lowers {
+		for j, hi := range uppers {
+			if !used[j] && hi.column == lo.column && hi.depth == lo.depth && hi.param != lo.param {
+				used[j] = true
+				pairs = append(pairs, RangePair{Column: lo.column, Lower: lo.param, Upper: hi.param})
+				break
+			}
+		}
+	}
+	return pairs
+}
+
+// rangeColumnBefore returns the column reference, possibly qualified, that ends just
+// before tokens[end], or "" if there is none.
+func rangeColumnBefore(tokens []fpToken, end int) string {
+	start := end - 1
+	if start < 0 || !isColumnToken(tokens[start]) {
+		return ""
+	}
+	for start >= 2 && tokens[start-1].kind == fpPunct && tokens[start-1].text == "." && isColumnToken(tokens[start-2]) {
+		start -= 2
+	}
+	return joinTokens(tokens[start:end])
+}
+
+// rangeColumnAfter returns the column reference that starts at tokens[start].
+func rangeColumnAfter(tokens []fpToken, start int) (string, bool) {
+	if start >= len(tokens) || !isColumnToken(tokens[start]) {
+		return "", false
+	}
+	end := start + 1
+	for end+1 < len(tokens) && tokens[end].kind == fpPunct && tokens[end].text == "." && isColumnToken(tokens[end+1]) {
+		end += 2
+	}
+	return joinTokens(tokens[start:end]), true
+}
+
+func isColumnToken(tok fpToken) bool {
+	return tok.kind == fpQuoted || tok.kind == fpWord && !fpSignContext[tok.text]
+}
+
+func joinTokens(tokens []fpToken) string {
+	var b strings.Builder
+	for _, tok := range tokens {
+		b.WriteString(tok.text)
+	}
+	return b.String()
+}
+
+// AnalyzeRanges models the range predicates of a template: for each pair of bounds it
+// learns the distribution of their difference from the traces in which the range is
+// valid. Pairs whose bounds are not both numbers or both times are left out. The traces
+// are summarized in a single pass by RangeSketches.
+func (a *ParameterAnalyzer) AnalyzeRanges(query string, traces []models.SQLTrace) []*models.RangeParameterModel {
+	sketches := a.NewRangeSketches(query)
+	for _, trace := range traces {
+		sketches.Add(trace)
+	}
+	return a.RangeModels(sketches)
+}
+
+// RangeSketches summarizes the widths of the range predicates of a template over a
+// stream of its traces, see AnalyzeRanges.
+type RangeSketches struct {
+	pairs  []RangePair
+	kinds  []models.RangeKind
+	widths []*ParameterSketch
+}
+
+// NewRangeSketches returns empty sketches for the range predicates of a template.
+func (a *ParameterAnalyzer) NewRangeSketches(query string) *RangeSketches {
+	limit := a.MaxCardinality
+	if limit <= 0 {
+		limit = 10000
+	}
+	s := &RangeSketches{pairs: FindRangePairs(query)}
+	s.kinds = make([]models.RangeKind, len(s.pairs))
+	s.widths = make([]*ParameterSketch, len(s.pairs))
+	for i, pair := range s.pairs {
+		s.widths[i] = NewParameterSketch(pair.Lower, limit)
+	}
+	return s
+}
+
+// Add adds the widths of the valid ranges of a trace. A range counts only if its
+// bounds are of the same kind as in the first valid range.
+func (s *RangeSketches) Add(trace models.SQLTrace) {
+	for i, pair := range s.pairs {
+		lo, hi := trace.Parameters[pair.Lower], trace.Parameters[pair.Upper]
+		k, width, ok := rangeWidth(lo, hi)
+		if !ok || s.kinds[i] != "" && k != s.kinds[i] {
+			continue
+		}
+		s.kinds[i] = k
+		s.widths[i].Add(width)
+	}
+}
+
+// Merge adds the widths summarized by the sketches of another shard of the same
+// template's traces.
+func (s *RangeSketches) Merge(o *RangeSketches) {
+	for i := range s.pairs {
+		if o.kinds[i] == "" || s.kinds[i] != "" && o.kinds[i] != s.kinds[i] {
+			continue
+		}
+		s.kinds[i] = o.kinds[i]
+		s.widths[i].Merge(o.widths[i])
+	}
+}
+
+// RangeModels converts RangeSketches to models of the ranges with a valid width.
+func (a *ParameterAnalyzer) RangeModels(s *RangeSketches) []*models.RangeParameterModel {
+	var result []*models.RangeParameterModel
+	for i, pair := range s.pairs {
+		kind, sketch := s.kinds[i], s.widths[i]
+		if sketch.TotalCount == 0 {
+			continue
+		}
+		stats := sketch.Stats()
+		stats.Type = ParamTypeFloat
+		if kind == models.RangeInt {
+			stats.Type = ParamTypeInt
+		}
+		// Repeated widths are sampled as observed: a few typical windows, like an hour
+		// or a day, are common and a fitted Zipf curve would shift their shares.
+		width := a.detector.DetectDistribution(stats)
+		width.ParamName = pair.Upper + "-" + pair.Lower
+		if width.Continuous == nil {
+			width.DistType = models.DistEmpirical
+		}
+		result = append(result, &models.RangeParameterModel{
+			Column: pair.Column,
+			Lower:  pair.Lower,
+			Upper:  pair.Upper,
+			Kind:   kind,
+			Width:  width,
+		})
+	}
+	return result
+}
+
+// rangeWidth returns the difference of two bounds, which must not be negative: an int64
+// for integers, a float64 for other numbers and the seconds between times.
+func rangeWidth(lo, hi interface{}) (models.RangeKind, interface{}, bool) {
+	if l, ok := toInt64(lo); ok {
+		if h, ok := toInt64(hi); ok && h >= l {
+			return models.RangeInt, h - l, true
+		}
+	}
+	if l, ok := toFloat64(lo); ok {
+		if h, ok := toFloat64(hi); ok && h >= l {
+			return models.RangeFloat, h - l, true
+		}
+		return "", nil, false
+	}
+	if l, _, ok := toTime(lo); ok {
+		if h, _, ok := toTime(hi); ok && !h.Before(l) {
+			return models.RangeTime, h.Sub(l).Seconds(), true
+		}
+	}
+	return "", nil, false
+}
+
+// AddRangeWidth returns the upper bound of a range from its lower bound and width, in
+// the type of the lower bound; times given as strings keep their layout.
+func AddRangeWidth(lo interface{}, kind models.RangeKind, width interface{}) (interface{}, bool) {
+	switch kind {
+	case models.RangeInt:
+		w, ok := toInt64(width)
+		if !ok {
+			return nil, false
+		}
+		switch l := lo.(type) {
+		case int:
+			return l + int(w), true
+		case int64:
+			return l + w, true
+		case int32:
+			return l + int32(w), true
+		case uint64:
+			return l + uint64(w), true
+		case uint:
+			return l + uint(w), true
+		case float64:
+			return l + float64(w), true
+		}
+	case models.RangeFloat:
+		w, ok := toFloat64(width)
+		if !ok {
+			return nil, false
+		}
+		if l, ok := toFloat64(lo); ok {
+			return l + w, true
+		}
+	case models.RangeTime:
+		w, ok := toFloat64(width)
+		if !ok {
+			return nil, false
+		}
+		l, layout, ok := toTime(lo)
+		if !ok {
+			return nil, false
+		}
+		hi := l.Add(time.Duration(math.Round(w * float64(time.Second))))
+		if layout == "" {
+			return hi, true
+		}
+		return hi.Format(layout), true
+	}
+	return nil, false
+}
+
+func toInt64(v interface{}) (int64, bool) {
+	switch n := v.(type) {
+	case int:
+		return int64(n), true
+	case int8:
+		return int64(n), true
+	case int16:
+		return int64(n), true
+	case int32:
+		return int64(n), true
+	case int64:
+		return n, true
+	case uint:
+		return int64(n), true
+	case uint8:
+		return int64(n), true
+	case uint16:
+		return int64(n), true
+	case uint32:
+		return int64(n), true
+	case uint64:
+		if n <= math.MaxInt64 {
+			return int64(n), true
+		}
+	}
+	return 0, false
+}
+
+func toFloat64(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	}
+	if n, ok := toInt64(v); ok {
+		return float64(n), true
+	}
+	return 0, false
+}
+
+// rangeTimeLayouts are the layouts of times given as strings, as in inferType.
+var rangeTimeLayouts = []string{time.RFC3339Nano, "2006-01-02 15:04:05.999999999", "2006-01-02"}
+
+// toTime returns a time value and, for a string, the layout it was parsed with.
+func toTime(v interface{}) (time.Time, string, bool) {
+	switch t := v.(type) {
+	case time.Time:
+		return t, "", true
+	case string:
+		for _, layout := range rangeTimeLayouts {
+			if parsed, err := time.Parse(layout, t); err == nil {
+				return parsed, layout, true
+			}
+		}
+	}
+	return time.Time{}, "", false
+}
@@ -0,0 +1,74 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+)
+
+func TestFindRangePairs(t *testing.T) {
+	cases := []struct {
+		query string
+		want  []RangePair
+	}{
+		{"select * from t where created_at between :p1 and :p2", []RangePair{{"created_at", ":p1", ":p2"}}},
+		{"select * from t where o.price >= :p1 and o.price < :p2", []RangePair{{"o.price", ":p1", ":p2"}}},
+		{"select * from t where price < :hi and price >= :lo", []RangePair{{"price", ":lo", ":hi"}}},
+		{"select * from t where :p1 <= ts and :p2 > ts", []RangePair{{"ts", ":p1", ":p2"}}},
+		{"select * from t where `d` not between :p1 and :p2 and x = :p3", []RangePair{{"`d`", ":p1", ":p2"}}},
+		// Bounds of different columns or nesting levels do not pair up.
+		{"select * from t where a >= :p1 and b < :p2", nil},
+		{"select * from t where a >= :p1 and a in (select a from u where a < :p2)", nil},
+		{"select * from t where a > 5 and a < :p1", nil},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.want, FindRangePairs(c.query), c.query)
+	}
+}
+
+func TestParameterAnalyzer_AnalyzeRanges(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2025, 1, d, 0, 0, 0, 0, time.UTC) }
+	traces := []models.SQLTrace{
+		{Parameters: map[string]interface{}{":p1": day(1), ":p2": day(2), ":p3": 10, ":p4": 20}},
+		{Parameters: map[string]interface{}{":p1": day(3), ":p2": day(4), ":p3": 15, ":p4": 25}},
+		{Parameters: map[string]interface{}{":p1": day(5), ":p2": day(12), ":p3": 7, ":p4": 5}},
+	}
+	ranges := NewParameterAnalyzer().AnalyzeRanges("select * from t where d between :p1 and :p2 and n >= :p3 and n <= :p4", traces)
+	require.Len(t, ranges, 2)
+
+	assert.Equal(t, "d", ranges[0].Column)
+	assert.Equal(t, models.RangeTime, ranges[0].Kind)
+	assert.Equal(t, []interface{}{86400.0, 7 * 86400.0}, ranges[0].Width.TopValues)
+	assert.Equal(t, []int{2, 1}, ranges[0].Width.TopFrequencies)
+
+	// The inverted range of the last trace is not learned from.
+	assert.Equal(t, models.RangeInt, ranges[1].Kind)
+	assert.Equal(t, []interface{}{int64(10)}, ranges[1].Width.TopValues)
+}
+
+func TestAddRangeWidth(t *testing.T) {
+	cases := []struct {
+		lo    interface{}
+		kind  models.RangeKind
+		width interface{}
+		want  interface{}
+	}{
+		{10, models.RangeInt, int64(5), 15},
+		{int64(10), models.RangeInt, int64(5), int64(15)},
+		{1.5, models.RangeFloat, 0.25, 1.75},
+		{time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), models.RangeTime, 3600.0, time.Date(2025, 1, 1, 1, 0, 0, 0, time.UTC)},
+		{"2025-01-01 00:00:00", models.RangeTime, 90.0, "2025-01-01 00:01:30"},
+		{"2025-01-31", models.RangeTime, 86400.0, "2025-02-01"},
+	}
+	for _, c := range cases {
+		got, ok := AddRangeWidth(c.lo, c.kind, c.width)
+		assert.True(t, ok)
+		assert.Equal(t, c.want, got)
+	}
+
+	_, ok := AddRangeWidth("abc", models.RangeTime, 1.0)
+	assert.False(t, ok)
+}
@@ -1,7 +1,9 @@
 package services
 
 import (
+	"math"
 	"math/rand"
+	"strings"
 	"time"
 
 	"github.com/turtacn/SQLTraceBench/internal/domain/models"
@@ -124,3 +126,162 @@ func (z *ZipfSampler) Sample(dist *models.ParameterModel) (interface{}, error) {
 
 	return dist.TopValues[int(idx)], nil
 }
+
+// ContinuousSampler draws values from the continuous distribution fitted to a parameter,
+// so that values which were not observed are generated too. Values stay inside the
+// observed range and keep the parameter's kind: integers, floats or times.
+type ContinuousSampler struct {
+	rand *rand.Rand
+}
+
+func NewContinuousSampler() *ContinuousSampler {
+	return &ContinuousSampler{
+		rand: rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+func NewSeededContinuousSampler(seed int64) *ContinuousSampler {
+	return &ContinuousSampler{
+		rand: rand.New(rand.NewSource(seed)),
+	}
+}
+
+// maxContinuousDraws is the number of draws outside the observed range after which
+// a value is clamped into it.
+const maxContinuousDraws = 16
+
+func (s *ContinuousSampler) Sample(dist *models.ParameterModel) (interface{}, error) {
+	c := dist.Continuous
+	if c == nil {
+		return nil, types.NewError(types.ErrInvalidInput, "parameter has no continuous distribution")
+	}
+
+	x := s.draw(dist.DistType, c)
+	for i := 1; i < maxContinuousDraws && (x < c.Min || x > c.Max); i++ {
+		x = s.draw(dist.DistType, c)
+	}
+	x = math.Min(math.Max(x, c.Min), c.Max)
+
+	switch c.ValueKind {
+	case "int":
+		n := int64(math.Round(x))
+		if len(dist.TopValues) > 0 {
+			if _, ok := dist.TopValues[0].(int); ok {
+				return int(n), nil
+			}
+		}
+		return n, nil
+	case "time":
+		t := time.Unix(0, int64(math.Round(x*1e9))).UTC()
+		if c.Layout != "" {
+			return t.Format(c.Layout), nil
+		}
+		return t, nil
+	}
+	return x, nil
+}
+
+func (s *ContinuousSampler) draw(dist models.DistributionType, c *models.ContinuousModel) float64 {
+	switch dist {
+	case models.DistNormal:
+		return c.Mu + c.Sigma*s.rand.NormFloat64()
+	case models.DistLogNormal:
+		return math.Exp(c.Mu + c.Sigma*s.rand.NormFloat64())
+	case models.DistExponential:
+		return c.Min + s.rand.ExpFloat64()/c.Rate
+	case models.DistHistogram:
+		total := 0
+		for _, n := range c.BinCounts {
+			total += n
+		}
+		if total > 0 && len(c.BinEdges) == len(c.BinCounts)+1 {
+			r := s.rand.Intn(total)
+			for i, n := range c.BinCounts {
+				if r < n {
+					return c.BinEdges[i] + s.rand.Float64()*(c.BinEdges[i+1]-c.BinEdges[i])
+				}
+				r -= n
+			}
+		}
+	}
+	return c.Min + s.rand.Float64()*(c.Max-c.Min)
+}
+
+// StringPatternSampler synthesizes string values from the patterns learned for a
+// parameter, see models.StringModel.
+type StringPatternSampler struct {
+	rand *rand.Rand
+}
+
+func NewStringPatternSampler() *StringPatternSampler {
+	return &StringPatternSampler{
+		rand: rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+func NewSeededStringPatternSampler(seed int64) *StringPatternSampler {
+	return &StringPatternSampler{
+		rand: rand.New(rand.NewSource(seed)),
+	}
+}
+
+// Fresh reports whether the next value should be synthesized rather than replayed,
+// with the probability of the model's FreshRatio.
+func (s *StringPatternSampler) Fresh(m *models.StringModel) bool {
+	return m != nil && len(m.Patterns) > 0 && s.rand.Float64() < m.FreshRatio
+}
+
+// Synthesize returns a value that fits one of the patterns, chosen by weight.
+func (s *StringPatternSampler) Synthesize(m *models.StringModel) (string, error) {
+	weights := make([]int, len(m.Patterns))
+	for i, p := range m.Patterns {
+		weights[i] = p.Weight
+	}
+	idx := s.pick(weights)
+	if idx < 0 {
+		return "", types.NewError(types.ErrInvalidInput, "cannot synthesize a string without patterns")
+	}
+
+	var b strings.Builder
+	for _, seg := range m.Patterns[idx].Segments {
+		if len(seg.Choices) > 0 {
+			if i := s.pick(seg.ChoiceFreqs); i >= 0 && i < len(seg.Choices) {
+				b.WriteString(seg.Choices[i])
+			} else {
+				b.WriteString(seg.Choices[0])
+			}
+			continue
+		}
+		alphabet := []rune(seg.Alphabet)
+		if len(alphabet) == 0 {
+			continue
+		}
+		n := 1
+		if i := s.pick(seg.LengthFreqs); i >= 0 && i < len(seg.Lengths) {
+			n = seg.Lengths[i]
+		}
+		for j := 0; j < n; j++ {
+			b.WriteRune(alphabet[s.rand.Intn(len(alphabet))])
+		}
+	}
+	return b.String(), nil
+}
+
+// pick returns an index with probability proportional to its weight, or -1.
+func (s *StringPatternSampler) pick(weights []int) int {
+	total := 0
+	for _, w := range weights {
+		total += w
+	}
+	if total <= 0 {
+		return -1
+	}
+	r := s.rand.Intn(total)
+	for i, w := range weights {
+		if r < w {
+			return i
+		}
+		r -= w
+	}
+	return len(weights) - 1
+}
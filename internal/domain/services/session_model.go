@@ -0,0 +1,326 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+)
+
+// DefaultSessionTimeout is the pause after which the next query of a session starts a
+// new one, when SessionConfig.Timeout is not set.
+const DefaultSessionTimeout = 30 * time.Minute
+
+// maxSessionOrder bounds the order of a session model.
+const maxSessionOrder = 5
+
+// SessionConfig configures the Markov model of the templates of sessions.
+type SessionConfig struct {
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// Order is the number of preceding templates the next one depends on, from 1 (the
+	// default) to 5.
+	Order int `yaml:"order" json:"order,omitempty"`
+	// Timeout is the pause after which a session is split in two. Zero uses
+	// DefaultSessionTimeout.
+	Timeout time.Duration `yaml:"timeout" json:"timeout,omitempty"`
+}
+
+// SessionAnalyzer learns a models.SessionModel from the traces of sessions.
+type SessionAnalyzer struct {
+	order    int
+	timeout  time.Duration
+	detector *HotspotDetector
+}
+
+// NewSessionAnalyzer returns an analyzer for a session configuration.
+func NewSessionAnalyzer(cfg SessionConfig) (*SessionAnalyzer, error) {
+	order := cfg.Order
+	if order == 0 {
+		order = 1
+	}
+	if order < 1 || order > maxSessionOrder {
+		return nil, fmt.Errorf("session model order must be in [1, %d], got %d", maxSessionOrder, cfg.Order)
+	}
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = DefaultSessionTimeout
+	}
+	return &SessionAnalyzer{order: order, timeout: timeout, detector: NewHotspotDetector()}, nil
+}
+
+// Analyze learns the transitions between the templates of the traces' sessions; keys[i]
+// is the GroupKey of the template of traces[i], or empty to leave the trace out. The
+// queries of a session are ordered by timestamp. Traces without a SessionID are left
+// out, and nil is returned when no trace has one.
+func (a *SessionAnalyzer) Analyze(traces []models.SQLTrace, keys []string) *models.SessionModel {
+	order := make([]int, len(traces))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return traces[order[i]].Timestamp.Before(traces[order[j]].Timestamp) })
+	sketch := a.NewSketch()
+	for _, i := range order {
+		sketch.Add(traces[i], keys[i])
+	}
+	return sketch.Model(nil)
+}
+
+// sessionSweepInterval is the number of traces after which a SessionSketch closes the
+// sessions that have timed out.
+const sessionSweepInterval = 4096
+
+// SessionSketch learns the transitions between the templates of sessions from a stream
+// of traces in a single pass. Only the open sessions are held, each by its last
+// templates, and sessions idle for longer than the timeout are closed as the stream
+// goes on, so the queries of a session must arrive in timestamp order, as trace
+// sources merge them.
+type SessionSketch struct {
+	analyzer *SessionAnalyzer
+	open     map[string]*openSession
+	states   map[string]*sessionCounts
+	latest   time.Time
+	added    int
+}
+
+// openSession is a session of a SessionSketch that may go on.
+type openSession struct {
+	history []string  // the last templates, up to the order of the model
+	end     time.Time // when the last query finished
+}
+
+// sessionCounts counts the templates that follow a context, and the think times before them.
+type sessionCounts struct {
+	context []string
+	next    map[string]int
+	think   *ParameterSketch
+}
+
+// NewSketch returns an empty SessionSketch.
+func (a *SessionAnalyzer) NewSketch() *SessionSketch {
+	return &SessionSketch{analyzer: a, open: make(map[string]*openSession), states: make(map[string]*sessionCounts)}
+}
+
+// Add adds a trace of the template with GroupKey key; traces without a SessionID or key
+// are left out.
+func (s *SessionSketch) Add(trace models.SQLTrace, key string) {
+	if trace.SessionID == "" || key == "" {
+		return
+	}
+	if trace.Timestamp.After(s.latest) {
+		s.latest = trace.Timestamp
+	}
+	sess, ok := s.open[trace.SessionID]
+	// A session that went idle for longer than the timeout starts over.
+	if ok && trace.Timestamp.Sub(sess.end) > s.analyzer.timeout {
+		s.close(sess)
+		ok = false
+	}
+	if !ok {
+		sess = &openSession{}
+		s.open[trace.SessionID] = sess
+		s.count(nil, key, 0, false)
+	} else {
+		// Think times are kept to the millisecond.
+		think := math.Round(math.Max(trace.Timestamp.Sub(sess.end).Seconds(), 0)*1000) / 1000
+		s.countContexts(sess.history, key, think, true)
+	}
+	sess.history = append(sess.history, key)
+	if len(sess.history) > s.analyzer.order {
+		sess.history = sess.history[1:]
+	}
+	sess.end = trace.Timestamp.Add(trace.Latency)
+
+	if s.added++; s.added%sessionSweepInterval == 0 {
+		for id, sess := range s.open {
+			if s.latest.Sub(sess.end) > s.analyzer.timeout {
+				s.close(sess)
+				delete(s.open, id)
+			}
+		}
+	}
+}
+
+// close counts the end of a session.
+func (s *SessionSketch) close(sess *openSession) {
+	s.countContexts(sess.history, "", 0, false)
+}
+
+// countContexts counts a transition after each suffix of a session's history.
+func (s *SessionSketch) countContexts(history []string, next string, think float64, hasThink bool) {
+	for n := 1; n <= len(history); n++ {
+		s.count(history[len(history)-n:], next, think, hasThink)
+	}
+}
+
+func (s *SessionSketch) count(context []string, next string, think float64, hasThink bool) {
+	key := strings.Join(context, "\x00")
+	st, ok := s.states[key]
+	if !ok {
+		st = &sessionCounts{context: append([]string(nil), context...), next: make(map[string]int)}
+		s.states[key] = st
+	}
+	st.next[next]++
+	if hasThink {
+		if st.think == nil {
+			st.think = NewParameterSketch("think_time", 0)
+		}
+		st.think.Add(think)
+	}
+}
+
+// Model closes the open sessions and returns the learned model, or nil if no trace had
+// a session. rename, if not nil, maps the GroupKey of each template to the one it is
+// modeled as, such as the representative of its cluster; the transitions of contexts
+// that become the same add up.
+func (s *SessionSketch) Model(rename func(string) string) *models.SessionModel {
+	ids := make([]string, 0, len(s.open))
+	for id := range s.open {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		s.close(s.open[id])
+		delete(s.open, id)
+	}
+	if len(s.states) == 0 {
+		return nil
+	}
+
+	states := s.states
+	if rename != nil {
+		states = make(map[string]*sessionCounts, len(s.states))
+		for _, st := range s.states {
+			context := make([]string, len(st.context))
+			for i, k := range st.context {
+				context[i] = rename(k)
+			}
+			key := strings.Join(context, "\x00")
+			merged, ok := states[key]
+			if !ok {
+				merged = &sessionCounts{context: context, next: make(map[string]int)}
+				states[key] = merged
+			}
+			for next, n := range st.next {
+				if next != "" {
+					next = rename(next)
+				}
+				merged.next[next] += n
+			}
+			if st.think != nil {
+				if merged.think == nil {
+					merged.think = NewParameterSketch("think_time", 0)
+				}
+				merged.think.Merge(st.think)
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(states))
+	for key := range states {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	model := &models.SessionModel{Order: s.analyzer.order}
+	for _, key := range keys {
+		st := states[key]
+		out := models.SessionState{Context: st.context}
+		nexts := make([]string, 0, len(st.next))
+		for next := range st.next {
+			nexts = append(nexts, next)
+		}
+		sort.Strings(nexts)
+		for _, next := range nexts {
+			out.Next = append(out.Next, next)
+			out.Counts = append(out.Counts, st.next[next])
+		}
+		if st.think != nil {
+			out.ThinkTime = s.analyzer.detector.DetectDistribution(st.think.Stats())
+			out.ThinkTime.ParamName = "think_time"
+		}
+		model.States = append(model.States, out)
+	}
+	return model
+}
+
+// SessionSampler walks a models.SessionModel to generate the templates of sessions.
+type SessionSampler struct {
+	model      *models.SessionModel
+	rand       *rand.Rand
+	states     map[string]*models.SessionState
+	continuous *ContinuousSampler
+	weighted   *WeightedRandomSampler
+}
+
+// NewSessionSampler creates a sampler for a session model.
+func NewSessionSampler(model *models.SessionModel, r *rand.Rand) *SessionSampler {
+	s := &SessionSampler{
+		model:      model,
+		rand:       r,
+		states:     make(map[string]*models.SessionState, len(model.States)),
+		continuous: NewSeededContinuousSampler(r.Int63()),
+		weighted:   NewSeededWeightedRandomSampler(r.Int63()),
+	}
+	for i := range model.States {
+		s.states[strings.Join(model.States[i].Context, "\x00")] = &model.States[i]
+	}
+	return s
+}
+
+// Next returns the GroupKey of the template that follows the templates of a session so
+// far, and the think time before it, or an empty key when the session ends. The state
+// of the longest observed context of up to Order templates is used.
+func (s *SessionSampler) Next(history []string) (string, time.Duration) {
+	var st *models.SessionState
+	if len(history) == 0 {
+		st = s.states[""]
+	}
+	for n := min(s.model.Order, len(history)); n >= 1 && st == nil; n-- {
+		st = s.states[strings.Join(history[len(history)-n:], "\x00")]
+	}
+	if st == nil {
+		return "", 0
+	}
+
+	total := 0
+	for _, c := range st.Counts {
+		total += c
+	}
+	if total <= 0 || len(st.Next) != len(st.Counts) {
+		return "", 0
+	}
+	r := s.rand.Intn(total)
+	next := st.Next[len(st.Next)-1]
+	for i, c := range st.Counts {
+		if r < c {
+			next = st.Next[i]
+			break
+		}
+		r -= c
+	}
+	if next == "" || len(history) == 0 {
+		return next, 0
+	}
+	return next, s.thinkTime(st.ThinkTime)
+}
+
+func (s *SessionSampler) thinkTime(m *models.ParameterModel) time.Duration {
+	if m == nil {
+		return 0
+	}
+	var v interface{}
+	var err error
+	if m.Continuous != nil {
+		v, err = s.continuous.Sample(m)
+	} else {
+		v, err = s.weighted.Sample(m)
+	}
+	seconds, ok := toFloat64(v)
+	if err != nil || !ok || seconds < 0 {
+		return 0
+	}
+	return time.Duration(seconds * float64(time.Second))
+}
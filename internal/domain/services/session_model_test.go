@@ -0,0 +1,157 @@
+package services
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+)
+
+// sessionTraces returns the traces of sessions that each issue a flow of templates,
+// named by their keys, with a think time of two seconds after each query.
+func sessionTraces(flows ...[]string) ([]models.SQLTrace, []string) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	var traces []models.SQLTrace
+	var keys []string
+	for i, flow := range flows {
+		ts := start.Add(time.Duration(i) * time.Minute)
+		for _, key := range flow {
+			traces = append(traces, models.SQLTrace{Query: key, SessionID: fmt.Sprint(i), Timestamp: ts, Latency: 100 * time.Millisecond})
+			keys = append(keys, key)
+			ts = ts.Add(2100 * time.Millisecond)
+		}
+	}
+	return traces, keys
+}
+
+func sessionState(m *models.SessionModel, context ...string) *models.SessionState {
+	for i := range m.States {
+		if strings.Join(m.States[i].Context, ",") == strings.Join(context, ",") {
+			return &m.States[i]
+		}
+	}
+	return nil
+}
+
+func TestSessionAnalyzer(t *testing.T) {
+	traces, keys := sessionTraces(
+		[]string{"login", "cart", "checkout"},
+		[]string{"login", "cart", "checkout"},
+		[]string{"login", "search", "cart"},
+		[]string{"search"},
+	)
+	// Traces are ordered by time within a session, not by their position.
+	traces[0], traces[1] = traces[1], traces[0]
+	keys[0], keys[1] = keys[1], keys[0]
+
+	analyzer, err := NewSessionAnalyzer(SessionConfig{Enabled: true, Order: 2})
+	require.NoError(t, err)
+	m := analyzer.Analyze(traces, keys)
+	require.NotNil(t, m)
+	assert.Equal(t, 2, m.Order)
+
+	start := sessionState(m)
+	require.NotNil(t, start)
+	assert.Equal(t, []string{"login", "search"}, start.Next)
+	assert.Equal(t, []int{3, 1}, start.Counts)
+	assert.Nil(t, start.ThinkTime)
+
+	cart := sessionState(m, "cart")
+	require.NotNil(t, cart)
+	assert.Equal(t, []string{"", "checkout"}, cart.Next, "an empty key ends the session")
+	assert.Equal(t, []int{1, 2}, cart.Counts)
+	require.NotNil(t, cart.ThinkTime)
+	assert.Equal(t, []interface{}{2.0}, cart.ThinkTime.TopValues)
+
+	// The second-order context tells the flows through the cart apart.
+	searchCart := sessionState(m, "search", "cart")
+	require.NotNil(t, searchCart)
+	assert.Equal(t, []string{""}, searchCart.Next)
+
+	// Without sessions there is no model.
+	assert.Nil(t, analyzer.Analyze([]models.SQLTrace{{Query: "q"}}, []string{"q"}))
+
+	_, err = NewSessionAnalyzer(SessionConfig{Order: 9})
+	assert.Error(t, err)
+}
+
+func TestSessionAnalyzer_Timeout(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	traces := []models.SQLTrace{
+		{SessionID: "a", Timestamp: start},
+		{SessionID: "a", Timestamp: start.Add(time.Second)},
+		{SessionID: "a", Timestamp: start.Add(2 * time.Hour)},
+	}
+	analyzer, err := NewSessionAnalyzer(SessionConfig{Enabled: true, Timeout: time.Hour})
+	require.NoError(t, err)
+	m := analyzer.Analyze(traces, []string{"login", "cart", "login"})
+
+	// The idle pause starts a second session.
+	assert.Equal(t, []int{2}, sessionState(m).Counts)
+	assert.Equal(t, []string{"", "cart"}, sessionState(m, "login").Next)
+}
+
+func TestSessionSampler(t *testing.T) {
+	var flows [][]string
+	for i := 0; i < 30; i++ {
+		flows = append(flows, []string{"login", "cart", "checkout"})
+	}
+	for i := 0; i < 10; i++ {
+		flows = append(flows, []string{"login", "search", "search", "cart"})
+	}
+	traces, keys := sessionTraces(flows...)
+	analyzer, err := NewSessionAnalyzer(SessionConfig{Enabled: true, Order: 2})
+	require.NoError(t, err)
+	sampler := NewSessionSampler(analyzer.Analyze(traces, keys), rand.New(rand.NewSource(1)))
+
+	generated := make(map[string]int)
+	for i := 0; i < 2000; i++ {
+		var history []string
+		for {
+			key, think := sampler.Next(history)
+			if key == "" {
+				break
+			}
+			if len(history) == 0 {
+				assert.Zero(t, think)
+			} else {
+				assert.Equal(t, 2*time.Second, think)
+			}
+			history = append(history, key)
+		}
+		generated[strings.Join(history, ",")]++
+	}
+
+	// Only the observed flows are generated, in their proportions.
+	assert.Len(t, generated, 2, generated)
+	assert.InDelta(t, 0.75, float64(generated["login,cart,checkout"])/2000, 0.03)
+	assert.InDelta(t, 0.25, float64(generated["login,search,search,cart"])/2000, 0.03)
+}
+
+func TestSessionSketch(t *testing.T) {
+	// Many short sessions, one after the other.
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	analyzer, err := NewSessionAnalyzer(SessionConfig{Enabled: true, Timeout: time.Minute})
+	require.NoError(t, err)
+	sketch := analyzer.NewSketch()
+	for i := 0; i < 3*sessionSweepInterval; i++ {
+		ts := start.Add(time.Duration(i) * time.Second)
+		key := "login"
+		if i%2 == 1 {
+			key = "cart"
+		}
+		sketch.Add(models.SQLTrace{SessionID: fmt.Sprint(i / 2), Timestamp: ts}, key)
+	}
+	assert.Less(t, len(sketch.open), 2*sessionSweepInterval, "timed out sessions are closed as the traces go on")
+
+	m := sketch.Model(func(key string) string { return "page:" + key })
+	assert.Equal(t, []string{"page:login"}, sessionState(m).Next)
+	assert.Equal(t, []int{3 * sessionSweepInterval / 2}, sessionState(m).Counts)
+	assert.Equal(t, []string{"page:cart"}, sessionState(m, "page:login").Next)
+	assert.Equal(t, []string{""}, sessionState(m, "page:cart").Next)
+}
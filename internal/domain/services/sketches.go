@@ -0,0 +1,315 @@
+package services
+
+import (
+	"container/heap"
+	"hash/fnv"
+	"math"
+	"math/bits"
+	"sort"
+)
+
+// SpaceSaving tracks the most frequent values of a stream in a fixed number of
+// counters. A value that arrives when all counters are taken replaces the least
+// frequent one and inherits its count as an error bound, so counts are exact until
+// the first eviction and lower bounds (count minus error) after it.
+type SpaceSaving struct {
+	capacity int
+	index    map[interface{}]*ssCounter
+	heap     ssHeap
+	evicted  bool
+}
+
+type ssCounter struct {
+	value interface{}
+	count int
+	err   int
+	pos   int
+}
+
+// ssHeap orders counters by count, least frequent first.
+type ssHeap []*ssCounter
+
+func (h ssHeap) Len() int           { return len(h) }
+func (h ssHeap) Less(i, j int) bool { return h[i].count < h[j].count }
+func (h ssHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].pos, h[j].pos = i, j
+}
+func (h *ssHeap) Push(x interface{}) {
+	c := x.(*ssCounter)
+	c.pos = len(*h)
+	*h = append(*h, c)
+}
+func (h *ssHeap) Pop() interface{} {
+	old := *h
+	c := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return c
+}
+
+func NewSpaceSaving(capacity int) *SpaceSaving {
+	if capacity <= 0 {
+		capacity = 10000
+	}
+	return &SpaceSaving{capacity: capacity, index: make(map[interface{}]*ssCounter)}
+}
+
+// Add counts n more observations of a value.
+func (s *SpaceSaving) Add(value interface{}, n int) {
+	if c, ok := s.index[value]; ok {
+		c.count += n
+		heap.Fix(&s.heap, c.pos)
+		return
+	}
+	if len(s.heap) < s.capacity {
+		c := &ssCounter{value: value, count: n}
+		s.index[value] = c
+		heap.Push(&s.heap, c)
+		return
+	}
+	min := s.heap[0]
+	delete(s.index, min.value)
+	min.value, min.err = value, min.count
+	min.count += n
+	s.index[value] = min
+	heap.Fix(&s.heap, 0)
+	s.evicted = true
+}
+
+// Merge adds the counts of another summary. A value missing from a summary that has
+// evicted values may have been counted up to its smallest count, which is added to the
+// value's count and error; the capacity most frequent values are kept.
+func (s *SpaceSaving) Merge(o *SpaceSaving) {
+	floor := func(x *SpaceSaving) int {
+		if x.evicted && len(x.heap) > 0 {
+			return x.heap[0].count
+		}
+		return 0
+	}
+	sFloor, oFloor := floor(s), floor(o)
+	merged := make([]*ssCounter, 0, len(s.heap)+len(o.heap))
+	for _, c := range s.heap {
+		m := &ssCounter{value: c.value, count: c.count + oFloor, err: c.err + oFloor}
+		if oc, ok := o.index[c.value]; ok {
+			m.count, m.err = c.count+oc.count, c.err+oc.err
+		}
+		merged = append(merged, m)
+	}
+	for _, oc := range o.heap {
+		if _, ok := s.index[oc.value]; !ok {
+			merged = append(merged, &ssCounter{value: oc.value, count: oc.count + sFloor, err: oc.err + sFloor})
+		}
+	}
+
+	s.evicted = s.evicted || o.evicted
+	if len(merged) > s.capacity {
+		sort.Slice(merged, func(i, j int) bool {
+			if merged[i].count != merged[j].count {
+				return merged[i].count > merged[j].count
+			}
+			return valueKey(merged[i].value) < valueKey(merged[j].value)
+		})
+		merged = merged[:s.capacity]
+		s.evicted = true
+	}
+	s.index = make(map[interface{}]*ssCounter, len(merged))
+	s.heap = s.heap[:0]
+	for i, c := range merged {
+		c.pos = i
+		s.index[c.value] = c
+		s.heap = append(s.heap, c)
+	}
+	heap.Init(&s.heap)
+}
+
+// Exact reports whether no value has been evicted, so that the counts are exact.
+func (s *SpaceSaving) Exact() bool {
+	return !s.evicted
+}
+
+// Counts returns the guaranteed counts of the tracked values, leaving out those whose
+// count is all error.
+func (s *SpaceSaving) Counts() map[interface{}]int {
+	counts := make(map[interface{}]int, len(s.heap))
+	for _, c := range s.heap {
+		if n := c.count - c.err; n > 0 {
+			counts[c.value] = n
+		}
+	}
+	return counts
+}
+
+// hllPrecision is the number of index bits of a HyperLogLog, for 4096 registers and a
+// standard error of about 1.6%.
+const hllPrecision = 12
+
+// HyperLogLog estimates the number of distinct values of a stream.
+type HyperLogLog struct {
+	registers []uint8
+}
+
+func NewHyperLogLog() *HyperLogLog {
+	return &HyperLogLog{registers: make([]uint8, 1<<hllPrecision)}
+}
+
+func (h *HyperLogLog) Add(value interface{}) {
+	x := hashValue(value)
+	idx := x >> (64 - hllPrecision)
+	rank := uint8(bits.LeadingZeros64(x<<hllPrecision|1<<(hllPrecision-1))) + 1
+	if rank > h.registers[idx] {
+		h.registers[idx] = rank
+	}
+}
+
+// Merge makes the sketch count the values of another one as well.
+func (h *HyperLogLog) Merge(o *HyperLogLog) {
+	for i, r := range o.registers {
+		if r > h.registers[i] {
+			h.registers[i] = r
+		}
+	}
+}
+
+// Estimate returns the estimated number of distinct values, using linear counting
+// for small cardinalities.
+func (h *HyperLogLog) Estimate() int {
+	m := float64(len(h.registers))
+	sum, zeros := 0.0, 0
+	for _, r := range h.registers {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+	estimate := 0.7213 / (1 + 1.079/m) * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return int(math.Round(estimate))
+}
+
+// hashValue hashes a value by its key, with a final mix so that all bits vary.
+func hashValue(v interface{}) uint64 {
+	f := fnv.New64a()
+	f.Write([]byte(valueKey(v)))
+	x := f.Sum64()
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// tdigestCompression bounds the number of centroids of a TDigest to about 100.
+const tdigestCompression = 100
+
+// TDigest summarizes the distribution of a stream of numbers in centroids that are
+// small at the tails and larger in the middle, which keeps quantiles accurate.
+type TDigest struct {
+	centroids []weightedPoint
+	buffer    []weightedPoint
+	count     float64
+	min, max  float64
+}
+
+func NewTDigest() *TDigest {
+	return &TDigest{min: math.Inf(1), max: math.Inf(-1)}
+}
+
+// Add adds w observations of x.
+func (d *TDigest) Add(x, w float64) {
+	d.buffer = append(d.buffer, weightedPoint{x: x, n: w})
+	d.count += w
+	d.min, d.max = math.Min(d.min, x), math.Max(d.max, x)
+	if len(d.buffer) >= 5*tdigestCompression {
+		d.compress()
+	}
+}
+
+// Merge adds the observations summarized by another digest.
+func (d *TDigest) Merge(o *TDigest) {
+	d.buffer = append(d.buffer, o.centroids...)
+	d.buffer = append(d.buffer, o.buffer...)
+	d.count += o.count
+	d.min, d.max = math.Min(d.min, o.min), math.Max(d.max, o.max)
+	d.compress()
+}
+
+// Count returns the number of observations.
+func (d *TDigest) Count() float64 {
+	return d.count
+}
+
+// Min and Max return the extreme observations.
+func (d *TDigest) Min() float64 { return d.min }
+func (d *TDigest) Max() float64 { return d.max }
+
+// Centroids returns the centroids in ascending order.
+func (d *TDigest) Centroids() []weightedPoint {
+	d.compress()
+	return d.centroids
+}
+
+// Quantile returns an estimate of the value below which a share q of the observations
+// fall, interpolating between the centers of the centroids.
+func (d *TDigest) Quantile(q float64) float64 {
+	d.compress()
+	if len(d.centroids) == 0 {
+		return math.NaN()
+	}
+	if q <= 0 {
+		return d.min
+	}
+	if q >= 1 {
+		return d.max
+	}
+	target := q * d.count
+	prevX, prevPos, cum := d.min, 0.0, 0.0
+	for _, c := range d.centroids {
+		pos := cum + c.n/2
+		if target < pos {
+			if pos == prevPos {
+				return c.x
+			}
+			return prevX + (c.x-prevX)*(target-prevPos)/(pos-prevPos)
+		}
+		prevX, prevPos = c.x, pos
+		cum += c.n
+	}
+	if d.count == prevPos {
+		return d.max
+	}
+	return prevX + (d.max-prevX)*(target-prevPos)/(d.count-prevPos)
+}
+
+// compress merges the buffered points into the centroids. A centroid grows while it
+// spans at most one unit of the scale k(q) = δ/2π·asin(2q-1).
+func (d *TDigest) compress() {
+	if len(d.buffer) == 0 {
+		return
+	}
+	points := append(d.centroids, d.buffer...)
+	d.buffer = d.buffer[:0]
+	sort.Slice(points, func(i, j int) bool { return points[i].x < points[j].x })
+
+	scale := func(q float64) float64 {
+		return tdigestCompression / (2 * math.Pi) * math.Asin(2*math.Min(q, 1)-1)
+	}
+	out := make([]weightedPoint, 0, 2*tdigestCompression)
+	cur := points[0]
+	done := 0.0
+	kLeft := scale(0)
+	for _, p := range points[1:] {
+		if scale((done+cur.n+p.n)/d.count)-kLeft <= 1 {
+			cur.x += (p.x - cur.x) * p.n / (cur.n + p.n)
+			cur.n += p.n
+			continue
+		}
+		out = append(out, cur)
+		done += cur.n
+		kLeft = scale(done / d.count)
+		cur = p
+	}
+	d.centroids = append(out, cur)
+}
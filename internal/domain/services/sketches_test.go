@@ -0,0 +1,196 @@
+package services
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+)
+
+func TestSpaceSaving(t *testing.T) {
+	s := NewSpaceSaving(3)
+	for _, v := range []string{"a", "b", "a", "c"} {
+		s.Add(v, 1)
+	}
+	assert.True(t, s.Exact())
+	assert.Equal(t, map[interface{}]int{"a": 2, "b": 1, "c": 1}, s.Counts())
+
+	// "d" takes the place of a value seen once, which it may have been.
+	s.Add("d", 1)
+	s.Add("a", 1)
+	assert.False(t, s.Exact())
+	counts := s.Counts()
+	assert.Equal(t, 3, counts["a"])
+	assert.Equal(t, 1, counts["d"])
+	assert.Len(t, counts, 3)
+
+	// Heavy hitters survive a long tail, and so do they across shards.
+	r := rand.New(rand.NewSource(1))
+	whole, left, right := NewSpaceSaving(100), NewSpaceSaving(100), NewSpaceSaving(100)
+	for i := 0; i < 100000; i++ {
+		v := r.Intn(1000000)
+		if i%10 == 0 {
+			v = i / 10 % 5
+		}
+		whole.Add(v, 1)
+		if i%2 == 0 {
+			left.Add(v, 1)
+		} else {
+			right.Add(v, 1)
+		}
+	}
+	left.Merge(right)
+	for _, counts := range []map[interface{}]int{whole.Counts(), left.Counts()} {
+		for v := 0; v < 5; v++ {
+			assert.InDelta(t, 2000, counts[v], 400, "value %d", v)
+		}
+	}
+}
+
+func TestHyperLogLog(t *testing.T) {
+	h, left, right := NewHyperLogLog(), NewHyperLogLog(), NewHyperLogLog()
+	for i := 0; i < 200000; i++ {
+		h.Add(i)
+		if i < 120000 {
+			left.Add(i)
+		}
+		if i >= 80000 {
+			right.Add(i)
+		}
+	}
+	assert.InEpsilon(t, 200000, h.Estimate(), 0.05)
+	left.Merge(right)
+	assert.Equal(t, h.Estimate(), left.Estimate(), "merging overlapping shards counts each value once")
+
+	small := NewHyperLogLog()
+	for i := 0; i < 100; i++ {
+		small.Add("v" + string(rune('a'+i%26)))
+	}
+	assert.Equal(t, 26, small.Estimate())
+}
+
+func TestTDigest(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	d, left, right := NewTDigest(), NewTDigest(), NewTDigest()
+	for i := 0; i < 100000; i++ {
+		x := r.Float64() * 1000
+		d.Add(x, 1)
+		if i%3 == 0 {
+			left.Add(x, 1)
+		} else {
+			right.Add(x, 1)
+		}
+	}
+	left.Merge(right)
+	for _, digest := range []*TDigest{d, left} {
+		assert.Less(t, len(digest.Centroids()), 2*tdigestCompression)
+		assert.Equal(t, 100000.0, digest.Count())
+		for _, q := range []float64{0.01, 0.25, 0.5, 0.75, 0.99} {
+			assert.InDelta(t, q*1000, digest.Quantile(q), 10, "quantile %v", q)
+		}
+		assert.Equal(t, d.Min(), digest.Quantile(0))
+		assert.Equal(t, d.Max(), digest.Quantile(1))
+	}
+}
+
+func TestParameterAnalyzer_Sketches(t *testing.T) {
+	// A few hot customers and a long tail of one-off ones, far more than are tracked.
+	r := rand.New(rand.NewSource(3))
+	var traces []models.SQLTrace
+	for i := 0; i < 50000; i++ {
+		customer := 1000 + r.Intn(10000000)
+		if r.Float64() < 0.6 {
+			customer = r.Intn(50)
+		}
+		traces = append(traces, models.SQLTrace{Parameters: map[string]interface{}{
+			":customer": customer,
+			":amount":   math.Exp(3 + r.NormFloat64()),
+		}})
+	}
+
+	analyzer := NewParameterAnalyzer()
+	analyzer.MaxCardinality = 500
+	whole := analyzer.Analyze(traces)
+
+	customer := whole[":customer"]
+	require.NotNil(t, customer)
+	assert.InEpsilon(t, 20000, customer.Cardinality, 0.1)
+	// The hotspot ratio counts the head beyond the tracked values, as an exact
+	// analysis does.
+	exact := NewParameterAnalyzer()
+	exact.MaxCardinality = 1 << 20
+	assert.InDelta(t, exact.Analyze(traces)[":customer"].HotspotRatio, customer.HotspotRatio, 0.02)
+	assert.Less(t, customer.TopValues[0].(int), 50, "hot customers lead the top values")
+
+	amount := whole[":amount"]
+	require.NotNil(t, amount.Continuous)
+	assert.Equal(t, models.DistLogNormal, amount.DistType)
+	assert.InDelta(t, 3, amount.Continuous.Mu, 0.05)
+	assert.InDelta(t, 1, amount.Continuous.Sigma, 0.05)
+
+	// Shards merge into the model of the whole trace.
+	shards := []*ParameterSketches{analyzer.NewSketches(), analyzer.NewSketches(), analyzer.NewSketches()}
+	for i, trace := range traces {
+		shards[i%3].Add(trace)
+	}
+	shards[0].Merge(shards[1])
+	shards[0].Merge(shards[2])
+	merged := analyzer.Models(shards[0])
+	assert.InEpsilon(t, customer.Cardinality, merged[":customer"].Cardinality, 0.02)
+	assert.InDelta(t, customer.HotspotRatio, merged[":customer"].HotspotRatio, 0.05)
+	assert.Equal(t, amount.DistType, merged[":amount"].DistType)
+	assert.InDelta(t, amount.Continuous.Mu, merged[":amount"].Continuous.Mu, 0.02)
+}
+
+func TestJointSketch(t *testing.T) {
+	// Tenants and their users, with a long tail of one-off pairs.
+	r := rand.New(rand.NewSource(5))
+	var traces []models.SQLTrace
+	for i := 0; i < 20000; i++ {
+		tenant := r.Intn(5)
+		user := tenant*10 + r.Intn(3)
+		if r.Float64() < 0.5 {
+			tenant, user = 100+r.Intn(1000000), r.Intn(1000000)
+		}
+		traces = append(traces, models.SQLTrace{Parameters: map[string]interface{}{
+			":tenant": tenant, ":user": user, ":ids": []interface{}{1, 2},
+		}})
+	}
+
+	analyzer := NewParameterAnalyzer()
+	analyzer.MaxCardinality = 200
+	analyzer.JointTopK = 15
+	sketch := analyzer.NewJointSketch()
+	for _, trace := range traces {
+		sketch.Add(trace)
+	}
+	assert.LessOrEqual(t, len(sketch.values), 2*analyzer.MaxCardinality, "the held combinations are bounded")
+
+	model := analyzer.JointModel(sketch)
+	require.NotNil(t, model)
+	assert.Equal(t, []string{":tenant", ":user"}, model.Params, "lists take no part")
+	assert.Equal(t, len(traces), model.Total)
+	require.Len(t, model.Tuples, 15)
+	for _, tuple := range model.Tuples {
+		tenant, user := tuple[0].(int), tuple[1].(int)
+		assert.Equal(t, tenant, user/10, "the frequent combinations are the tenants' own users")
+	}
+	assert.Equal(t, model, analyzer.AnalyzeJoint(traces))
+
+	// Shards merge into the model of the whole trace.
+	left, right := analyzer.NewJointSketch(), analyzer.NewJointSketch()
+	for i, trace := range traces {
+		if i%2 == 0 {
+			left.Add(trace)
+		} else {
+			right.Add(trace)
+		}
+	}
+	left.Merge(right)
+	merged := analyzer.JointModel(left)
+	assert.Equal(t, model.Total, merged.Total)
+	assert.ElementsMatch(t, model.Tuples, merged.Tuples)
+}
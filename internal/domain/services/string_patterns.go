@@ -0,0 +1,187 @@
+package services
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+)
+
+// Limits of the patterns learned for a string parameter.
+const (
+	minPatternDistinct = 20 // distinct values needed to learn patterns
+	maxStringPatterns  = 16 // patterns kept, by weight
+	maxSegmentChoices  = 8  // distinct texts of a segment kept as choices
+)
+
+// stringRun is a maximal run of characters of one class in a string value.
+type stringRun struct {
+	class string
+	text  string
+}
+
+// charClass returns the class of a character: digits, upper case and other letters
+// form classes, and any other character is a class of its own.
+func charClass(r rune) string {
+	switch {
+	case r >= '0' && r <= '9':
+		return "9"
+	case unicode.IsUpper(r):
+		return "A"
+	case unicode.IsLetter(r):
+		return "a"
+	}
+	return string(r)
+}
+
+func splitRuns(s string) []stringRun {
+	var runs []stringRun
+	start := 0
+	class := ""
+	for i, r := range s {
+		c := charClass(r)
+		if i > 0 && c != class {
+			runs = append(runs, stringRun{class: class, text: s[start:i]})
+			start = i
+		}
+		class = c
+	}
+	if start < len(s) {
+		runs = append(runs, stringRun{class: class, text: s[start:]})
+	}
+	return runs
+}
+
+// learnStringPatterns learns the shapes of the values of a string parameter: values
+// with the same sequence of character classes share a pattern, whose segments keep
+// their distinct texts when there are few, like a prefix, or else their alphabet and
+// lengths. It returns nil for parameters that are not strings, have fewer than
+// minPatternDistinct values, or whose values all repeat.
+func learnStringPatterns(stats *ParameterStats) *models.StringModel {
+	if stats.Type == ParamTypeDatetime || max(len(stats.ValueCounts), stats.Distinct) < minPatternDistinct || stats.TotalCount == 0 {
+		return nil
+	}
+
+	type segmentStats struct {
+		texts   map[string]int
+		many    bool // more than maxSegmentChoices distinct texts
+		lengths map[int]int
+		chars   map[rune]bool
+	}
+	type shape struct {
+		key      string
+		weight   int
+		segments []*segmentStats
+	}
+	shapes := make(map[string]*shape)
+	counted, singletons := 0, 0
+	for v, c := range stats.ValueCounts {
+		s, ok := v.(string)
+		if !ok {
+			return nil
+		}
+		counted += c
+		if c == 1 {
+			singletons++
+		}
+
+		runs := splitRuns(s)
+		classes := make([]string, len(runs))
+		for i, r := range runs {
+			classes[i] = r.class
+		}
+		key := strings.Join(classes, "\x00")
+		sh, ok := shapes[key]
+		if !ok {
+			sh = &shape{key: key, segments: make([]*segmentStats, len(runs))}
+			for i := range sh.segments {
+				sh.segments[i] = &segmentStats{texts: make(map[string]int), lengths: make(map[int]int), chars: make(map[rune]bool)}
+			}
+			shapes[key] = sh
+		}
+		sh.weight += c
+		for i, r := range runs {
+			seg := sh.segments[i]
+			if _, seen := seg.texts[r.text]; seen || len(seg.texts) < maxSegmentChoices {
+				seg.texts[r.text] += c
+			} else {
+				seg.many = true
+			}
+			seg.lengths[utf8.RuneCountInString(r.text)] += c
+			for _, ch := range r.text {
+				seg.chars[ch] = true
+			}
+		}
+	}
+
+	// Values beyond the tracked cardinality, or the heavy hitters of a sketch, are
+	// counted as seen once.
+	fresh := float64(singletons+stats.TotalCount-counted) / float64(stats.TotalCount)
+	if fresh <= 0 {
+		return nil
+	}
+
+	ranked := make([]*shape, 0, len(shapes))
+	for _, sh := range shapes {
+		ranked = append(ranked, sh)
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].weight != ranked[j].weight {
+			return ranked[i].weight > ranked[j].weight
+		}
+		return ranked[i].key < ranked[j].key
+	})
+	if len(ranked) > maxStringPatterns {
+		ranked = ranked[:maxStringPatterns]
+	}
+
+	model := &models.StringModel{FreshRatio: fresh}
+	for _, sh := range ranked {
+		pattern := models.StringPattern{Weight: sh.weight}
+		for _, seg := range sh.segments {
+			var out models.StringSegment
+			if !seg.many && (len(seg.texts) == 1 || 4*len(seg.texts) <= sh.weight) {
+				for _, text := range sortedKeys(seg.texts) {
+					out.Choices = append(out.Choices, text)
+					out.ChoiceFreqs = append(out.ChoiceFreqs, seg.texts[text])
+				}
+			} else {
+				chars := make([]rune, 0, len(seg.chars))
+				for ch := range seg.chars {
+					chars = append(chars, ch)
+				}
+				sort.Slice(chars, func(i, j int) bool { return chars[i] < chars[j] })
+				out.Alphabet = string(chars)
+				lengths := make([]int, 0, len(seg.lengths))
+				for n := range seg.lengths {
+					lengths = append(lengths, n)
+				}
+				sort.Ints(lengths)
+				for _, n := range lengths {
+					out.Lengths = append(out.Lengths, n)
+					out.LengthFreqs = append(out.LengthFreqs, seg.lengths[n])
+				}
+			}
+			pattern.Segments = append(pattern.Segments, out)
+		}
+		model.Patterns = append(model.Patterns, pattern)
+	}
+	return model
+}
+
+// sortedKeys returns the keys of a count map by descending count, then in order.
+func sortedKeys(counts map[string]int) []string {
+	keys := make([]string, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+	return keys
+}
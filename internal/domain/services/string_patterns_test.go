@@ -0,0 +1,98 @@
+package services
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+)
+
+func stringStats(values []string) *ParameterStats {
+	stats := &ParameterStats{ParamName: ":p1", Type: ParamTypeString, ValueCounts: make(map[interface{}]int)}
+	for _, v := range values {
+		stats.ValueCounts[v]++
+		stats.TotalCount++
+	}
+	return stats
+}
+
+func TestLearnStringPatterns(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+
+	t.Run("OrderNumbers", func(t *testing.T) {
+		var values []string
+		for i := 0; i < 500; i++ {
+			values = append(values, fmt.Sprintf("ORD-%08d", r.Intn(100000000)))
+		}
+		m := learnStringPatterns(stringStats(values))
+		require.NotNil(t, m)
+		require.Len(t, m.Patterns, 1)
+		assert.Equal(t, "ORD-[0-9]{8}", m.Patterns[0].String())
+		assert.InDelta(t, 1.0, m.FreshRatio, 0.01)
+	})
+
+	t.Run("Prefixes", func(t *testing.T) {
+		var values []string
+		for i := 0; i < 500; i++ {
+			prefix := []string{"SKU", "SKU", "SKU", "KIT"}[r.Intn(4)]
+			values = append(values, fmt.Sprintf("%s_%d-%s", prefix, 1+r.Intn(9999), []string{"red", "red", "blue"}[r.Intn(3)]))
+		}
+		m := learnStringPatterns(stringStats(values))
+		require.NotNil(t, m)
+		require.Len(t, m.Patterns, 1)
+		assert.Equal(t, "(SKU|KIT)_[0-9]{1,4}-(red|blue)", m.Patterns[0].String())
+	})
+
+	t.Run("Skipped", func(t *testing.T) {
+		// Few distinct values are replayed as observed.
+		assert.Nil(t, learnStringPatterns(stringStats([]string{"a", "b", "a"})))
+		// So are values that all repeat.
+		var values []string
+		for i := 0; i < 100; i++ {
+			values = append(values, fmt.Sprintf("key-%d", i%25))
+		}
+		assert.Nil(t, learnStringPatterns(stringStats(values)))
+		// Numbers are not strings.
+		stats := continuousStats(ParamTypeInt, 100, func(r *rand.Rand) interface{} { return r.Intn(1000) })
+		assert.Nil(t, learnStringPatterns(stats))
+	})
+}
+
+func TestSynthesizer_StringPatterns(t *testing.T) {
+	// Half of the lookups hit 20 hot orders, the others are one-off orders.
+	r := rand.New(rand.NewSource(11))
+	var values []string
+	for i := 0; i < 4000; i++ {
+		if r.Intn(2) == 0 {
+			values = append(values, fmt.Sprintf("ORD-%08d", r.Intn(20)))
+		} else {
+			values = append(values, fmt.Sprintf("ORD-%08d", 1000+r.Intn(100000000)))
+		}
+	}
+	stats := stringStats(values)
+	model := NewHotspotDetector().DetectDistribution(stats)
+	require.NotNil(t, model.Strings)
+
+	wlParams := models.NewWorkloadParameterModel()
+	wlParams.TemplateParameters["g1"] = map[string]*models.ParameterModel{":p1": model}
+	synth := NewSynthesizer(wlParams)
+	tmpl := &models.SQLTemplate{GroupKey: "g1", Parameters: []string{":p1"}}
+
+	re := regexp.MustCompile(`^ORD-[0-9]{8}$`)
+	generated := make(map[interface{}]int)
+	for range values {
+		args, err := synth.FillParameters(tmpl)
+		require.NoError(t, err)
+		assert.Regexp(t, re, args[0])
+		generated[args[0]]++
+	}
+
+	// The synthesized share keeps the cardinality close to the observed one.
+	observed := float64(len(stats.ValueCounts))
+	t.Logf("observed %v distinct values, generated %d", observed, len(generated))
+	assert.InDelta(t, 1.0, float64(len(generated))/observed, 0.15)
+}
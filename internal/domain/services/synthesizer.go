@@ -2,6 +2,9 @@ package services
 
 import (
 	"fmt"
+	"math/rand"
+	"sort"
+	"time"
 
 	"github.com/turtacn/SQLTraceBench/internal/domain/models"
 )
@@ -16,6 +19,18 @@ type ModelSampler interface {
 type Synthesizer struct {
 	// samplers maps GroupKey -> ParamName -> ModelSampler
 	samplers map[string]map[string]ModelSampler
+	// joints maps GroupKey -> sampler of the template's parameters taken together
+	joints map[string]*JointSampler
+	// ranges maps GroupKey -> samplers of the template's range predicates
+	ranges map[string][]rangeSampler
+	// reference is the time relative datetime parameters are re-anchored to.
+	reference time.Time
+}
+
+// rangeSampler derives the upper bound of a range from its lower bound and a width.
+type rangeSampler struct {
+	model *models.RangeParameterModel
+	width ModelSampler
 }
 
 // BoundZipfSampler adapts the generic ZipfSampler to the ModelSampler interface
@@ -39,6 +54,60 @@ func (s *BoundWeightedSampler) Sample() (interface{}, error) {
 	return s.sampler.Sample(s.model)
 }
 
+// BoundContinuousSampler adapts the ContinuousSampler to the ModelSampler interface.
+type BoundContinuousSampler struct {
+	sampler *ContinuousSampler
+	model   *models.ParameterModel
+}
+
+func (s *BoundContinuousSampler) Sample() (interface{}, error) {
+	return s.sampler.Sample(s.model)
+}
+
+// BoundStringSampler mixes the values replayed by a sampler with values synthesized
+// from the patterns of a string parameter.
+type BoundStringSampler struct {
+	sampler  ModelSampler
+	patterns *StringPatternSampler
+	model    *models.ParameterModel
+}
+
+func (s *BoundStringSampler) Sample() (interface{}, error) {
+	if v, ok := s.Fresh(); ok {
+		return v, nil
+	}
+	return s.sampler.Sample()
+}
+
+// Fresh returns a synthesized value with the probability of the model's FreshRatio.
+func (s *BoundStringSampler) Fresh() (interface{}, bool) {
+	if !s.patterns.Fresh(s.model.Strings) {
+		return nil, false
+	}
+	v, err := s.patterns.Synthesize(s.model.Strings)
+	return v, err == nil
+}
+
+// BoundRelativeTimeSampler re-anchors the offsets drawn for a relative datetime
+// parameter to the reference time of its Synthesizer.
+type BoundRelativeTimeSampler struct {
+	sampler   ModelSampler
+	model     *models.ParameterModel
+	reference *time.Time
+}
+
+func (s *BoundRelativeTimeSampler) Sample() (interface{}, error) {
+	v, err := s.sampler.Sample()
+	if err != nil {
+		return nil, err
+	}
+	offset, ok := toFloat64(v)
+	if !ok {
+		return v, nil
+	}
+	return ReanchorTime(offset, *s.reference, s.model.TimeLayout), nil
+}
+
 // BoundUniformSampler adapts a uniform sampling strategy.
 type BoundUniformSampler struct {
 	sampler *WeightedRandomSampler
@@ -49,35 +118,100 @@ func (s *BoundUniformSampler) Sample() (interface{}, error) {
 	return s.sampler.Sample(s.model)
 }
 
+// BoundKeySampler draws a parameter from the values of its column in the target
+// database, see KeyPool.
+type BoundKeySampler struct {
+	pool *KeyPool
+	rand *rand.Rand
+}
+
+func (s *BoundKeySampler) Sample() (interface{}, error) {
+	return s.pool.Sample(s.rand), nil
+}
+
 // NewSynthesizer creates a new Synthesizer initialized with the provided workload parameter models.
 func NewSynthesizer(workloadModel *models.WorkloadParameterModel) *Synthesizer {
 	s := &Synthesizer{
-		samplers: make(map[string]map[string]ModelSampler),
+		samplers:  make(map[string]map[string]ModelSampler),
+		joints:    make(map[string]*JointSampler),
+		ranges:    make(map[string][]rangeSampler),
+		reference: time.Now(),
 	}
 
 	zipfSvc := NewZipfSampler(1.001) // Default s, will be overridden by model.ZipfS
 	weightedSvc := NewWeightedRandomSampler()
+	continuousSvc := NewContinuousSampler()
+	patternSvc := NewStringPatternSampler()
+	bindSampler := func(model *models.ParameterModel) ModelSampler {
+		var sampler ModelSampler
+		switch {
+		case model.Continuous != nil:
+			sampler = &BoundContinuousSampler{sampler: continuousSvc, model: model}
+		case model.DistType == models.DistZipfian:
+			sampler = &BoundZipfSampler{sampler: zipfSvc, model: model}
+		case model.DistType == models.DistUniform:
+			sampler = &BoundUniformSampler{sampler: weightedSvc, model: model}
+		default: // Fallback to empirical/weighted
+			sampler = &BoundWeightedSampler{sampler: weightedSvc, model: model}
+		}
+		if model.Strings != nil {
+			sampler = &BoundStringSampler{sampler: sampler, patterns: patternSvc, model: model}
+		}
+		if model.Relative {
+			sampler = &BoundRelativeTimeSampler{sampler: sampler, model: model, reference: &s.reference}
+		}
+		return sampler
+	}
 
 	for groupKey, params := range workloadModel.TemplateParameters {
 		s.samplers[groupKey] = make(map[string]ModelSampler)
 		for paramName, model := range params {
-			var sampler ModelSampler
-
-			switch model.DistType {
-			case models.DistZipfian:
-				sampler = &BoundZipfSampler{sampler: zipfSvc, model: model}
-			case models.DistUniform:
-				sampler = &BoundUniformSampler{sampler: weightedSvc, model: model}
-			default: // Fallback to empirical/weighted
-				sampler = &BoundWeightedSampler{sampler: weightedSvc, model: model}
+			s.samplers[groupKey][paramName] = bindSampler(model)
+		}
+	}
+
+	for groupKey, ranges := range workloadModel.TemplateRanges {
+		for _, r := range ranges {
+			if r != nil && r.Width != nil && len(r.Width.TopValues) > 0 {
+				s.ranges[groupKey] = append(s.ranges[groupKey], rangeSampler{model: r, width: bindSampler(r.Width)})
 			}
-			s.samplers[groupKey][paramName] = sampler
+		}
+	}
+
+	jointRand := rand.New(rand.NewSource(time.Now().UnixNano()))
+	for groupKey, joint := range workloadModel.TemplateJoints {
+		if joint != nil && joint.Total > 0 {
+			s.joints[groupKey] = NewJointSampler(joint, jointRand)
 		}
 	}
 
 	return s
 }
 
+// SetReferenceTime sets the time that datetime parameters modeled relative to their
+// traces' timestamps are re-anchored to. It defaults to the creation time of the
+// Synthesizer.
+func (s *Synthesizer) SetReferenceTime(t time.Time) {
+	s.reference = t
+}
+
+// UseKeyPools makes the parameters with a key pool, by GroupKey and parameter name,
+// draw their values from it rather than from their model; see KeySampler.Pools.
+func (s *Synthesizer) UseKeyPools(pools map[string]map[string]*KeyPool) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	for groupKey, params := range pools {
+		for paramName, pool := range params {
+			if len(pool.Values) == 0 {
+				continue
+			}
+			if s.samplers[groupKey] == nil {
+				s.samplers[groupKey] = make(map[string]ModelSampler)
+			}
+			s.samplers[groupKey][paramName] = &BoundKeySampler{pool: pool, rand: r}
+		}
+	}
+}
+
 // FillParameters generates values for the template's parameters and returns the list of arguments.
 func (s *Synthesizer) FillParameters(tmpl *models.SQLTemplate) ([]interface{}, error) {
 	groupSamplers, ok := s.samplers[tmpl.GroupKey]
@@ -87,12 +221,37 @@ func (s *Synthesizer) FillParameters(tmpl *models.SQLTemplate) ([]interface{}, e
 			fmt.Errorf("no parameter model found for template group key: %s", tmpl.GroupKey)
 	}
 
+	// Parameters modeled jointly are sampled together; the others, and all of them
+	// when the joint sample falls outside the modeled combinations, independently.
+	var joint map[string]interface{}
+	if js, ok := s.joints[tmpl.GroupKey]; ok {
+		joint = js.Sample()
+	}
+
 	args := make([]interface{}, len(tmpl.Parameters))
 	for i, paramName := range tmpl.Parameters {
 		var val interface{} = "DEFAULT" // Fallback
 		var err error
 
-		if sampler, exists := groupSamplers[paramName]; exists {
+		sampler, hasSampler := groupSamplers[paramName]
+		// Continuous and relative datetime parameters draw fresh values rather than
+		// replaying the observed ones of the joint model.
+		_, continuous := sampler.(*BoundContinuousSampler)
+		_, relative := sampler.(*BoundRelativeTimeSampler)
+		if v, exists := joint[paramName]; exists && !continuous && !relative {
+			val = v
+			// Keys that were never observed do not co-occur with anything, so the
+			// synthesized share of a string parameter is kept.
+			if ss, ok := sampler.(*BoundStringSampler); ok {
+				if fresh, ok := ss.Fresh(); ok {
+					val = fresh
+				}
+			}
+			// A combination is only replayed with keys that exist in the database.
+			if ks, ok := sampler.(*BoundKeySampler); ok && !ks.pool.Contains(v) {
+				val, _ = ks.Sample()
+			}
+		} else if hasSampler {
 			val, err = sampler.Sample()
 			if err != nil {
 				// On sampling error, you might want to use a fallback or log the error
@@ -102,5 +261,104 @@ func (s *Synthesizer) FillParameters(tmpl *models.SQLTemplate) ([]interface{}, e
 		args[i] = val
 	}
 
+	// The upper bound of a range is the sampled lower bound plus a sampled width.
+	for _, r := range s.ranges[tmpl.GroupKey] {
+		lo, hi := -1, -1
+		for i, paramName := range tmpl.Parameters {
+			switch paramName {
+			case r.model.Lower:
+				lo = i
+			case r.model.Upper:
+				hi = i
+			}
+		}
+		if lo < 0 || hi < 0 {
+			continue
+		}
+		width, err := r.width.Sample()
+		if err != nil {
+			continue
+		}
+		if upper, ok := AddRangeWidth(args[lo], r.model.Kind, width); ok {
+			args[hi] = upper
+		}
+	}
+
 	return args, nil
 }
+
+// JointSampler samples the parameters of a template together from a JointParameterModel.
+type JointSampler struct {
+	rand  *rand.Rand
+	model *models.JointParameterModel
+	// tupleCum and condCum are the cumulative frequencies of the tuples and conditionals.
+	tupleCum []int
+	condCum  []int
+}
+
+// NewJointSampler creates a sampler for a joint model.
+func NewJointSampler(model *models.JointParameterModel, r *rand.Rand) *JointSampler {
+	s := &JointSampler{rand: r, model: model}
+	total := 0
+	for _, f := range model.TupleFrequencies {
+		total += f
+		s.tupleCum = append(s.tupleCum, total)
+	}
+	total = 0
+	for _, c := range model.Conditionals {
+		total += c.Count
+		s.condCum = append(s.condCum, total)
+	}
+	return s
+}
+
+// Sample draws the values of the jointly modeled parameters, keyed by name. It returns
+// nil for the share of observations the model leaves to the per-parameter models.
+func (s *JointSampler) Sample() map[string]interface{} {
+	m := s.model
+	r := s.rand.Intn(m.Total)
+	if n := len(s.tupleCum); n > 0 {
+		if r < s.tupleCum[n-1] {
+			tuple := m.Tuples[sort.SearchInts(s.tupleCum, r+1)]
+			values := make(map[string]interface{}, len(m.Params))
+			for i, name := range m.Params {
+				values[name] = tuple[i]
+			}
+			return values
+		}
+		r -= s.tupleCum[n-1]
+	}
+	n := len(s.condCum)
+	if n == 0 || r >= s.condCum[n-1] {
+		return nil
+	}
+	cond := m.Conditionals[sort.SearchInts(s.condCum, r+1)]
+	values := make(map[string]interface{}, len(m.Params))
+	for i, name := range m.Params {
+		if i == m.Anchor {
+			values[name] = cond.Given
+		} else if v, ok := s.pick(cond.Values[i], cond.Frequencies[i]); ok {
+			values[name] = v
+		}
+	}
+	return values
+}
+
+// pick draws a value with probability proportional to its frequency.
+func (s *JointSampler) pick(values []interface{}, freqs []int) (interface{}, bool) {
+	total := 0
+	for _, f := range freqs {
+		total += f
+	}
+	if total <= 0 || len(values) != len(freqs) {
+		return nil, false
+	}
+	r := s.rand.Intn(total)
+	for i, f := range freqs {
+		if r < f {
+			return values[i], true
+		}
+		r -= f
+	}
+	return values[len(values)-1], true
+}
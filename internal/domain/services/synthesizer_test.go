@@ -3,6 +3,7 @@ package services_test
 import (
 	"math"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/turtacn/SQLTraceBench/internal/domain/models"
@@ -123,3 +124,94 @@ func TestGenerationFidelity(t *testing.T) {
 	// Allow some variance
 	assert.InDelta(t, expectedRatio, ratio, 1.0, "Ratio between Rank 1 and 2 should be close to theoretical Zipf")
 }
+
+func TestSynthesizer_JointParameters(t *testing.T) {
+	marginal := func(name string, values ...interface{}) *models.ParameterModel {
+		return &models.ParameterModel{ParamName: name, DistType: models.DistUniform, TopValues: values, TopFrequencies: make([]int, len(values))}
+	}
+	wlParams := models.NewWorkloadParameterModel()
+	wlParams.TemplateParameters["g1"] = map[string]*models.ParameterModel{
+		":tenant": marginal(":tenant", 1, 2),
+		":user":   marginal(":user", 10, 11, 20),
+		":limit":  marginal(":limit", 50),
+	}
+	wlParams.TemplateJoints["g1"] = &models.JointParameterModel{
+		Params:           []string{":tenant", ":user"},
+		Tuples:           [][]interface{}{{1, 10}, {1, 11}},
+		TupleFrequencies: []int{6, 2},
+		Anchor:           1,
+		Conditionals: []models.ConditionalDistribution{{
+			Given: 20, Count: 2,
+			Values: [][]interface{}{{2}, nil}, Frequencies: [][]int{{2}, nil},
+		}},
+		Total: 12,
+	}
+	synth := services.NewSynthesizer(wlParams)
+	tmpl := &models.SQLTemplate{GroupKey: "g1", Parameters: []string{":tenant", ":user", ":limit"}}
+
+	observed := map[[2]int]bool{{1, 10}: true, {1, 11}: true, {2, 20}: true}
+	pairs := make(map[[2]int]int)
+	n := 5000
+	for i := 0; i < n; i++ {
+		args, err := synth.FillParameters(tmpl)
+		assert.NoError(t, err)
+		assert.Equal(t, 50, args[2], "parameters outside the joint model are sampled on their own")
+		pairs[[2]int{args[0].(int), args[1].(int)}]++
+	}
+
+	// Only the 2 of 12 observations the joint model leaves out pair values independently.
+	unobserved := 0
+	for pair, count := range pairs {
+		if !observed[pair] {
+			unobserved += count
+		}
+	}
+	t.Logf("Pairs: %v", pairs)
+	assert.Greater(t, unobserved, 0)
+	assert.Less(t, float64(unobserved)/float64(n), 0.15)
+	assert.InDelta(t, 0.5, float64(pairs[[2]int{1, 10}])/float64(n), 0.05)
+	assert.Greater(t, pairs[[2]int{2, 20}], pairs[[2]int{1, 20}], "the conditional pairs user 20 with tenant 2")
+}
+
+func TestSynthesizer_RangeParameters(t *testing.T) {
+	wlParams := models.NewWorkloadParameterModel()
+	wlParams.TemplateParameters["g1"] = map[string]*models.ParameterModel{
+		":lo": {ParamName: ":lo", DistType: models.DistUniform, TopValues: []interface{}{100, 200, 300}},
+		":hi": {ParamName: ":hi", DistType: models.DistUniform, TopValues: []interface{}{110, 150, 250}},
+	}
+	wlParams.TemplateRanges["g1"] = []*models.RangeParameterModel{{
+		Column: "price", Lower: ":lo", Upper: ":hi", Kind: models.RangeInt,
+		Width: &models.ParameterModel{DistType: models.DistEmpirical, TopValues: []interface{}{int64(10), int64(50)}, TopFrequencies: []int{3, 1}},
+	}}
+	synth := services.NewSynthesizer(wlParams)
+	tmpl := &models.SQLTemplate{GroupKey: "g1", Parameters: []string{":lo", ":hi"}}
+
+	widths := make(map[int]int)
+	for i := 0; i < 2000; i++ {
+		args, err := synth.FillParameters(tmpl)
+		assert.NoError(t, err)
+		lo, hi := args[0].(int), args[1].(int)
+		assert.GreaterOrEqual(t, hi, lo, "ranges are never inverted")
+		widths[hi-lo]++
+	}
+	assert.Len(t, widths, 2)
+	assert.InDelta(t, 0.75, float64(widths[10])/2000, 0.05)
+}
+
+func TestSynthesizer_RelativeTimes(t *testing.T) {
+	wlParams := models.NewWorkloadParameterModel()
+	wlParams.TemplateParameters["g1"] = map[string]*models.ParameterModel{
+		":since": {ParamName: ":since", DistType: models.DistUniform, Relative: true, TimeLayout: "2006-01-02 15:04:05",
+			TopValues: []interface{}{-86400.0}},
+		":day": {ParamName: ":day", DistType: models.DistUniform, Relative: true, TimeLayout: "2006-01-02",
+			TopValues: []interface{}{-2 * 86400.0}},
+		":at": {ParamName: ":at", DistType: models.DistUniform, Relative: true, TopValues: []interface{}{-3600.0}},
+	}
+	synth := services.NewSynthesizer(wlParams)
+	synth.SetReferenceTime(time.Date(2025, 6, 20, 8, 30, 15, 500, time.UTC))
+	tmpl := &models.SQLTemplate{GroupKey: "g1", Parameters: []string{":since", ":day", ":at"}}
+
+	args, err := synth.FillParameters(tmpl)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"2025-06-19 08:30:15", "2025-06-18", time.Date(2025, 6, 20, 7, 30, 15, 0, time.UTC)}, args)
+}
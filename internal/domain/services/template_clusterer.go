@@ -0,0 +1,222 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+)
+
+// DefaultClusterThreshold is the similarity above which templates are clustered
+// when ClusterConfig.Threshold is not set.
+const DefaultClusterThreshold = 0.8
+
+// ClusterConfig configures the clustering of near-duplicate templates.
+type ClusterConfig struct {
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// Threshold is the minimum similarity, in (0, 1], of a template to the
+	// representative of its cluster. Zero uses DefaultClusterThreshold.
+	Threshold float64 `yaml:"threshold" json:"threshold,omitempty"`
+}
+
+// TemplateCluster is a group of templates represented by its heaviest member.
+type TemplateCluster struct {
+	// Representative is the group key of the template kept for the cluster.
+	Representative string `json:"representative"`
+	// Weight is the combined weight of the members.
+	Weight  int             `json:"weight"`
+	Members []ClusterMember `json:"members"`
+}
+
+// ClusterMember is a template merged into a cluster.
+type ClusterMember struct {
+	GroupKey string `json:"group_key"`
+	Weight   int    `json:"weight"`
+	// Similarity is the similarity of the template to the representative.
+	Similarity float64 `json:"similarity"`
+}
+
+// ClusterReport describes the result of clustering a set of templates.
+type ClusterReport struct {
+	Threshold float64 `json:"threshold"`
+	Templates int     `json:"templates"`
+	// Clusters lists the clusters of more than one template, heaviest first.
+	Clusters []TemplateCluster `json:"clusters"`
+}
+
+// TemplateClusterer groups templates whose query shapes differ only slightly, such as
+// the variants an ORM produces with reordered columns or optional predicates.
+//
+// Templates are compared by the elements of their clauses: the select list items,
+// the tables, each predicate of a WHERE clause and so on, with literals and
+// placeholders blanked out. The similarity of two templates is the Jaccard index of
+// their elements, so reordering a list does not change it and each optional predicate
+// lowers it a little. Only templates of the same statement type are clustered.
+type TemplateClusterer struct {
+	threshold float64
+}
+
+// NewTemplateClusterer creates a clusterer from a configuration.
+func NewTemplateClusterer(cfg ClusterConfig) (*TemplateClusterer, error) {
+	threshold := cfg.Threshold
+	if threshold == 0 {
+		threshold = DefaultClusterThreshold
+	}
+	if threshold < 0 || threshold > 1 {
+		return nil, fmt.Errorf("cluster threshold must be in (0, 1], got %v", cfg.Threshold)
+	}
+	return &TemplateClusterer{threshold: threshold}, nil
+}
+
+// Cluster merges near-duplicate templates. Templates are visited by descending weight,
+// and each one joins the cluster of the most similar representative above the
+// threshold, or else starts a new cluster. The representatives are returned with the merged
+// weights (see models.SQLTemplate.Merge), sorted by weight in descending order.
+func (c *TemplateClusterer) Cluster(tpls []models.SQLTemplate) ([]models.SQLTemplate, ClusterReport) {
+	order := make([]int, len(tpls))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool { return tpls[order[a]].Weight > tpls[order[b]].Weight })
+
+	type cluster struct {
+		template models.SQLTemplate
+		features map[string]bool
+		members  []ClusterMember
+	}
+	var clusters []*cluster
+	// index maps a statement type and feature to the clusters whose representative has it.
+	index := make(map[string][]int)
+
+	for _, i := range order {
+		tpl := tpls[i]
+		qt, _ := ClassifyQuery(tpl.RawSQL)
+		kind := qt.String()
+		features := clauseFeatures(tpl.RawSQL)
+
+		// Count the features shared with each candidate representative.
+		shared := make(map[int]int)
+		for f := range features {
+			for _, ci := range index[kind+"\x00"+f] {
+				shared[ci]++
+			}
+		}
+		best, bestSim := -1, 0.0
+		for ci, n := range shared {
+			sim := float64(n) / float64(len(features)+len(clusters[ci].features)-n)
+			if sim >= c.threshold && (sim > bestSim || sim == bestSim && ci < best) {
+				best, bestSim = ci, sim
+			}
+		}
+
+		if best >= 0 {
+			cl := clusters[best]
+			cl.template.Merge(tpl)
+			cl.members = append(cl.members, ClusterMember{GroupKey: tpl.GroupKey, Weight: tpl.Weight, Similarity: bestSim})
+			continue
+		}
+		clusters = append(clusters, &cluster{
+			template: tpl,
+			features: features,
+			members:  []ClusterMember{{GroupKey: tpl.GroupKey, Weight: tpl.Weight, Similarity: 1}},
+		})
+		for f := range features {
+			key := kind + "\x00" + f
+			index[key] = append(index[key], len(clusters)-1)
+		}
+	}
+
+	out := make([]models.SQLTemplate, 0, len(clusters))
+	report := ClusterReport{Threshold: c.threshold, Templates: len(tpls)}
+	for _, cl := range clusters {
+		out = append(out, cl.template)
+		if len(cl.members) > 1 {
+			report.Clusters = append(report.Clusters, TemplateCluster{
+				Representative: cl.template.GroupKey,
+				Weight:         cl.template.Weight,
+				Members:        cl.members,
+			})
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Weight > out[j].Weight })
+	sort.SliceStable(report.Clusters, func(i, j int) bool { return report.Clusters[i].Weight > report.Clusters[j].Weight })
+	return out, report
+}
+
+// clauseWords start a clause of a statement.
+var clauseWords = map[string]bool{
+	"select": true, "from": true, "where": true, "group": true, "having": true, "order": true,
+	"limit": true, "offset": true, "insert": true, "into": true, "values": true, "update": true,
+	"set": true, "delete": true, "join": true, "on": true, "using": true, "returning": true,
+	"union": true, "intersect": true, "except": true, "with": true, "window": true, "for": true,
+}
+
+// joinModifiers precede JOIN; they are dropped so that "LEFT JOIN t" and "JOIN t"
+// share the joined table.
+var joinModifiers = map[string]bool{"left": true, "right": true, "inner": true, "outer": true, "cross": true, "full": true, "natural": true}
+
+// clauseFeatures returns the elements of the clauses of a query, each prefixed with its
+// clause. Elements are split on commas and AND/OR at the clause's nesting level, and a
+// repeated element is numbered so that the features form a multiset.
+func clauseFeatures(query string) map[string]bool {
+	features := make(map[string]bool)
+	clause := ""
+	var elem []string
+	depth := 0
+	flush := func() {
+		if len(elem) == 0 {
+			return
+		}
+		key := clause + ":" + strings.Join(elem, " ")
+		for n := 2; features[key]; n++ {
+			key = fmt.Sprintf("%s:%s#%d", clause, strings.Join(elem, " "), n)
+		}
+		features[key] = true
+		elem = elem[:0]
+	}
+
+	tokens := lexFingerprint(query)
+	for i, tok := range tokens {
+		text := tok.text
+		switch tok.kind {
+		case fpString, fpNumber, fpParam, fpList:
+			text = "?"
+		case fpPunct:
+			switch text {
+			case "(":
+				depth++
+			case ")":
+				depth--
+			case ",":
+				if depth == 0 {
+					flush()
+					continue
+				}
+			}
+		case fpWord:
+			if depth > 0 {
+				break
+			}
+			if clauseWords[text] {
+				flush()
+				clause = text
+				continue
+			}
+			if text == "by" && i > 0 && (tokens[i-1].text == "group" || tokens[i-1].text == "order") {
+				continue
+			}
+			if joinModifiers[text] {
+				flush()
+				continue
+			}
+			if text == "and" || text == "or" {
+				flush()
+				continue
+			}
+		}
+		elem = append(elem, text)
+	}
+	flush()
+	return features
+}
@@ -0,0 +1,55 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+)
+
+func TestTemplateClusterer_Cluster(t *testing.T) {
+	tc := models.TraceCollection{}
+	add := func(query string, n int, user string) {
+		for i := 0; i < n; i++ {
+			tc.Add(models.SQLTrace{Query: query, User: user})
+		}
+	}
+	add("SELECT id, name, email, created_at FROM users WHERE id = 1 AND deleted_at IS NULL LIMIT 10", 5, "app")
+	add("SELECT name, id, email, created_at FROM users WHERE deleted_at IS NULL AND id = 2 LIMIT 20", 3, "app")
+	add("SELECT id, name, email, created_at FROM users WHERE id = 3 AND deleted_at IS NULL AND tenant_id = 7 LIMIT 10", 2, "admin")
+	add("DELETE FROM users WHERE id = 1 AND deleted_at IS NULL", 1, "app")
+	add("SELECT * FROM orders WHERE user_id = 1", 4, "app")
+	templates := NewTemplateService().ExtractTemplates(tc)
+	require.Len(t, templates, 5)
+
+	clusterer, err := NewTemplateClusterer(ClusterConfig{Enabled: true})
+	require.NoError(t, err)
+	clustered, report := clusterer.Cluster(templates)
+
+	require.Len(t, clustered, 3)
+	users := clustered[0]
+	assert.Equal(t, "select id, name, email, created_at from users where id = :p1 and deleted_at is null limit :p2", users.RawSQL)
+	assert.Equal(t, 10, users.Weight)
+	assert.Equal(t, map[string]int{"app": 8, "admin": 2}, users.Users)
+	assert.Equal(t, 4, clustered[1].Weight, "unrelated query")
+	assert.Equal(t, 1, clustered[2].Weight, "statement types are not mixed")
+
+	assert.Equal(t, 5, report.Templates)
+	require.Len(t, report.Clusters, 1)
+	cluster := report.Clusters[0]
+	assert.Equal(t, users.GroupKey, cluster.Representative)
+	assert.Equal(t, 10, cluster.Weight)
+	require.Len(t, cluster.Members, 3)
+	assert.Equal(t, 1.0, cluster.Members[1].Similarity, "reordered columns and predicates")
+	assert.InDelta(t, 8.0/9, cluster.Members[2].Similarity, 1e-9, "one optional predicate")
+
+	// A strict threshold keeps only the reordered variant.
+	clusterer, err = NewTemplateClusterer(ClusterConfig{Enabled: true, Threshold: 0.9})
+	require.NoError(t, err)
+	clustered, _ = clusterer.Cluster(templates)
+	assert.Len(t, clustered, 4)
+
+	_, err = NewTemplateClusterer(ClusterConfig{Threshold: 1.5})
+	assert.Error(t, err)
+}
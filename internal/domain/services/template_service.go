@@ -3,46 +3,112 @@ package services
 
 import (
 	"sort"
-	"strings"
 
 	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+	"github.com/turtacn/SQLTraceBench/pkg/types"
 )
 
 // TemplateService is responsible for processing raw SQL traces and extracting SQL templates.
 // It normalizes SQL queries to group them into templates and calculates the frequency of each template.
-type TemplateService struct{}
+type TemplateService struct {
+	// dialect is the SQL dialect of the traces; DatabaseNone reads them as MySQL.
+	dialect types.DatabaseType
+	// parser lists the tables of the templates; without one they are found by
+	// ReferencedTables.
+	parser Parser
+}
 
 // NewTemplateService creates a new TemplateService.
 func NewTemplateService() *TemplateService {
 	return &TemplateService{}
 }
 
+// ForDialect returns a TemplateService that reads queries of the given SQL dialect.
+func (s *TemplateService) ForDialect(dialect types.DatabaseType) *TemplateService {
+	return &TemplateService{dialect: dialect, parser: s.parser}
+}
+
+// WithParser returns a TemplateService that lists the tables of templates using parser.
+func (s *TemplateService) WithParser(parser Parser) *TemplateService {
+	return &TemplateService{dialect: s.dialect, parser: parser}
+}
+
+// Describe classifies a template by statement type and access, and lists the tables
+// it references.
+func (s *TemplateService) Describe(tpl *models.SQLTemplate) {
+	tpl.QueryType, tpl.ReadOnly = ClassifyQuery(tpl.RawSQL)
+	if s.parser == nil {
+		tpl.Tables = ReferencedTables(tpl.RawSQL)
+		return
+	}
+	if tables, err := s.parser.ListTables(tpl.RawSQL); err == nil {
+		tpl.Tables = tables
+	}
+}
+
+// Fingerprint normalizes a query of the service's dialect (see FingerprintQuery).
+func (s *TemplateService) Fingerprint(query string, params map[string]interface{}) QueryFingerprint {
+	return fingerprintQuery(query, params, s.dialect)
+}
+
 // ExtractTemplates takes a collection of SQL traces and returns a slice of SQL templates.
-// It groups traces by a normalized version of their SQL query, calculates the weight (frequency) of each template,
-// and sorts the templates by weight in descending order.
+// It groups traces by the fingerprint of their SQL query (see FingerprintQuery), calculates
+// the weight (frequency) of each template, describes it (see Describe), and sorts the templates
+// by weight in descending order.
+// The literal values of each trace are stored in its Parameters, under the names of the
+// template's placeholders. A trace that already carried bound parameters is rewritten to
+// its template's query, since its placeholders may have been renumbered.
 func (s *TemplateService) ExtractTemplates(tc models.TraceCollection) []models.SQLTemplate {
-	agg := make(map[string]*models.SQLTemplate)
-
-	for _, tr := range tc.Traces {
-		// Normalize the query to use as a grouping key.
-		// This is a simple normalization; more sophisticated techniques could be used here.
-		key := strings.ToLower(strings.TrimSpace(tr.Query))
-
-		if _, ok := agg[key]; !ok {
-			template := &models.SQLTemplate{
-				RawSQL:   tr.Query,
-				GroupKey: key,
-				Weight:   0,
-			}
-			template.ExtractParameters()
-			agg[key] = template
+	set := s.NewTemplateSet()
+	for i := range tc.Traces {
+		set.Add(&tc.Traces[i])
+	}
+	return set.Templates()
+}
+
+// TemplateSet collects the templates of a stream of traces, one trace at a time; see
+// ExtractTemplates.
+type TemplateSet struct {
+	svc *TemplateService
+	agg map[string]*models.SQLTemplate
+}
+
+// NewTemplateSet returns an empty set of the templates of traces of the service's dialect.
+func (s *TemplateService) NewTemplateSet() *TemplateSet {
+	return &TemplateSet{svc: s, agg: make(map[string]*models.SQLTemplate)}
+}
+
+// Add counts a trace towards its template, storing its literal values in its Parameters
+// as ExtractTemplates does, and returns the template.
+func (ts *TemplateSet) Add(tr *models.SQLTrace) *models.SQLTemplate {
+	fp := ts.svc.Fingerprint(tr.Query, tr.Parameters)
+	if len(tr.Parameters) > 0 {
+		tr.Query = fp.Query
+	}
+	tr.Parameters = fp.Parameters
+	key := fp.Query
+
+	template, ok := ts.agg[key]
+	if !ok {
+		template = &models.SQLTemplate{
+			RawSQL:   fp.Query,
+			GroupKey: key,
+			Weight:   0,
 		}
-		agg[key].Weight++
+		template.ExtractParameters()
+		ts.svc.Describe(template)
+		ts.agg[key] = template
 	}
+	template.Weight++
+	template.Observe(*tr)
+	return template
+}
 
-	// Convert the map to a slice and sort it by weight.
-	out := make([]models.SQLTemplate, 0, len(agg))
-	for _, t := range agg {
+// Templates returns the templates of the traces added so far, sorted by weight in
+// descending order.
+func (ts *TemplateSet) Templates() []models.SQLTemplate {
+	out := make([]models.SQLTemplate, 0, len(ts.agg))
+	for _, t := range ts.agg {
 		out = append(out, *t)
 	}
 
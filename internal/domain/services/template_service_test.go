@@ -4,7 +4,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+	"github.com/turtacn/SQLTraceBench/pkg/types"
 )
 
 func TestTemplateService_ExtractTemplates(t *testing.T) {
@@ -33,4 +35,112 @@ func TestTemplateService_ExtractTemplates(t *testing.T) {
 	assert.Equal(t, 1, ordersTemplate.Weight, "orders template should have weight 1")
 	assert.Equal(t, "select * from orders", ordersTemplate.GroupKey, "group key should be normalized")
 	assert.Empty(t, ordersTemplate.Parameters, "should have no parameters")
-}
\ No newline at end of file
+}
+
+func TestTemplateService_ExtractTemplates_Cases(t *testing.T) {
+	testCases := []struct {
+		name   string
+		traces []models.SQLTrace
+		// parser, when set, lists the tables of the templates.
+		parser Parser
+		check  func(t *testing.T, templates []models.SQLTemplate, traces []models.SQLTrace)
+	}{
+		{
+			name: "session context",
+			traces: []models.SQLTrace{
+				{Query: "select 1", Database: "shop", User: "app"},
+				{Query: "select 1", Database: "shop", User: "report", ErrorCode: "1205"},
+				{Query: "select 1", Database: "billing"},
+			},
+			check: func(t *testing.T, templates []models.SQLTemplate, _ []models.SQLTrace) {
+				require.Len(t, templates, 1)
+				assert.Equal(t, map[string]int{"shop": 2, "billing": 1}, templates[0].Databases)
+				assert.Equal(t, map[string]int{"app": 1, "report": 1}, templates[0].Users)
+				assert.Equal(t, 1, templates[0].Errors)
+			},
+		},
+		{
+			name: "literals",
+			traces: []models.SQLTrace{
+				{Query: "SELECT * FROM users WHERE id IN (1, 2) AND state = 'active'"},
+				{Query: "select * from users where id in (3) and state = 'banned'"},
+			},
+			check: func(t *testing.T, templates []models.SQLTemplate, traces []models.SQLTrace) {
+				require.Len(t, templates, 1, "IN-lists of different lengths should share a template")
+				assert.Equal(t, 2, templates[0].Weight)
+				assert.Equal(t, "select * from users where id in (:p1) and state = :p2", templates[0].GroupKey)
+				assert.ElementsMatch(t, []string{":p1", ":p2"}, templates[0].Parameters)
+
+				// The traces keep their literal values as parameters.
+				assert.Equal(t, []interface{}{int64(1), int64(2)}, traces[0].Parameters[":p1"])
+				assert.Equal(t, "select * from users where id in (3) and state = 'banned'", traces[1].Query)
+				assert.Equal(t, map[string]interface{}{":p1": int64(3), ":p2": "banned"}, traces[1].Parameters)
+			},
+		},
+		{
+			name: "bound parameters",
+			traces: []models.SQLTrace{{
+				Query:      "UPDATE orders SET total = :p1, note = :p3 WHERE id = :p2",
+				Parameters: map[string]interface{}{":p1": 99.5, ":p2": int64(5), ":p3": nil},
+			}},
+			check: func(t *testing.T, templates []models.SQLTemplate, traces []models.SQLTrace) {
+				require.Len(t, templates, 1)
+				assert.Equal(t, "update orders set total = :p1, note = :p2 where id = :p3", templates[0].GroupKey)
+
+				// Renumbered placeholders move the trace onto the template query.
+				assert.Equal(t, templates[0].GroupKey, traces[0].Query)
+				assert.Equal(t, map[string]interface{}{":p1": 99.5, ":p2": nil, ":p3": int64(5)}, traces[0].Parameters)
+			},
+		},
+		{
+			name: "classified",
+			traces: []models.SQLTrace{
+				{Query: "INSERT INTO users (id, name) VALUES (7, 'ann')"},
+				{Query: "SELECT o.id FROM orders o JOIN `shop`.`users` u ON u.id = o.user_id"},
+			},
+			check: func(t *testing.T, templates []models.SQLTemplate, _ []models.SQLTrace) {
+				require.Len(t, templates, 2)
+				byType := make(map[types.QueryType]models.SQLTemplate)
+				for _, tpl := range templates {
+					byType[tpl.QueryType] = tpl
+				}
+				require.Contains(t, byType, types.QueryInsert)
+				assert.False(t, byType[types.QueryInsert].ReadOnly)
+				assert.Equal(t, []string{"users"}, byType[types.QueryInsert].Tables)
+				require.Contains(t, byType, types.QuerySelect)
+				assert.True(t, byType[types.QuerySelect].ReadOnly)
+				assert.Equal(t, []string{"orders", "shop.users"}, byType[types.QuerySelect].Tables)
+			},
+		},
+		{
+			name:   "tables from parser",
+			traces: []models.SQLTrace{{Query: "SELECT o.id FROM orders o JOIN `shop`.`users` u ON u.id = o.user_id"}},
+			parser: fakeTableParser{"t1"},
+			check: func(t *testing.T, templates []models.SQLTemplate, _ []models.SQLTrace) {
+				require.Len(t, templates, 1)
+				assert.Equal(t, []string{"t1"}, templates[0].Tables)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := NewTemplateService()
+			if tc.parser != nil {
+				service = service.WithParser(tc.parser)
+			}
+			collection := models.TraceCollection{}
+			for _, trace := range tc.traces {
+				collection.Add(trace)
+			}
+			templates := service.ExtractTemplates(collection)
+			tc.check(t, templates, collection.Traces)
+		})
+	}
+}
+
+type fakeTableParser []string
+
+func (p fakeTableParser) ListTables(sql string) ([]string, error) {
+	return p, nil
+}
@@ -6,18 +6,24 @@ import (
 	"github.com/turtacn/SQLTraceBench/internal/domain/models"
 )
 
-type TemporalPattern struct {
-	Window    time.Duration
-	BinCounts map[int]int // key=time bin index, value=query count
+// TemporalConfig configures the shaping of a generated workload in time.
+type TemporalConfig struct {
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// Window is the width of the bins the source traces are counted in; zero uses an
+	// hour.
+	Window time.Duration `yaml:"window" json:"window,omitempty"`
+	// Duration is the time the generated workload is spread over. Zero keeps the span
+	// of the source traces.
+	Duration time.Duration `yaml:"duration" json:"duration,omitempty"`
 }
 
 type TemporalPatternExtractor struct {
 	Window time.Duration
 }
 
-func (e *TemporalPatternExtractor) Extract(traces []models.SQLTrace) *TemporalPattern {
+func (e *TemporalPatternExtractor) Extract(traces []models.SQLTrace) *models.TemporalPattern {
 	if len(traces) == 0 {
-		return &TemporalPattern{
+		return &models.TemporalPattern{
 			Window:    e.Window,
 			BinCounts: make(map[int]int),
 		}
@@ -48,12 +54,63 @@ func (e *TemporalPatternExtractor) Extract(traces []models.SQLTrace) *TemporalPa
 		binCounts[binIndex]++
 	}
 
-	return &TemporalPattern{
+	return &models.TemporalPattern{
 		Window:    e.Window,
 		BinCounts: binCounts,
 	}
 }
 
+// TemporalSketch counts a stream of timestamps in bins of a window, in a single pass. The
+// bins are aligned to the first timestamp and renumbered from the earliest one, so a
+// stream in timestamp order is binned as Extract bins it.
+type TemporalSketch struct {
+	window time.Duration
+	origin time.Time
+	bins   map[int]int
+}
+
+// NewSketch returns an empty sketch of the extractor's window.
+func (e *TemporalPatternExtractor) NewSketch() *TemporalSketch {
+	window := e.Window
+	if window <= 0 {
+		window = time.Hour
+	}
+	return &TemporalSketch{window: window, bins: make(map[int]int)}
+}
+
+// Add counts a timestamp; the zero time is left out.
+func (s *TemporalSketch) Add(ts time.Time) {
+	if ts.IsZero() {
+		return
+	}
+	if s.origin.IsZero() {
+		s.origin = ts
+	}
+	d := ts.Sub(s.origin)
+	bin := int(d / s.window)
+	if d < 0 && d%s.window != 0 {
+		bin--
+	}
+	s.bins[bin]++
+}
+
+// Pattern returns the counts of the bins from the earliest one, or nil if no timestamp
+// was counted.
+func (s *TemporalSketch) Pattern() *models.TemporalPattern {
+	if len(s.bins) == 0 {
+		return nil
+	}
+	first := 0
+	for bin := range s.bins {
+		first = min(first, bin)
+	}
+	counts := make(map[int]int, len(s.bins))
+	for bin, n := range s.bins {
+		counts[bin-first] = n
+	}
+	return &models.TemporalPattern{Window: s.window, BinCounts: counts}
+}
+
 func FindTimeRange(traces []models.SQLTrace) (minTime, maxTime time.Time) {
 	if len(traces) == 0 {
 		return
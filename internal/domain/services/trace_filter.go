@@ -0,0 +1,542 @@
+package services
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+)
+
+// TraceFilter is a compiled trace filter expression such as
+//
+//	query !~ '^SELECT 1$' && ts >= '2025-01-01' && (db == 'shop' || user == 'etl')
+//
+// An expression is a boolean combination (&&, ||, !, parentheses; "and", "or" and
+// "not" also work) of comparisons between a trace field and a literal:
+//
+//   - query, db, user, host, session, txn, kind, error: strings compared with ==, !=,
+//     =~ and !~ (regular expression match, unanchored).
+//   - type: the statement type of the query (SELECT, INSERT, UPDATE, DELETE, DDL or
+//     OTHER) and access: read or write, both as classified by ClassifyQuery.
+//   - table: the tables of the query, listed by the parser set with UseParser. == and =~
+//     hold if any table matches, != and !~ if none does; an unqualified name also
+//     matches the table in any schema.
+//   - ts: the trace timestamp, compared with ==, !=, <, <=, > and >= against an
+//     RFC3339 time, "2006-01-02 15:04:05" or "2006-01-02" (UTC).
+//   - latency: compared against a duration such as '10ms' or a number of seconds.
+//   - rows_examined, rows_affected, memory: compared against integers.
+//
+// Strings are single- or double-quoted; numbers and durations may be written bare.
+type TraceFilter struct {
+	expr   string
+	match  func(*models.SQLTrace) bool
+	parser Parser
+}
+
+// ParseTraceFilter compiles a filter expression. An empty expression matches every trace.
+func ParseTraceFilter(expr string) (*TraceFilter, error) {
+	f := &TraceFilter{expr: expr}
+	if strings.TrimSpace(expr) == "" {
+		return f, nil
+	}
+	tokens, err := lexFilter(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter %q: %w", expr, err)
+	}
+	p := &filterParser{tokens: tokens, filter: f}
+	match, err := p.parseOr()
+	if err == nil && p.peek().kind != tokEOF {
+		err = fmt.Errorf("unexpected %s", p.peek())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter %q: %w", expr, err)
+	}
+	f.match = match
+	return f, nil
+}
+
+// Match reports whether the trace satisfies the filter. A nil filter matches every trace.
+func (f *TraceFilter) Match(tr *models.SQLTrace) bool {
+	if f == nil || f.match == nil {
+		return true
+	}
+	return f.match(tr)
+}
+
+// UseParser sets the parser that lists the tables of a query for the table field.
+// Without one, no query references any table.
+func (f *TraceFilter) UseParser(parser Parser) {
+	if f != nil {
+		f.parser = parser
+	}
+}
+
+func (f *TraceFilter) tables(query string) []string {
+	if f.parser == nil {
+		return nil
+	}
+	tables, err := f.parser.ListTables(query)
+	if err != nil {
+		return nil
+	}
+	return tables
+}
+
+// String returns the source expression.
+func (f *TraceFilter) String() string {
+	if f == nil {
+		return ""
+	}
+	return f.expr
+}
+
+// Sampling keys accepted by NewTraceSampler.
+const (
+	SampleByTrace   = "trace"
+	SampleBySession = "session"
+	SampleByQuery   = "query"
+	SampleByTxn     = "txn"
+)
+
+// TraceSampler keeps a deterministic fraction of traces. Whether a trace is kept
+// depends only on a hash of its sampling key and the seed, so repeated runs over the
+// same input select the same traces, and sampling by session keeps or drops whole
+// sessions.
+type TraceSampler struct {
+	rate      float64
+	by        string
+	seed      string
+	threshold uint64
+}
+
+// NewTraceSampler creates a sampler keeping the given fraction (0, 1] of traces.
+// by selects the sampling key: trace (the default), session, query or txn. Traces
+// without a session or transaction id fall back to the per-trace key.
+func NewTraceSampler(rate float64, by, seed string) (*TraceSampler, error) {
+	if rate <= 0 || rate > 1 || math.IsNaN(rate) {
+		return nil, fmt.Errorf("sample rate must be in (0, 1], got %v", rate)
+	}
+	if by == "" {
+		by = SampleByTrace
+	}
+	switch by {
+	case SampleByTrace, SampleBySession, SampleByQuery, SampleByTxn:
+	default:
+		return nil, fmt.Errorf("invalid sampling key %q (expected trace, session, query or txn)", by)
+	}
+	s := &TraceSampler{rate: rate, by: by, seed: seed, threshold: math.MaxUint64}
+	if rate < 1 {
+		s.threshold = uint64(rate * float64(1<<63) * 2)
+	}
+	return s, nil
+}
+
+// Keep reports whether the trace is part of the sample. A nil sampler keeps every trace.
+func (s *TraceSampler) Keep(tr *models.SQLTrace) bool {
+	if s == nil || s.threshold == math.MaxUint64 {
+		return true
+	}
+	h := fnv.New64a()
+	h.Write([]byte(s.seed))
+	h.Write([]byte{0})
+	h.Write([]byte(s.key(tr)))
+	return h.Sum64() < s.threshold
+}
+
+func (s *TraceSampler) key(tr *models.SQLTrace) string {
+	switch {
+	case s.by == SampleBySession && tr.SessionID != "":
+		return "s:" + tr.SessionID
+	case s.by == SampleByTxn && tr.TxnID != "":
+		return "x:" + tr.TxnID
+	case s.by == SampleByQuery:
+		return "q:" + tr.Query
+	}
+	return "t:" + tr.Timestamp.Format(time.RFC3339Nano) + "\x00" + tr.SessionID + "\x00" + tr.Query
+}
+
+// filter fields, by the kind of literal they are compared with.
+var (
+	filterStringFields = map[string]func(*models.SQLTrace) string{
+		"query":   func(t *models.SQLTrace) string { return t.Query },
+		"db":      func(t *models.SQLTrace) string { return t.Database },
+		"user":    func(t *models.SQLTrace) string { return t.User },
+		"host":    func(t *models.SQLTrace) string { return t.Host },
+		"session": func(t *models.SQLTrace) string { return t.SessionID },
+		"txn":     func(t *models.SQLTrace) string { return t.TxnID },
+		"kind":    func(t *models.SQLTrace) string { return t.QueryKind },
+		"error":   func(t *models.SQLTrace) string { return t.ErrorCode },
+		"type": func(t *models.SQLTrace) string {
+			qt, _ := ClassifyQuery(t.Query)
+			return qt.String()
+		},
+		"access": func(t *models.SQLTrace) string {
+			if _, readOnly := ClassifyQuery(t.Query); readOnly {
+				return "read"
+			}
+			return "write"
+		},
+	}
+	filterIntFields = map[string]func(*models.SQLTrace) int64{
+		"rows_examined": func(t *models.SQLTrace) int64 { return t.RowsExamined },
+		"rows_affected": func(t *models.SQLTrace) int64 { return t.RowsAffected },
+		"memory":        func(t *models.SQLTrace) int64 { return t.MemoryUsage },
+	}
+	filterFieldAliases = map[string]string{
+		"database":   "db",
+		"session_id": "session",
+		"txn_id":     "txn",
+		"query_kind": "kind",
+		"error_code": "error",
+		"timestamp":  "ts",
+		"time":       "ts",
+		"query_type": "type",
+		"tables":     "table",
+	}
+	filterTimeLayouts = []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02 15:04:05.999999999", "2006-01-02"}
+)
+
+type filterTokenKind int
+
+const (
+	tokEOF filterTokenKind = iota
+	tokIdent
+	tokString
+	tokNumber
+	tokOp
+	tokAnd
+	tokOr
+	tokNot
+	tokLParen
+	tokRParen
+)
+
+type filterToken struct {
+	kind filterTokenKind
+	text string
+	pos  int
+}
+
+func (t filterToken) String() string {
+	if t.kind == tokEOF {
+		return "end of expression"
+	}
+	return fmt.Sprintf("%q at position %d", t.text, t.pos)
+}
+
+var filterOperators = []string{"==", "!=", "<=", ">=", "=~", "!~", "&&", "||", "<", ">", "!", "(", ")"}
+
+func lexFilter(expr string) ([]filterToken, error) {
+	var tokens []filterToken
+	i := 0
+	for i < len(expr) {
+		c := expr[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '\'' || c == '"':
+			start := i
+			var sb strings.Builder
+			i++
+			for ; i < len(expr) && expr[i] != c; i++ {
+				if expr[i] == '\\' && i+1 < len(expr) && (expr[i+1] == c || expr[i+1] == '\\') {
+					i++
+				}
+				sb.WriteByte(expr[i])
+			}
+			if i >= len(expr) {
+				return nil, fmt.Errorf("unterminated string at position %d", start)
+			}
+			i++
+			tokens = append(tokens, filterToken{kind: tokString, text: sb.String(), pos: start})
+		case isFilterWordByte(c):
+			start := i
+			for i < len(expr) && (isFilterWordByte(expr[i]) || expr[i] == '.') {
+				i++
+			}
+			word := expr[start:i]
+			tok := filterToken{kind: tokIdent, text: word, pos: start}
+			switch {
+			case c >= '0' && c <= '9':
+				tok.kind = tokNumber
+			case strings.EqualFold(word, "and"):
+				tok.kind = tokAnd
+			case strings.EqualFold(word, "or"):
+				tok.kind = tokOr
+			case strings.EqualFold(word, "not"):
+				tok.kind = tokNot
+			}
+			tokens = append(tokens, tok)
+		default:
+			op := ""
+			for _, candidate := range filterOperators {
+				if strings.HasPrefix(expr[i:], candidate) {
+					op = candidate
+					break
+				}
+			}
+			if op == "" {
+				return nil, fmt.Errorf("unexpected character %q at position %d", c, i)
+			}
+			tok := filterToken{kind: tokOp, text: op, pos: i}
+			switch op {
+			case "&&":
+				tok.kind = tokAnd
+			case "||":
+				tok.kind = tokOr
+			case "!":
+				tok.kind = tokNot
+			case "(":
+				tok.kind = tokLParen
+			case ")":
+				tok.kind = tokRParen
+			}
+			tokens = append(tokens, tok)
+			i += len(op)
+		}
+	}
+	return append(tokens, filterToken{kind: tokEOF, pos: len(expr)}), nil
+}
+
+func isFilterWordByte(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+// filterParser is a recursive descent parser producing the match function directly.
+type filterParser struct {
+	tokens []filterToken
+	pos    int
+	filter *TraceFilter
+}
+
+func (p *filterParser) peek() filterToken {
+	return p.tokens[p.pos]
+}
+
+func (p *filterParser) next() filterToken {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *filterParser) parseOr() (func(*models.SQLTrace) bool, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokOr {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		l := left
+		left = func(t *models.SQLTrace) bool { return l(t) || right(t) }
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (func(*models.SQLTrace) bool, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokAnd {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		l := left
+		left = func(t *models.SQLTrace) bool { return l(t) && right(t) }
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseUnary() (func(*models.SQLTrace) bool, error) {
+	switch p.peek().kind {
+	case tokNot:
+		p.next()
+		inner, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return func(t *models.SQLTrace) bool { return !inner(t) }, nil
+	case tokLParen:
+		p.next()
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if tok := p.next(); tok.kind != tokRParen {
+			return nil, fmt.Errorf("expected ) but found %s", tok)
+		}
+		return inner, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *filterParser) parseComparison() (func(*models.SQLTrace) bool, error) {
+	fieldTok := p.next()
+	if fieldTok.kind != tokIdent {
+		return nil, fmt.Errorf("expected a field name but found %s", fieldTok)
+	}
+	opTok := p.next()
+	if opTok.kind != tokOp {
+		return nil, fmt.Errorf("expected a comparison operator after %q but found %s", fieldTok.text, opTok)
+	}
+	valTok := p.next()
+	if valTok.kind != tokString && valTok.kind != tokNumber {
+		return nil, fmt.Errorf("expected a value after %q but found %s", opTok.text, valTok)
+	}
+
+	field := strings.ToLower(fieldTok.text)
+	if alias, ok := filterFieldAliases[field]; ok {
+		field = alias
+	}
+	op := opTok.text
+
+	if get, ok := filterStringFields[field]; ok {
+		return compileStringComparison(get, op, valTok)
+	}
+	if get, ok := filterIntFields[field]; ok {
+		n, err := strconv.ParseInt(valTok.text, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s expects an integer but found %s", field, valTok)
+		}
+		cmp, err := orderedComparison(op, opTok)
+		if err != nil {
+			return nil, err
+		}
+		return func(t *models.SQLTrace) bool { return cmp(compareInt64(get(t), n)) }, nil
+	}
+	switch field {
+	case "table":
+		return compileTableComparison(p.filter, op, valTok)
+	case "ts":
+		ts, err := parseFilterTime(valTok.text)
+		if err != nil {
+			return nil, fmt.Errorf("ts expects a time but found %s", valTok)
+		}
+		cmp, err := orderedComparison(op, opTok)
+		if err != nil {
+			return nil, err
+		}
+		return func(t *models.SQLTrace) bool { return cmp(t.Timestamp.Compare(ts)) }, nil
+	case "latency":
+		d, err := parseFilterDuration(valTok.text)
+		if err != nil {
+			return nil, fmt.Errorf("latency expects a duration but found %s", valTok)
+		}
+		cmp, err := orderedComparison(op, opTok)
+		if err != nil {
+			return nil, err
+		}
+		return func(t *models.SQLTrace) bool { return cmp(compareInt64(int64(t.Latency), int64(d))) }, nil
+	}
+	return nil, fmt.Errorf("unknown field %s", fieldTok)
+}
+
+func compileStringComparison(get func(*models.SQLTrace) string, op string, val filterToken) (func(*models.SQLTrace) bool, error) {
+	switch op {
+	case "==":
+		return func(t *models.SQLTrace) bool { return get(t) == val.text }, nil
+	case "!=":
+		return func(t *models.SQLTrace) bool { return get(t) != val.text }, nil
+	case "=~", "!~":
+		re, err := regexp.Compile(val.text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression %s: %w", val, err)
+		}
+		want := op == "=~"
+		return func(t *models.SQLTrace) bool { return re.MatchString(get(t)) == want }, nil
+	}
+	return nil, fmt.Errorf("operator %q is not supported on text fields", op)
+}
+
+func compileTableComparison(f *TraceFilter, op string, val filterToken) (func(*models.SQLTrace) bool, error) {
+	var match func(string) bool
+	switch op {
+	case "==", "!=":
+		match = func(name string) bool { return tableNameMatches(name, val.text) }
+	case "=~", "!~":
+		re, err := regexp.Compile(val.text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression %s: %w", val, err)
+		}
+		match = re.MatchString
+	default:
+		return nil, fmt.Errorf("operator %q is not supported on text fields", op)
+	}
+	want := op == "==" || op == "=~"
+	return func(t *models.SQLTrace) bool {
+		for _, name := range f.tables(t.Query) {
+			if match(name) {
+				return want
+			}
+		}
+		return !want
+	}, nil
+}
+
+// tableNameMatches reports whether a listed table is the wanted one. An unqualified
+// name matches the table in any schema.
+func tableNameMatches(name, want string) bool {
+	if strings.EqualFold(name, want) {
+		return true
+	}
+	i := strings.LastIndexByte(name, '.')
+	return i >= 0 && !strings.Contains(want, ".") && strings.EqualFold(name[i+1:], want)
+}
+
+// orderedComparison maps an operator to a test on the result of a three-way comparison.
+func orderedComparison(op string, tok filterToken) (func(int) bool, error) {
+	switch op {
+	case "==":
+		return func(c int) bool { return c == 0 }, nil
+	case "!=":
+		return func(c int) bool { return c != 0 }, nil
+	case "<":
+		return func(c int) bool { return c < 0 }, nil
+	case "<=":
+		return func(c int) bool { return c <= 0 }, nil
+	case ">":
+		return func(c int) bool { return c > 0 }, nil
+	case ">=":
+		return func(c int) bool { return c >= 0 }, nil
+	}
+	return nil, fmt.Errorf("operator %s is only supported on text fields", tok)
+}
+
+func compareInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func parseFilterTime(value string) (time.Time, error) {
+	for _, layout := range filterTimeLayouts {
+		if ts, err := time.ParseInLocation(layout, value, time.UTC); err == nil {
+			return ts, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognized time %q", value)
+}
+
+func parseFilterDuration(value string) (time.Duration, error) {
+	if secs, err := strconv.ParseFloat(value, 64); err == nil {
+		return time.Duration(secs * float64(time.Second)), nil
+	}
+	return time.ParseDuration(value)
+}
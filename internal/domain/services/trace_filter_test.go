@@ -0,0 +1,146 @@
+package services
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+)
+
+func TestTraceFilter_Match(t *testing.T) {
+	trace := models.SQLTrace{
+		Query:        "SELECT * FROM orders WHERE id = 7",
+		Timestamp:    time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		Latency:      15 * time.Millisecond,
+		Database:     "shop",
+		User:         "app",
+		SessionID:    "42",
+		RowsExamined: 100,
+		ErrorCode:    "1213",
+	}
+
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{"", true},
+		{"query !~ '^SELECT 1$'", true},
+		{"query =~ '(?i)from orders'", true},
+		{"db == 'shop' && user == \"app\"", true},
+		{"db == 'shop' && user == 'etl'", false},
+		{"db == 'crm' || user == 'app'", true},
+		{"!(db == 'shop')", false},
+		{"not db == 'crm' and session == '42'", true},
+		{"ts >= '2025-01-01' && ts < '2025-01-03'", true},
+		{"ts > '2025-01-02T03:04:05Z'", false},
+		{"timestamp == '2025-01-02 03:04:05'", true},
+		{"latency > 10ms", true},
+		{"latency > 0.02", false},
+		{"latency <= '15ms'", true},
+		{"rows_examined >= 100 && rows_affected == 0", true},
+		{"error != ''", true},
+		{"database == 'shop' || (user == 'x' && db == 'y')", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			f, err := ParseTraceFilter(tt.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, f.Match(&trace))
+		})
+	}
+
+	var nilFilter *TraceFilter
+	assert.True(t, nilFilter.Match(&trace))
+}
+
+// tableParser lists fixed tables for every query.
+type tableParser []string
+
+func (p tableParser) ListTables(string) ([]string, error) { return p, nil }
+
+func TestTraceFilter_QueryTypeAndTables(t *testing.T) {
+	selectTrace := models.SQLTrace{Query: "SELECT * FROM shop.orders o JOIN users u ON u.id = o.user_id"}
+	updateTrace := models.SQLTrace{Query: "UPDATE users SET name = 'x'"}
+
+	tests := []struct {
+		expr       string
+		sel, write bool
+	}{
+		{"type == 'SELECT' && table == 'orders'", true, false},
+		{"type == 'UPDATE'", false, true},
+		{"access == 'read'", true, false},
+		{"query_type != 'SELECT'", false, true},
+		{"table == 'shop.orders'", true, false},
+		{"table == 'crm.orders'", false, false},
+		{"table != 'orders'", false, true},
+		{"tables =~ '^us'", true, true},
+		{"table !~ 'ord'", false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			f, err := ParseTraceFilter(tt.expr)
+			require.NoError(t, err)
+			f.UseParser(tableParser{"shop.orders", "users"})
+			assert.Equal(t, tt.sel, f.Match(&selectTrace))
+			f.UseParser(tableParser{"users"})
+			assert.Equal(t, tt.write, f.Match(&updateTrace))
+		})
+	}
+
+	// Without a parser, no query references a table.
+	f, err := ParseTraceFilter("table == 'orders'")
+	require.NoError(t, err)
+	assert.False(t, f.Match(&selectTrace))
+}
+
+func TestParseTraceFilter_Errors(t *testing.T) {
+	for _, expr := range []string{
+		"db ==",
+		"db == 'shop' &&",
+		"(db == 'shop'",
+		"db == 'shop')",
+		"nope == 'x'",
+		"db < 'shop'",
+		"ts >= 'yesterday'",
+		"latency > fast",
+		"rows_examined > 1.5",
+		"query =~ '('",
+		"db == 'unterminated",
+		"db # 'x'",
+	} {
+		_, err := ParseTraceFilter(expr)
+		assert.Error(t, err, expr)
+	}
+}
+
+func TestTraceSampler(t *testing.T) {
+	_, err := NewTraceSampler(0, "", "")
+	assert.Error(t, err)
+	_, err = NewTraceSampler(1.5, "", "")
+	assert.Error(t, err)
+	_, err = NewTraceSampler(0.5, "user", "")
+	assert.Error(t, err)
+
+	sampler, err := NewTraceSampler(0.1, SampleBySession, "")
+	require.NoError(t, err)
+
+	kept := 0
+	const sessions = 2000
+	for i := 0; i < sessions; i++ {
+		first := models.SQLTrace{Query: "BEGIN", SessionID: fmt.Sprint(i)}
+		second := models.SQLTrace{Query: "COMMIT", SessionID: fmt.Sprint(i)}
+		keep := sampler.Keep(&first)
+		assert.Equal(t, keep, sampler.Keep(&second), "a session is kept or dropped as a whole")
+		if keep {
+			kept++
+		}
+	}
+	assert.InDelta(t, sessions/10, kept, sessions/40)
+
+	all, err := NewTraceSampler(1, "", "")
+	require.NoError(t, err)
+	assert.True(t, all.Keep(&models.SQLTrace{}))
+}
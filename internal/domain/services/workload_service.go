@@ -12,6 +12,9 @@ import (
 type GenerationConfig struct {
 	TotalQueries int
 	ScaleFactor  float64
+	// ReferenceTime is the time relative datetime parameters are re-anchored to;
+	// the zero value uses the time of generation.
+	ReferenceTime time.Time
 }
 
 // WorkloadService is responsible for generating a benchmark workload.
@@ -36,6 +39,9 @@ func (s *WorkloadService) GenerateWorkload(
 
 	// 1. Initialize Synthesizer
 	synthesizer := NewSynthesizer(pm)
+	if !config.ReferenceTime.IsZero() {
+		synthesizer.SetReferenceTime(config.ReferenceTime)
+	}
 
 	// 2. Prepare Template Selector (Weighted Random)
 	var totalWeight int
@@ -86,6 +86,18 @@ func (s *DBExecutionService) RunBench(ctx context.Context, wl *models.BenchmarkW
 	var wg sync.WaitGroup
 
 	for _, q := range wl.Queries {
+		// A workload shaped in time issues each query at its time.
+		if wait := time.Until(start.Add(q.IssueAt)); q.IssueAt > 0 && wait > 0 {
+			timer := time.NewTimer(wait)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+			}
+			if ctx.Err() != nil {
+				break
+			}
+		}
 		if err := s.rc.Acquire(ctx); err != nil {
 			// If we can't acquire, we stop.
 			// The already spawned goroutines will continue and finish.
@@ -110,7 +122,7 @@ func (s *DBExecutionService) RunBench(ctx context.Context, wl *models.BenchmarkW
 				return
 			}
 
-			_, err = stmt.ExecContext(ctx, query.Args...)
+			_, err = stmt.ExecContext(ctx, query.BindArgs()...)
 			latency := time.Since(execStart)
 			recorder.Record(latency, err)
 		}(q)
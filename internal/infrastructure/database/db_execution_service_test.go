@@ -2,7 +2,10 @@ package database
 
 import (
 	"context"
+	"database/sql"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
@@ -101,3 +104,49 @@ func TestDBExecutionService_RunBench_ExecFails(t *testing.T) {
 	assert.Equal(t, int64(1), metrics.Errors)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestDBExecutionService_RunBench_IssueAt(t *testing.T) {
+	service, mock := newTestDBExecutionService(t)
+	defer service.db.Close()
+
+	workload := &models.BenchmarkWorkload{
+		Queries: []models.QueryWithArgs{
+			{Query: "SELECT 1", Args: []interface{}{}},
+			{Query: "SELECT 2", Args: []interface{}{}, IssueAt: 50 * time.Millisecond},
+		},
+		Duration: 50 * time.Millisecond,
+	}
+
+	mock.ExpectPing()
+	mock.ExpectPrepare("SELECT 1").ExpectExec().WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectPrepare("SELECT 2").ExpectExec().WillReturnResult(sqlmock.NewResult(1, 1))
+
+	start := time.Now()
+	metrics, err := service.RunBench(context.Background(), workload)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), metrics.QueriesExecuted)
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond, "the second query waits for its time")
+}
+
+func TestDBExecutionService_RunBench_NamedArgs(t *testing.T) {
+	service, mock := newTestDBExecutionService(t)
+	defer service.db.Close()
+
+	at := time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC)
+	query := "SELECT * FROM orders WHERE id = {p1:Int64} AND created_at > {p2:DateTime64(6)} AND note = {p3:Nullable(String)}"
+	workload := &models.BenchmarkWorkload{Queries: []models.QueryWithArgs{
+		{Query: query, Args: []interface{}{int64(7), at, nil}, ArgNames: []string{"p1", "p2", "p3"}},
+	}}
+
+	// Named placeholders are bound by name, as the text ClickHouse parses them from.
+	mock.ExpectPing()
+	prep := mock.ExpectPrepare(regexp.QuoteMeta(query))
+	prep.ExpectExec().
+		WithArgs(sql.Named("p1", "7"), sql.Named("p2", "2024-03-01 12:30:00.000000"), sql.Named("p3", `\N`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	metrics, err := service.RunBench(context.Background(), workload)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), metrics.Errors)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
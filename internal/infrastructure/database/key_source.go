@@ -0,0 +1,126 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/turtacn/SQLTraceBench/pkg/types"
+)
+
+// SQLKeySource reads the tables, columns and values of a database through
+// database/sql, for data-aware parameter sampling (see services.KeySampler).
+type SQLKeySource struct {
+	db      *sql.DB
+	dialect types.DatabaseType
+	owned   bool
+}
+
+// NewSQLKeySource creates a SQLKeySource for a connection to a database of the
+// given driver, e.g. mysql, postgres or clickhouse.
+func NewSQLKeySource(db *sql.DB, driver string) *SQLKeySource {
+	return &SQLKeySource{db: db, dialect: types.DatabaseTypeFromString(driver)}
+}
+
+// OpenSQLKeySource opens a connection to a DSN and creates a SQLKeySource for it;
+// Close closes the connection.
+func OpenSQLKeySource(driver, dsn string) (*SQLKeySource, error) {
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, types.WrapError(types.ErrDatabaseConnection, "failed to open database connection", err)
+	}
+	s := NewSQLKeySource(db, driver)
+	s.owned = true
+	return s, nil
+}
+
+// Close closes the connection opened by OpenSQLKeySource.
+func (s *SQLKeySource) Close() error {
+	if !s.owned {
+		return nil
+	}
+	return s.db.Close()
+}
+
+// ListTables returns the tables of the current database, or schema for PostgreSQL.
+func (s *SQLKeySource) ListTables(ctx context.Context) ([]string, error) {
+	var query string
+	switch s.dialect {
+	case types.DatabasePostgreSQL:
+		query = "SELECT table_name FROM information_schema.tables WHERE table_schema = current_schema()"
+	case types.DatabaseClickHouse:
+		query = "SELECT name FROM system.tables WHERE database = currentDatabase()"
+	default:
+		query = "SELECT table_name FROM information_schema.tables WHERE table_schema = DATABASE()"
+	}
+	return s.queryStrings(ctx, query)
+}
+
+// ListColumns returns the columns of a table of the current database.
+func (s *SQLKeySource) ListColumns(ctx context.Context, table string) ([]string, error) {
+	switch s.dialect {
+	case types.DatabasePostgreSQL:
+		return s.queryStrings(ctx, "SELECT column_name FROM information_schema.columns WHERE table_schema = current_schema() AND table_name = $1 ORDER BY ordinal_position", table)
+	case types.DatabaseClickHouse:
+		return s.queryStrings(ctx, "SELECT name FROM system.columns WHERE database = currentDatabase() AND table = ? ORDER BY position", table)
+	default:
+		return s.queryStrings(ctx, "SELECT column_name FROM information_schema.columns WHERE table_schema = DATABASE() AND table_name = ? ORDER BY ordinal_position", table)
+	}
+}
+
+// SampleColumn returns up to limit distinct non-null values of a column. Values read
+// as bytes are returned as strings.
+func (s *SQLKeySource) SampleColumn(ctx context.Context, table, column string, limit int) ([]interface{}, error) {
+	col := s.quote(column)
+	query := fmt.Sprintf("SELECT DISTINCT %s FROM %s WHERE %s IS NOT NULL LIMIT %d", col, s.quote(table), col, limit)
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, types.WrapError(types.ErrDatabaseConnection, "failed to read column values", err)
+	}
+	defer rows.Close()
+
+	var values []interface{}
+	for rows.Next() {
+		var v interface{}
+		if err := rows.Scan(&v); err != nil {
+			return nil, types.WrapError(types.ErrDatabaseConnection, "failed to scan column value", err)
+		}
+		if b, ok := v.([]byte); ok {
+			v = string(b)
+		}
+		values = append(values, v)
+	}
+	return values, rows.Err()
+}
+
+func (s *SQLKeySource) queryStrings(ctx context.Context, query string, args ...interface{}) ([]string, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, types.WrapError(types.ErrDatabaseConnection, "failed to query the catalog", err)
+	}
+	defer rows.Close()
+
+	var out []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, types.WrapError(types.ErrDatabaseConnection, "failed to scan the catalog", err)
+		}
+		out = append(out, name)
+	}
+	return out, rows.Err()
+}
+
+// quote quotes a possibly qualified identifier for the dialect.
+func (s *SQLKeySource) quote(ident string) string {
+	q := "`"
+	if s.dialect == types.DatabasePostgreSQL {
+		q = `"`
+	}
+	parts := strings.Split(ident, ".")
+	for i, p := range parts {
+		parts[i] = q + strings.ReplaceAll(p, q, q+q) + q
+	}
+	return strings.Join(parts, ".")
+}
@@ -0,0 +1,53 @@
+package database
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQLKeySource(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	source := NewSQLKeySource(db, "mysql")
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM information_schema.tables WHERE table_schema = DATABASE()")).
+		WillReturnRows(sqlmock.NewRows([]string{"table_name"}).AddRow("orders").AddRow("users"))
+	tables, err := source.ListTables(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"orders", "users"}, tables)
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM information_schema.columns")).WithArgs("orders").
+		WillReturnRows(sqlmock.NewRows([]string{"column_name"}).AddRow("id").AddRow("customer_id"))
+	columns, err := source.ListColumns(ctx, "orders")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"id", "customer_id"}, columns)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT DISTINCT `customer_id` FROM `shop`.`orders` WHERE `customer_id` IS NOT NULL LIMIT 100")).
+		WillReturnRows(sqlmock.NewRows([]string{"customer_id"}).AddRow([]byte("17")).AddRow(int64(42)))
+	values, err := source.SampleColumn(ctx, "shop.orders", "customer_id", 100)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"17", int64(42)}, values, "bytes are read as strings")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSQLKeySource_Postgres(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	source := NewSQLKeySource(db, "postgres")
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT DISTINCT "Id" FROM "orders" WHERE "Id" IS NOT NULL LIMIT 5`)).
+		WillReturnRows(sqlmock.NewRows([]string{"Id"}).AddRow(int64(1)))
+	values, err := source.SampleColumn(context.Background(), "orders", "Id", 5)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{int64(1)}, values)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,146 @@
+// PostgreSqlLexer.g4
+lexer grammar PostgreSqlLexer;
+
+// Keywords
+SELECT: S E L E C T;
+FROM: F R O M;
+WHERE: W H E R E;
+AND: A N D;
+OR: O R;
+NOT: N O T;
+NULL_LITERAL: N U L L;
+TRUE: T R U E;
+FALSE: F A L S E;
+JOIN: J O I N;
+INNER: I N N E R;
+LEFT: L E F T;
+RIGHT: R I G H T;
+FULL: F U L L;
+OUTER: O U T E R;
+CROSS: C R O S S;
+ON: O N;
+USING: U S I N G;
+AS: A S;
+ONLY: O N L Y;
+LATERAL: L A T E R A L;
+WITH: W I T H;
+WITHOUT: W I T H O U T;
+RECURSIVE: R E C U R S I V E;
+MATERIALIZED: M A T E R I A L I Z E D;
+INSERT: I N S E R T;
+INTO: I N T O;
+VALUES: V A L U E S;
+DEFAULT: D E F A U L T;
+UPDATE: U P D A T E;
+SET: S E T;
+DELETE: D E L E T E;
+TRUNCATE: T R U N C A T E;
+TABLE: T A B L E;
+RETURNING: R E T U R N I N G;
+CONFLICT: C O N F L I C T;
+CONSTRAINT: C O N S T R A I N T;
+DO: D O;
+NOTHING: N O T H I N G;
+DISTINCT: D I S T I N C T;
+ALL: A L L;
+UNION: U N I O N;
+INTERSECT: I N T E R S E C T;
+EXCEPT: E X C E P T;
+GROUP: G R O U P;
+BY: B Y;
+HAVING: H A V I N G;
+ORDER: O R D E R;
+ASC: A S C;
+DESC: D E S C;
+LIMIT: L I M I T;
+OFFSET: O F F S E T;
+FOR: F O R;
+OF: O F;
+NO: N O;
+KEY: K E Y;
+SHARE: S H A R E;
+NOWAIT: N O W A I T;
+SKIP_: S K I P;
+LOCKED: L O C K E D;
+IS: I S;
+IN: I N;
+LIKE: L I K E;
+ILIKE: I L I K E;
+BETWEEN: B E T W E E N;
+EXISTS: E X I S T S;
+CASE: C A S E;
+WHEN: W H E N;
+THEN: T H E N;
+ELSE: E L S E;
+END: E N D;
+PRECISION: P R E C I S I O N;
+
+// Identifiers
+ID: [a-zA-Z_\u0080-\uFFFF] [a-zA-Z_0-9$\u0080-\uFFFF]*;
+QUOTED_ID: '"' ( '""' | ~'"' )* '"';
+
+// Literals
+INT: [0-9]+;
+DECIMAL: [0-9]+ '.' [0-9]+;
+STRING: '\'' ('\'\'' | ~'\'')* '\'';
+ESCAPE_STRING: [eE] '\'' ('\\' ~'\u0000' | '\'\'' | ~['\\])* '\'';
+DOLLAR_STRING: '$$' ( ~'$' | '$' ~'$' )* '$$';
+PARAMETER: '$' [0-9]+;
+
+// Operators
+EQ: '=';
+NEQ: '!=' | '<>';
+GT: '>';
+LT: '<';
+GTE: '>=';
+LTE: '<=';
+OVERLAP: '&&';
+PLUS: '+';
+MINUS: '-';
+STAR: '*';
+SLASH: '/';
+PERCENT: '%';
+CONCAT: '||';
+CAST: '::';
+
+// Punctuation
+LPAREN: '(';
+RPAREN: ')';
+LBRACKET: '[';
+RBRACKET: ']';
+COMMA: ',';
+DOT: '.';
+SEMICOLON: ';';
+
+// Whitespace and comments
+WS: [ \t\r\n\f]+ -> skip;
+LINE_COMMENT: '--' ~[\r\n]* -> skip;
+BLOCK_COMMENT: '/*' ( ~'*' | '*'+ ~[*/] )* '*'+ '/' -> skip;
+
+// Case-insensitive fragments
+fragment A: [aA];
+fragment B: [bB];
+fragment C: [cC];
+fragment D: [dD];
+fragment E: [eE];
+fragment F: [fF];
+fragment G: [gG];
+fragment H: [hH];
+fragment I: [iI];
+fragment J: [jJ];
+fragment K: [kK];
+fragment L: [lL];
+fragment M: [mM];
+fragment N: [nN];
+fragment O: [oO];
+fragment P: [pP];
+fragment Q: [qQ];
+fragment R: [rR];
+fragment S: [sS];
+fragment T: [tT];
+fragment U: [uU];
+fragment V: [vV];
+fragment W: [wW];
+fragment X: [xX];
+fragment Y: [yY];
+fragment Z: [zZ];
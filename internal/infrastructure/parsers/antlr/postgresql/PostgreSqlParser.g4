@@ -0,0 +1,232 @@
+// PostgreSqlParser.g4
+parser grammar PostgreSqlParser;
+
+options { tokenVocab = PostgreSqlLexer; }
+
+// Rules
+query: statement SEMICOLON? EOF;
+
+statement:
+    with_clause? (select_statement | insert_statement | update_statement | delete_statement)
+    | truncate_statement;
+
+with_clause:
+    WITH RECURSIVE? common_table_expression (COMMA common_table_expression)*;
+
+common_table_expression:
+    identifier column_list? AS materialization? LPAREN statement RPAREN;
+
+materialization:
+    NOT? MATERIALIZED;
+
+select_statement:
+    select_core (set_operator select_core)* order_by_clause? limit_clause? locking_clause?;
+
+set_operator:
+    (UNION | INTERSECT | EXCEPT) (ALL | DISTINCT)?;
+
+select_core:
+    SELECT (ALL | DISTINCT)? select_list from_clause? where_clause? group_by_clause? having_clause?;
+
+select_list:
+    select_item (COMMA select_item)*;
+
+select_item:
+    STAR
+    | expression (AS? identifier)?;
+
+from_clause:
+    FROM from_item (COMMA from_item)*;
+
+from_item:
+    table_primary (join_clause)*;
+
+table_primary:
+    ONLY? qualified_name table_suffix? alias?
+    | LATERAL? LPAREN statement RPAREN alias?;
+
+table_suffix:
+    STAR
+    | call_arguments;
+
+alias:
+    AS? identifier column_list?;
+
+join_clause:
+    CROSS JOIN table_primary
+    | join_type? JOIN table_primary join_condition;
+
+join_type:
+    INNER
+    | (LEFT | RIGHT | FULL) OUTER?;
+
+join_condition:
+    ON expression
+    | USING column_list;
+
+where_clause:
+    WHERE expression;
+
+group_by_clause:
+    GROUP BY expression_list;
+
+having_clause:
+    HAVING expression;
+
+order_by_clause:
+    ORDER BY order_item (COMMA order_item)*;
+
+order_item:
+    expression (ASC | DESC)?;
+
+limit_clause:
+    LIMIT expression (OFFSET expression)?
+    | OFFSET expression;
+
+locking_clause:
+    FOR lock_strength (OF qualified_name (COMMA qualified_name)*)? wait_policy?;
+
+lock_strength:
+    UPDATE
+    | NO KEY UPDATE
+    | SHARE
+    | KEY SHARE;
+
+wait_policy:
+    NOWAIT
+    | SKIP_ LOCKED;
+
+insert_statement:
+    INSERT INTO table_name (AS identifier)? column_list? insert_source on_conflict_clause? returning_clause?;
+
+insert_source:
+    VALUES value_row (COMMA value_row)*
+    | select_statement
+    | DEFAULT VALUES;
+
+value_row:
+    LPAREN expression_list RPAREN;
+
+on_conflict_clause:
+    ON CONFLICT conflict_target? DO conflict_action;
+
+conflict_target:
+    column_list
+    | ON CONSTRAINT identifier;
+
+conflict_action:
+    NOTHING
+    | UPDATE SET set_clause (COMMA set_clause)* where_clause?;
+
+update_statement:
+    UPDATE ONLY? table_name alias? SET set_clause (COMMA set_clause)* from_clause? where_clause? returning_clause?;
+
+set_clause:
+    identifier EQ expression;
+
+delete_statement:
+    DELETE FROM ONLY? table_name alias? (USING from_item (COMMA from_item)*)? where_clause? returning_clause?;
+
+truncate_statement:
+    TRUNCATE TABLE? table_name (COMMA table_name)*;
+
+returning_clause:
+    RETURNING select_list;
+
+table_name:
+    qualified_name;
+
+qualified_name:
+    identifier (DOT identifier)*;
+
+column_list:
+    LPAREN identifier (COMMA identifier)* RPAREN;
+
+expression:
+    and_expression (OR and_expression)*;
+
+and_expression:
+    not_expression (AND not_expression)*;
+
+not_expression:
+    NOT? predicate;
+
+predicate:
+    additive_expression predicate_suffix?;
+
+predicate_suffix:
+    (EQ | NEQ | GT | LT | GTE | LTE | OVERLAP) additive_expression
+    | IS NOT? (NULL_LITERAL | TRUE | FALSE | DISTINCT FROM additive_expression)
+    | NOT? (IN LPAREN (statement | expression_list) RPAREN | (LIKE | ILIKE) additive_expression | BETWEEN additive_expression AND additive_expression);
+
+additive_expression:
+    multiplicative_expression ((PLUS | MINUS | CONCAT) multiplicative_expression)*;
+
+multiplicative_expression:
+    unary_expression ((STAR | SLASH | PERCENT) unary_expression)*;
+
+unary_expression:
+    MINUS? cast_expression;
+
+cast_expression:
+    primary (CAST type_name)*;
+
+primary:
+    constant
+    | identifier (DOT (identifier | STAR))* name_suffix?
+    | LPAREN (statement | expression_list) RPAREN
+    | EXISTS LPAREN statement RPAREN
+    | CASE expression? (when_clause)+ (ELSE expression)? END;
+
+name_suffix:
+    call_arguments
+    | STRING;
+
+call_arguments:
+    LPAREN function_arguments? RPAREN;
+
+function_arguments:
+    STAR
+    | (ALL | DISTINCT)? function_argument (COMMA function_argument)*;
+
+function_argument:
+    expression (FROM expression)?;
+
+when_clause:
+    WHEN expression THEN expression;
+
+type_name:
+    identifier (DOT identifier)? PRECISION? type_modifiers? time_zone? (LBRACKET RBRACKET)*;
+
+type_modifiers:
+    LPAREN INT (COMMA INT)* RPAREN;
+
+time_zone:
+    (WITH | WITHOUT) identifier identifier;
+
+expression_list:
+    expression (COMMA expression)*;
+
+constant:
+    INT
+    | DECIMAL
+    | STRING
+    | ESCAPE_STRING
+    | DOLLAR_STRING
+    | PARAMETER
+    | NULL_LITERAL
+    | TRUE
+    | FALSE
+    | DEFAULT;
+
+identifier:
+    ID
+    | QUOTED_ID
+    | KEY
+    | SHARE
+    | NOTHING
+    | CONFLICT
+    | MATERIALIZED
+    | NOWAIT
+    | SKIP_
+    | LOCKED;
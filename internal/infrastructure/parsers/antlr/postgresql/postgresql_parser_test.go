@@ -0,0 +1,93 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+type testErrorListener struct {
+	*antlr.DefaultErrorListener
+	t      *testing.T
+	errors int
+}
+
+func (l *testErrorListener) SyntaxError(recognizer antlr.Recognizer, offendingSymbol interface{}, line, column int, msg string, e antlr.RecognitionException) {
+	l.errors++
+}
+
+func (l *testErrorListener) GetSyntaxErrors() int {
+	return l.errors
+}
+
+func parseQuery(t *testing.T, sql string) (antlr.Tree, *testErrorListener) {
+	t.Helper()
+
+	// Create the lexer and parser
+	input := antlr.NewInputStream(sql)
+	lexer := NewPostgreSqlLexer(input)
+	stream := antlr.NewCommonTokenStream(lexer, 0)
+	p := NewPostgreSqlParser(stream)
+
+	// Add an error listener to capture syntax errors
+	errorListener := &testErrorListener{t: t}
+	p.AddErrorListener(errorListener)
+
+	// Parse the query
+	tree := p.Query()
+
+	return tree, errorListener
+}
+
+func TestPostgreSqlParser_SelectWithCasts(t *testing.T) {
+	// Parse a SELECT query with "::" casts and a positional parameter
+	tree, errorListener := parseQuery(t, "SELECT id::text, created_at::timestamp with time zone FROM events WHERE tags::varchar(20)[] && $1;")
+
+	// Assert that there are no syntax errors
+	assert.Equal(t, 0, errorListener.GetSyntaxErrors(), "should not have any syntax errors")
+
+	// Assert that the parse tree is not nil
+	assert.NotNil(t, tree)
+}
+
+func TestPostgreSqlParser_MaterializedCTE(t *testing.T) {
+	// Parse a query with MATERIALIZED and NOT MATERIALIZED common table expressions
+	tree, errorListener := parseQuery(t, "WITH recent AS MATERIALIZED (SELECT * FROM orders), top AS NOT MATERIALIZED (SELECT user_id FROM recent) SELECT * FROM top JOIN public.users ON users.id = top.user_id")
+
+	// Assert that there are no syntax errors
+	assert.Equal(t, 0, errorListener.GetSyntaxErrors(), "should not have any syntax errors")
+
+	// Assert that the parse tree is not nil
+	assert.NotNil(t, tree)
+}
+
+func TestPostgreSqlParser_InsertOnConflictReturning(t *testing.T) {
+	// Parse an INSERT with an ON CONFLICT clause and RETURNING
+	tree, errorListener := parseQuery(t, "INSERT INTO counters (key, n) VALUES ($1, 1) ON CONFLICT (key) DO UPDATE SET n = counters.n + 1 RETURNING n;")
+
+	// Assert that there are no syntax errors
+	assert.Equal(t, 0, errorListener.GetSyntaxErrors(), "should not have any syntax errors")
+
+	// Assert that the parse tree is not nil
+	assert.NotNil(t, tree)
+}
+
+func TestPostgreSqlParser_DeleteUsing(t *testing.T) {
+	// Parse a DELETE with a USING clause and quoted identifiers
+	tree, errorListener := parseQuery(t, `DELETE FROM "Sessions" s USING users u WHERE s.user_id = u.id RETURNING s.id`)
+
+	// Assert that there are no syntax errors
+	assert.Equal(t, 0, errorListener.GetSyntaxErrors(), "should not have any syntax errors")
+
+	// Assert that the parse tree is not nil
+	assert.NotNil(t, tree)
+}
+
+func TestPostgreSqlParser_InvalidQuery(t *testing.T) {
+	// Parse an invalid query
+	_, errorListener := parseQuery(t, "SELECT * FROM;")
+
+	// Assert that there is at least one syntax error
+	assert.Greater(t, errorListener.GetSyntaxErrors(), 0, "should have at least one syntax error")
+}
@@ -1,6 +1,8 @@
 package parsers
 
 import (
+	"fmt"
+
 	"github.com/antlr4-go/antlr/v4"
 	mysql "github.com/turtacn/SQLTraceBench/internal/infrastructure/parsers/antlr/mysql"
 )
@@ -14,24 +16,49 @@ func NewAntlrParser() *AntlrParser {
 }
 
 // ListTables extracts table names from a SQL query using the ANTLR parser.
+// Statements the grammar does not cover fall back to the RegexParser.
 func (p *AntlrParser) ListTables(sql string) ([]string, error) {
+	errs := &syntaxErrorCounter{DefaultErrorListener: antlr.NewDefaultErrorListener()}
+
 	// Create the ANTLR input stream.
 	is := antlr.NewInputStream(sql)
 
 	// Create the lexer.
 	lexer := mysql.NewMySqlLexer(is)
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(errs)
 	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
 
 	// Create the parser.
 	parser := mysql.NewMySqlParser(stream)
+	parser.RemoveErrorListeners()
+	parser.AddErrorListener(errs)
 	parser.BuildParseTrees = true
 
 	// Parse the query.
 	tree := parser.Query()
+	if errs.count > 0 {
+		return NewRegexParser().ListTables(sql)
+	}
 
 	// Create the listener and walk the parse tree.
 	listener := NewTableListener()
 	antlr.ParseTreeWalkerDefault.Walk(listener, tree)
 
 	return listener.TableNames, nil
-}
\ No newline at end of file
+}
+
+// syntaxErrorCounter counts syntax errors instead of printing them.
+type syntaxErrorCounter struct {
+	*antlr.DefaultErrorListener
+	count int
+	// first describes the first syntax error.
+	first string
+}
+
+func (c *syntaxErrorCounter) SyntaxError(_ antlr.Recognizer, _ interface{}, line, column int, msg string, _ antlr.RecognitionException) {
+	if c.count == 0 {
+		c.first = fmt.Sprintf("line %d:%d %s", line, column, msg)
+	}
+	c.count++
+}
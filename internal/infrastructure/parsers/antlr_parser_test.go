@@ -30,6 +30,16 @@ func TestAntlrParser_ListTables(t *testing.T) {
 			sql:      "SELECT u.id, o.order_id FROM users JOIN orders ON u.id = o.user_id;",
 			expected: []string{"users", "orders"},
 		},
+		{
+			name:     "aliased tables",
+			sql:      "SELECT * FROM users u JOIN orders o ON u.id = o.user_id;",
+			expected: []string{"users", "orders"},
+		},
+		{
+			name:     "statement outside the grammar",
+			sql:      "UPDATE orders SET total = :p1 WHERE id = :p2",
+			expected: []string{"orders"},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,243 @@
+package parsers
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+	"github.com/turtacn/SQLTraceBench/pkg/utils"
+)
+
+// clickHouseTimeLayout is the text form of DateTime64 values in TSV and JSONEachRow output.
+const clickHouseTimeLayout = "2006-01-02 15:04:05.999999999"
+
+// ClickHouseQueryLogParser parses an export of ClickHouse's system.query_log table, e.g.
+//
+//	SELECT * FROM system.query_log WHERE event_date = today() FORMAT TSVWithNames
+//
+// Both TSVWithNames and JSONEachRow exports are accepted; the format is detected from
+// the first byte of the stream. Only finished or failed initial queries become traces,
+// so QueryStart rows and the secondary queries of distributed tables are skipped.
+type ClickHouseQueryLogParser struct {
+	BufferSize int
+	// Location is the server time zone the event times were written in. Defaults to UTC.
+	Location *time.Location
+	// Stats, when set, counts skipped and defaulted rows and enforces the error threshold.
+	Stats *ParseStats
+}
+
+// NewClickHouseQueryLogParser creates a new ClickHouseQueryLogParser with the given buffer size.
+func NewClickHouseQueryLogParser(bufferSize int) *ClickHouseQueryLogParser {
+	if bufferSize <= 0 {
+		bufferSize = 1024 * 1024 // Default 1MB
+	}
+	return &ClickHouseQueryLogParser{
+		BufferSize: bufferSize,
+		Location:   time.UTC,
+	}
+}
+
+// Parse reads the export and calls the callback for every finished query.
+func (p *ClickHouseQueryLogParser) Parse(reader io.Reader, callback func(models.SQLTrace) error) error {
+	br := bufio.NewReader(reader)
+	for {
+		b, err := br.Peek(1)
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		switch b[0] {
+		case ' ', '\t', '\r', '\n':
+			br.ReadByte()
+			continue
+		case '{':
+			return p.parseRows(br, callback, p.decodeJSONRow)
+		default:
+			return p.parseRows(br, callback, nil)
+		}
+	}
+}
+
+// parseRows scans the export line by line. When decode is nil the first line is
+// treated as the TSVWithNames header and subsequent lines are decoded as TSV.
+func (p *ClickHouseQueryLogParser) parseRows(reader io.Reader, callback func(models.SQLTrace) error, decode func([]byte) (map[string]string, error)) error {
+	scanner := bufio.NewScanner(reader)
+	buf := make([]byte, 64*1024)
+	bufferSize := p.BufferSize
+	if bufferSize == 0 {
+		bufferSize = 1024 * 1024 // Default fallback
+	}
+	scanner.Buffer(buf, bufferSize)
+
+	logger := utils.GetGlobalLogger()
+	lineNum := 0
+	var columns []string
+
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Bytes()
+		if len(strings.TrimSpace(string(line))) == 0 {
+			continue
+		}
+
+		var row map[string]string
+		if decode != nil {
+			r, err := decode(line)
+			if err != nil {
+				logger.Error("Skipped malformed line", utils.Field{Key: "line", Value: lineNum}, utils.Field{Key: "error", Value: err})
+				if err := p.Stats.addSkipped(); err != nil {
+					return fmt.Errorf("line %d: %w", lineNum, err)
+				}
+				continue
+			}
+			row = r
+		} else {
+			fields := strings.Split(string(line), "\t")
+			if columns == nil {
+				columns = fields
+				continue
+			}
+			row = make(map[string]string, len(columns))
+			for i, name := range columns {
+				if i < len(fields) {
+					row[name] = unescapeTSV(fields[i])
+				}
+			}
+		}
+
+		trace, ok := p.toTrace(row)
+		if !ok {
+			continue
+		}
+		if trace.Timestamp.IsZero() {
+			p.Stats.addDefaulted()
+		}
+		p.Stats.addParsed()
+		if err := callback(trace); err != nil {
+			return fmt.Errorf("callback failed at line %d: %w", lineNum, err)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("scanner error: %w", err)
+	}
+	return nil
+}
+
+// decodeJSONRow flattens a JSONEachRow object into strings. 64-bit integers are
+// quoted by default in ClickHouse JSON output, so every value is handled as text.
+func (p *ClickHouseQueryLogParser) decodeJSONRow(line []byte) (map[string]string, error) {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(line, &raw); err != nil {
+		return nil, err
+	}
+	row := make(map[string]string, len(raw))
+	for k, v := range raw {
+		switch val := v.(type) {
+		case string:
+			row[k] = val
+		case float64:
+			row[k] = strconv.FormatFloat(val, 'f', -1, 64)
+		case nil:
+		default:
+			row[k] = fmt.Sprint(val)
+		}
+	}
+	return row, nil
+}
+
+// toTrace converts a query_log row into a SQLTrace. It reports false for rows that
+// are not finished (or failed while processing) initial queries.
+func (p *ClickHouseQueryLogParser) toTrace(row map[string]string) (models.SQLTrace, bool) {
+	switch row["type"] {
+	case "", "QueryFinish", "2", "ExceptionWhileProcessing", "4":
+	default:
+		return models.SQLTrace{}, false
+	}
+	if initial, ok := row["is_initial_query"]; ok && initial == "0" {
+		return models.SQLTrace{}, false
+	}
+	query := strings.TrimSpace(row["query"])
+	if query == "" {
+		return models.SQLTrace{}, false
+	}
+
+	loc := p.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	var ts time.Time
+	for _, col := range []string{"event_time_microseconds", "query_start_time_microseconds", "event_time"} {
+		if v := row[col]; v != "" {
+			if parsed, err := time.ParseInLocation(clickHouseTimeLayout, v, loc); err == nil {
+				ts = parsed
+				break
+			}
+		}
+	}
+
+	durationMs, _ := strconv.ParseFloat(row["query_duration_ms"], 64)
+	readRows, _ := strconv.ParseInt(row["read_rows"], 10, 64)
+	memoryUsage, _ := strconv.ParseInt(row["memory_usage"], 10, 64)
+	writtenRows, _ := strconv.ParseInt(row["written_rows"], 10, 64)
+	errorCode := row["exception_code"]
+	if errorCode == "0" {
+		errorCode = ""
+	}
+
+	return models.SQLTrace{
+		Query:        query,
+		Timestamp:    ts,
+		Latency:      time.Duration(durationMs * float64(time.Millisecond)),
+		User:         row["user"],
+		Host:         row["address"],
+		RowsExamined: readRows,
+		MemoryUsage:  memoryUsage,
+		QueryKind:    row["query_kind"],
+		Database:     row["current_database"],
+		RowsAffected: writtenRows,
+		ErrorCode:    errorCode,
+	}, true
+}
+
+// unescapeTSV reverses the escaping ClickHouse applies to TabSeparated values.
+func unescapeTSV(s string) string {
+	if s == `\N` {
+		return ""
+	}
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n':
+			b.WriteByte('\n')
+		case 't':
+			b.WriteByte('\t')
+		case 'r':
+			b.WriteByte('\r')
+		case '0':
+			b.WriteByte(0)
+		case 'b':
+			b.WriteByte('\b')
+		case 'f':
+			b.WriteByte('\f')
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String()
+}
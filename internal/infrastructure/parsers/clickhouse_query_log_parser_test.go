@@ -0,0 +1,54 @@
+package parsers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClickHouseQueryLogParser_TSVWithNames(t *testing.T) {
+	export := "type\tevent_time_microseconds\tquery_duration_ms\tread_rows\tmemory_usage\tuser\tquery_kind\tis_initial_query\tquery\n" +
+		"QueryStart\t2025-01-01 00:00:00.000001\t0\t0\t0\tdefault\tSelect\t1\tSELECT count() FROM hits\n" +
+		"QueryFinish\t2025-01-01 00:00:00.500001\t125\t1000000\t4194304\tdefault\tSelect\t1\tSELECT count()\\nFROM hits WHERE CounterID = 62\n" +
+		"QueryFinish\t2025-01-01 00:00:00.600000\t10\t500\t1024\tdefault\tSelect\t0\tSELECT count() FROM hits_local\n"
+
+	traces := collectTraces(t, NewClickHouseQueryLogParser(0), export)
+	require.Len(t, traces, 1)
+
+	tr := traces[0]
+	assert.Equal(t, "SELECT count()\nFROM hits WHERE CounterID = 62", tr.Query)
+	assert.Equal(t, time.Date(2025, 1, 1, 0, 0, 0, 500001000, time.UTC), tr.Timestamp)
+	assert.Equal(t, 125*time.Millisecond, tr.Latency)
+	assert.Equal(t, int64(1000000), tr.RowsExamined)
+	assert.Equal(t, int64(4194304), tr.MemoryUsage)
+	assert.Equal(t, "default", tr.User)
+	assert.Equal(t, "Select", tr.QueryKind)
+}
+
+func TestClickHouseQueryLogParser_JSONEachRow(t *testing.T) {
+	export := `{"type":"QueryFinish","event_time_microseconds":"2025-01-01 00:00:01.250000","query_duration_ms":"3","read_rows":"42","memory_usage":"2048","user":"etl","query_kind":"Insert","query":"INSERT INTO events VALUES","current_database":"analytics","written_rows":"42","exception_code":0}
+{"type":"ExceptionWhileProcessing","event_time_microseconds":"2025-01-01 00:00:02.000000","query_duration_ms":"1","user":"etl","query":"SELECT nope","exception_code":47}
+{"type":"ExceptionBeforeStart","event_time_microseconds":"2025-01-01 00:00:03.000000","query_duration_ms":"0","user":"etl","query":"SELEC 1","exception_code":62}
+not json
+`
+	traces := collectTraces(t, NewClickHouseQueryLogParser(0), export)
+	require.Len(t, traces, 2)
+
+	tr := traces[0]
+	assert.Equal(t, "INSERT INTO events VALUES", tr.Query)
+	assert.Equal(t, 3*time.Millisecond, tr.Latency)
+	assert.Equal(t, int64(42), tr.RowsExamined)
+	assert.Equal(t, int64(2048), tr.MemoryUsage)
+	assert.Equal(t, "Insert", tr.QueryKind)
+	assert.Equal(t, time.Date(2025, 1, 1, 0, 0, 1, 250000000, time.UTC), tr.Timestamp)
+	assert.Equal(t, "analytics", tr.Database)
+	assert.Equal(t, int64(42), tr.RowsAffected)
+	assert.False(t, tr.Failed())
+
+	failed := traces[1]
+	assert.Equal(t, "SELECT nope", failed.Query)
+	assert.Equal(t, "47", failed.ErrorCode)
+	assert.True(t, failed.Failed())
+}
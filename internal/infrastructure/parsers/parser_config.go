@@ -0,0 +1,292 @@
+package parsers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultParserConfigPath is the trace parser configuration loaded by the conversion service.
+const DefaultParserConfigPath = "configs/trace_parser.yaml"
+
+// Epoch pseudo-layouts accepted in timestamp_layouts for numeric timestamps.
+const (
+	EpochSeconds      = "epoch_s"
+	EpochMilliseconds = "epoch_ms"
+	EpochMicroseconds = "epoch_us"
+	EpochNanoseconds  = "epoch_ns"
+)
+
+// ParserConfig mirrors the "parser" section of configs/trace_parser.yaml.
+type ParserConfig struct {
+	BufferSize int `yaml:"buffer_size"`
+	// ErrorThreshold aborts parsing once more than this many lines were skipped. Zero disables the limit.
+	ErrorThreshold int `yaml:"error_threshold"`
+	// Sources holds per-format settings keyed by trace format name.
+	Sources map[string]SourceConfig `yaml:"sources"`
+}
+
+// SourceConfig describes how the timestamps and latencies of a trace format are written.
+// Empty fields keep the reader's defaults.
+type SourceConfig struct {
+	TimestampField string `yaml:"timestamp_field"`
+	// TimestampLayouts are Go time layouts or one of the epoch_s/epoch_ms/epoch_us/epoch_ns
+	// pseudo-layouts, tried in order.
+	TimestampLayouts []string `yaml:"timestamp_layouts"`
+	// Timezone is an IANA name applied to timestamps that carry no zone.
+	Timezone     string `yaml:"timezone"`
+	LatencyField string `yaml:"latency_field"`
+	// LatencyUnit is one of ns, us, ms or s.
+	LatencyUnit string `yaml:"latency_unit"`
+	// MySQLPorts and PostgresPorts are the server ports decoded by the pcap reader.
+	MySQLPorts    []int `yaml:"mysql_ports"`
+	PostgresPorts []int `yaml:"postgres_ports"`
+}
+
+// ReaderConfig is handed to TraceFormat.NewReader.
+type ReaderConfig struct {
+	BufferSize       int
+	TimestampField   string
+	TimestampLayouts []string
+	Location         *time.Location
+	LatencyField     string
+	LatencyUnit      time.Duration
+	MySQLPorts       []int
+	PostgresPorts    []int
+	// Stats, when set, collects parse statistics and enforces the error threshold.
+	Stats *ParseStats
+}
+
+// LoadParserConfig reads a trace parser configuration file.
+func LoadParserConfig(path string) (*ParserConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file struct {
+		Parser ParserConfig `yaml:"parser"`
+	}
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	for name, src := range file.Parser.Sources {
+		if _, err := src.resolve(); err != nil {
+			return nil, fmt.Errorf("%s: source %s: %w", path, name, err)
+		}
+	}
+	return &file.Parser, nil
+}
+
+// ReaderConfig returns the reader configuration for a trace format. bufferSize
+// overrides the configured buffer size when positive.
+func (c *ParserConfig) ReaderConfig(format string, bufferSize int) ReaderConfig {
+	rc := ReaderConfig{BufferSize: bufferSize}
+	if c == nil {
+		return rc
+	}
+	if rc.BufferSize <= 0 {
+		rc.BufferSize = c.BufferSize
+	}
+	if src, ok := c.Sources[strings.ToLower(format)]; ok {
+		// Sources are validated by LoadParserConfig.
+		resolved, _ := src.resolve()
+		resolved.BufferSize = rc.BufferSize
+		rc = resolved
+	}
+	return rc
+}
+
+func (s SourceConfig) resolve() (ReaderConfig, error) {
+	rc := ReaderConfig{
+		TimestampField:   s.TimestampField,
+		TimestampLayouts: s.TimestampLayouts,
+		LatencyField:     s.LatencyField,
+		MySQLPorts:       s.MySQLPorts,
+		PostgresPorts:    s.PostgresPorts,
+	}
+	if s.Timezone != "" {
+		loc, err := time.LoadLocation(s.Timezone)
+		if err != nil {
+			return rc, fmt.Errorf("invalid timezone %q: %w", s.Timezone, err)
+		}
+		rc.Location = loc
+	}
+	if s.LatencyUnit != "" {
+		unit, err := parseLatencyUnit(s.LatencyUnit)
+		if err != nil {
+			return rc, err
+		}
+		rc.LatencyUnit = unit
+	}
+	return rc, nil
+}
+
+func parseLatencyUnit(unit string) (time.Duration, error) {
+	switch strings.ToLower(unit) {
+	case "ns":
+		return time.Nanosecond, nil
+	case "us", "µs":
+		return time.Microsecond, nil
+	case "ms":
+		return time.Millisecond, nil
+	case "s":
+		return time.Second, nil
+	default:
+		return 0, fmt.Errorf("invalid latency unit %q (expected ns, us, ms or s)", unit)
+	}
+}
+
+// ParseStats counts what a reader did with its input lines. It is safe for
+// concurrent use, so one instance can be shared by the readers of a TraceSource.
+// A nil *ParseStats ignores all updates.
+type ParseStats struct {
+	// ErrorThreshold aborts parsing once more than this many lines were skipped. Zero disables the limit.
+	ErrorThreshold int64
+
+	parsed    int64
+	skipped   int64
+	defaulted int64
+}
+
+// ErrorThresholdError is returned by a reader once the error threshold is exceeded.
+type ErrorThresholdError struct {
+	Skipped   int64
+	Threshold int64
+}
+
+func (e *ErrorThresholdError) Error() string {
+	return fmt.Sprintf("skipped %d malformed lines, exceeding the error threshold of %d", e.Skipped, e.Threshold)
+}
+
+// Parsed returns the number of traces produced.
+func (s *ParseStats) Parsed() int64 {
+	if s == nil {
+		return 0
+	}
+	return atomic.LoadInt64(&s.parsed)
+}
+
+// Skipped returns the number of lines or records dropped as malformed.
+func (s *ParseStats) Skipped() int64 {
+	if s == nil {
+		return 0
+	}
+	return atomic.LoadInt64(&s.skipped)
+}
+
+// Defaulted returns the number of traces for which a missing or unparsable field
+// was replaced by a default value.
+func (s *ParseStats) Defaulted() int64 {
+	if s == nil {
+		return 0
+	}
+	return atomic.LoadInt64(&s.defaulted)
+}
+
+func (s *ParseStats) addParsed() {
+	if s != nil {
+		atomic.AddInt64(&s.parsed, 1)
+	}
+}
+
+func (s *ParseStats) addDefaulted() {
+	if s != nil {
+		atomic.AddInt64(&s.defaulted, 1)
+	}
+}
+
+// addSkipped records a dropped line and returns an *ErrorThresholdError once the
+// threshold is exceeded.
+func (s *ParseStats) addSkipped() error {
+	if s == nil {
+		return nil
+	}
+	n := atomic.AddInt64(&s.skipped, 1)
+	if s.ErrorThreshold > 0 && n > s.ErrorThreshold {
+		return &ErrorThresholdError{Skipped: n, Threshold: s.ErrorThreshold}
+	}
+	return nil
+}
+
+// parseTimestamp interprets a raw JSON value (string or number) using the given
+// layouts. With no layouts it accepts RFC3339 strings and epoch seconds.
+func parseTimestamp(raw json.RawMessage, layouts []string, loc *time.Location) (time.Time, error) {
+	value := rawString(raw)
+	if value == "" {
+		return time.Time{}, errMissingValue
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	if len(layouts) == 0 {
+		layouts = []string{time.RFC3339Nano, EpochSeconds}
+	}
+	for _, layout := range layouts {
+		if ts, ok := parseTimeLayout(value, layout, loc); ok {
+			return ts, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("timestamp %q does not match layouts %v", value, layouts)
+}
+
+func parseTimeLayout(value, layout string, loc *time.Location) (time.Time, bool) {
+	var unit time.Duration
+	switch layout {
+	case EpochSeconds:
+		unit = time.Second
+	case EpochMilliseconds:
+		unit = time.Millisecond
+	case EpochMicroseconds:
+		unit = time.Microsecond
+	case EpochNanoseconds:
+		unit = time.Nanosecond
+	default:
+		ts, err := time.ParseInLocation(layout, value, loc)
+		return ts, err == nil
+	}
+	if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(0, 0).Add(time.Duration(n) * unit).UTC(), true
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(0, 0).Add(time.Duration(f * float64(unit))).UTC(), true
+}
+
+// parseLatency interprets a raw JSON number (or numeric string) in the given unit.
+func parseLatency(raw json.RawMessage, unit time.Duration) (time.Duration, error) {
+	value := rawString(raw)
+	if value == "" {
+		return 0, errMissingValue
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid latency %q", value)
+	}
+	return time.Duration(f * float64(unit)), nil
+}
+
+// rawString returns the text of a JSON string or the literal of a number.
+// It returns an empty string for missing and null values.
+func rawString(raw json.RawMessage) string {
+	if len(raw) == 0 || string(raw) == "null" {
+		return ""
+	}
+	if raw[0] == '"' {
+		var s string
+		if err := json.Unmarshal(raw, &s); err == nil {
+			return strings.TrimSpace(s)
+		}
+	}
+	return strings.TrimSpace(string(raw))
+}
+
+var errMissingValue = errors.New("missing value")
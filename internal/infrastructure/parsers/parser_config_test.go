@@ -0,0 +1,120 @@
+package parsers
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+)
+
+func TestLoadParserConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trace_parser.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+parser:
+  buffer_size: 2048
+  error_threshold: 5
+  sources:
+    jsonl:
+      timestamp_field: ts
+      timestamp_layouts: [epoch_ms]
+      timezone: Asia/Shanghai
+      latency_field: duration_us
+      latency_unit: us
+`), 0644))
+
+	cfg, err := LoadParserConfig(path)
+	require.NoError(t, err)
+	assert.Equal(t, 2048, cfg.BufferSize)
+	assert.Equal(t, 5, cfg.ErrorThreshold)
+
+	rc := cfg.ReaderConfig("JSONL", 0)
+	assert.Equal(t, 2048, rc.BufferSize)
+	assert.Equal(t, "ts", rc.TimestampField)
+	assert.Equal(t, []string{EpochMilliseconds}, rc.TimestampLayouts)
+	assert.Equal(t, "Asia/Shanghai", rc.Location.String())
+	assert.Equal(t, "duration_us", rc.LatencyField)
+	assert.Equal(t, time.Microsecond, rc.LatencyUnit)
+
+	assert.Equal(t, ReaderConfig{BufferSize: 4096}, cfg.ReaderConfig(TraceFormatSlowLog, 4096))
+
+	var nilCfg *ParserConfig
+	assert.Equal(t, ReaderConfig{BufferSize: 10}, nilCfg.ReaderConfig(TraceFormatJSONL, 10))
+}
+
+func TestLoadParserConfig_Invalid(t *testing.T) {
+	dir := t.TempDir()
+	for name, body := range map[string]string{
+		"unit": "parser:\n  sources:\n    jsonl:\n      latency_unit: minutes\n",
+		"zone": "parser:\n  sources:\n    jsonl:\n      timezone: Mars/Olympus\n",
+	} {
+		path := filepath.Join(dir, name+".yaml")
+		require.NoError(t, os.WriteFile(path, []byte(body), 0644))
+		_, err := LoadParserConfig(path)
+		assert.Error(t, err, name)
+	}
+
+	_, err := LoadParserConfig(filepath.Join(dir, "missing.yaml"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestParseTimestamp(t *testing.T) {
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		raw     string
+		layouts []string
+		loc     *time.Location
+		want    time.Time
+	}{
+		{"rfc3339", `"2025-01-01T00:00:00Z"`, nil, nil, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"default epoch seconds", `1735689600`, nil, nil, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"epoch ms", `1735689600123`, []string{EpochMilliseconds}, nil, time.Date(2025, 1, 1, 0, 0, 0, 123000000, time.UTC)},
+		{"epoch us as string", `"1735689600000005"`, []string{EpochMicroseconds}, nil, time.Date(2025, 1, 1, 0, 0, 0, 5000, time.UTC)},
+		{"epoch ns", `1735689600000000001`, []string{EpochNanoseconds}, nil, time.Date(2025, 1, 1, 0, 0, 0, 1, time.UTC)},
+		{"fractional epoch seconds", `1735689600.5`, []string{EpochSeconds}, nil, time.Date(2025, 1, 1, 0, 0, 0, 500000000, time.UTC)},
+		{"zone-less layout in location", `"2025-01-01 08:00:00"`, []string{"2006-01-02 15:04:05"}, shanghai, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"second layout", `"01/02/2025 00:00"`, []string{time.RFC3339, "01/02/2006 15:04"}, nil, time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseTimestamp([]byte(tt.raw), tt.layouts, tt.loc)
+			require.NoError(t, err)
+			assert.True(t, tt.want.Equal(got), "got %v, want %v", got, tt.want)
+		})
+	}
+
+	_, err = parseTimestamp(nil, nil, nil)
+	assert.Equal(t, errMissingValue, err)
+	_, err = parseTimestamp([]byte(`"yesterday"`), nil, nil)
+	assert.Error(t, err)
+}
+
+func TestParseStats_ErrorThreshold(t *testing.T) {
+	stats := &ParseStats{ErrorThreshold: 2}
+	jsonl := "bad\n" + `{"query":"SELECT 1","timestamp":"2025-01-01T00:00:00Z"}` + "\nbad\nbad\n" +
+		`{"query":"SELECT 2","timestamp":"2025-01-01T00:00:01Z"}` + "\n"
+
+	p := NewStreamingTraceParser(0)
+	p.Stats = stats
+	var count int
+	err := p.Parse(strings.NewReader(jsonl), func(models.SQLTrace) error {
+		count++
+		return nil
+	})
+
+	var thresholdErr *ErrorThresholdError
+	require.True(t, errors.As(err, &thresholdErr), "got %v", err)
+	assert.Equal(t, int64(3), thresholdErr.Skipped)
+	assert.Contains(t, err.Error(), "line 4")
+	assert.Equal(t, 1, count)
+	assert.Equal(t, int64(1), stats.Parsed())
+	assert.Equal(t, int64(3), stats.Skipped())
+}
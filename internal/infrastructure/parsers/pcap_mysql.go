@@ -0,0 +1,583 @@
+package parsers
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/turtacn/SQLTraceBench/pkg/utils"
+)
+
+// MySQL client/server protocol constants.
+const (
+	mysqlComQuit        = 0x01
+	mysqlComInitDB      = 0x02
+	mysqlComQuery       = 0x03
+	mysqlComStmtPrepare = 0x16
+	mysqlComStmtExecute = 0x17
+	mysqlComStmtLong    = 0x18
+	mysqlComStmtClose   = 0x19
+
+	mysqlClientConnectWithDB    = 1 << 3
+	mysqlClientCompress         = 1 << 5
+	mysqlClientSSL              = 1 << 11
+	mysqlClientSecureConnection = 1 << 15
+	mysqlClientPluginAuthLenenc = 1 << 21
+	mysqlClientQueryAttributes  = 1 << 27
+
+	mysqlMaxPacket = 0xffffff
+)
+
+// MySQL column types used in the binary protocol.
+const (
+	mysqlTypeTiny      = 0x01
+	mysqlTypeShort     = 0x02
+	mysqlTypeLong      = 0x03
+	mysqlTypeFloat     = 0x04
+	mysqlTypeDouble    = 0x05
+	mysqlTypeNull      = 0x06
+	mysqlTypeTimestamp = 0x07
+	mysqlTypeLongLong  = 0x08
+	mysqlTypeInt24     = 0x09
+	mysqlTypeDate      = 0x0a
+	mysqlTypeTime      = 0x0b
+	mysqlTypeDateTime  = 0x0c
+	mysqlTypeYear      = 0x0d
+)
+
+// mysqlStatement is a server-side prepared statement.
+type mysqlStatement struct {
+	query   string
+	nparams int
+	// types are the parameter types of the last execute that sent them; later
+	// executes may omit them.
+	types []uint16
+}
+
+// mysqlWireDecoder decodes one MySQL connection.
+type mysqlWireDecoder struct {
+	conn         *wireConn
+	client       streamBuffer
+	server       streamBuffer
+	handshake    bool
+	capabilities uint32
+	user         string
+	database     string
+	ignored      bool
+	pending      []*pendingRequest
+	// prepares holds the query of each in-flight COM_STMT_PREPARE, in request order.
+	prepares   []string
+	statements map[uint32]*mysqlStatement
+	// unknownExecutes counts executes of statements prepared before the capture started.
+	unknownExecutes int
+}
+
+func newMySQLWireDecoder(conn *wireConn) *mysqlWireDecoder {
+	return &mysqlWireDecoder{conn: conn, statements: make(map[uint32]*mysqlStatement)}
+}
+
+func (d *mysqlWireDecoder) feed(fromClient bool, data []byte, t time.Time) {
+	if d.ignored {
+		return
+	}
+	buf := &d.server
+	if fromClient {
+		buf = &d.client
+	}
+	buf.append(data, t)
+	for !d.ignored {
+		payload, seq, start, n, ok := d.nextPacket(buf)
+		if !ok {
+			return
+		}
+		buf.consume(n)
+		if fromClient {
+			d.clientPacket(payload, seq, start)
+		} else {
+			d.serverPacket(payload, seq, start)
+		}
+	}
+}
+
+// nextPacket returns the next complete packet, joining payloads split across
+// 16MB packets. n is the number of bytes it occupies in the buffer.
+func (d *mysqlWireDecoder) nextPacket(buf *streamBuffer) (payload []byte, seq byte, start time.Time, n int, ok bool) {
+	data := buf.data
+	for {
+		if len(data)-n < 4 {
+			return nil, 0, time.Time{}, 0, false
+		}
+		length := int(data[n]) | int(data[n+1])<<8 | int(data[n+2])<<16
+		if len(data)-n-4 < length {
+			return nil, 0, time.Time{}, 0, false
+		}
+		if n == 0 {
+			seq = data[3]
+			start = buf.timeAt(0)
+		}
+		payload = append(payload, data[n+4:n+4+length]...)
+		n += 4 + length
+		if length < mysqlMaxPacket {
+			return payload, seq, start, n, true
+		}
+	}
+}
+
+func (d *mysqlWireDecoder) clientPacket(payload []byte, seq byte, t time.Time) {
+	if seq != 0 {
+		// Connection phase: the handshake response answers the server greeting.
+		if seq == 1 && !d.handshake {
+			d.handshake = true
+			d.parseHandshakeResponse(payload)
+		}
+		return
+	}
+	d.handshake = true
+	if len(payload) == 0 {
+		return
+	}
+
+	switch payload[0] {
+	case mysqlComQuery:
+		query, err := d.parseQuery(payload[1:])
+		if err != nil {
+			d.malformed("COM_QUERY", err)
+			d.push(nil)
+			return
+		}
+		d.push(&pendingRequest{trace: d.conn.newTrace(query, t), kind: mysqlComQuery})
+	case mysqlComStmtPrepare:
+		d.prepares = append(d.prepares, string(payload[1:]))
+		d.push(&pendingRequest{kind: mysqlComStmtPrepare})
+	case mysqlComStmtExecute:
+		req, err := d.parseExecute(payload[1:], t)
+		if err != nil {
+			d.malformed("COM_STMT_EXECUTE", err)
+		}
+		d.push(req)
+	case mysqlComInitDB:
+		d.database = string(payload[1:])
+		d.push(nil)
+	case mysqlComStmtClose:
+		if len(payload) >= 5 {
+			delete(d.statements, binary.LittleEndian.Uint32(payload[1:5]))
+		}
+	case mysqlComQuit, mysqlComStmtLong:
+		// No response.
+	default:
+		d.push(nil)
+	}
+}
+
+// push queues a request awaiting its response. A nil request is awaited but produces
+// no trace.
+func (d *mysqlWireDecoder) push(req *pendingRequest) {
+	if req == nil {
+		req = &pendingRequest{}
+	} else if req.kind != mysqlComStmtPrepare {
+		req.trace.User = d.user
+		req.trace.Database = d.database
+	}
+	d.pending = append(d.pending, req)
+}
+
+func (d *mysqlWireDecoder) serverPacket(payload []byte, seq byte, t time.Time) {
+	// Every response starts with sequence id 1; later packets of a result set
+	// continue the sequence.
+	if seq != 1 || len(d.pending) == 0 || len(payload) == 0 {
+		return
+	}
+	req := d.pending[0]
+	d.pending = d.pending[1:]
+
+	switch req.kind {
+	case mysqlComStmtPrepare:
+		query := d.prepares[0]
+		d.prepares = d.prepares[1:]
+		if payload[0] == 0x00 && len(payload) >= 9 {
+			id := binary.LittleEndian.Uint32(payload[1:5])
+			d.statements[id] = &mysqlStatement{
+				query:   rewriteQuestionPlaceholders(query),
+				nparams: int(binary.LittleEndian.Uint16(payload[7:9])),
+			}
+		}
+	case mysqlComQuery, mysqlComStmtExecute:
+		switch payload[0] {
+		case 0x00: // OK
+			if rows, _, ok := readLenencInt(payload[1:]); ok {
+				req.trace.RowsAffected = int64(rows)
+			}
+		case 0xff: // ERR
+			if len(payload) >= 3 {
+				req.trace.ErrorCode = strconv.Itoa(int(binary.LittleEndian.Uint16(payload[1:3])))
+			}
+		}
+		d.conn.emit(req.finish(t))
+	}
+}
+
+// parseHandshakeResponse reads the user, default database and capabilities of the
+// connection. Encrypted and compressed connections cannot be decoded.
+func (d *mysqlWireDecoder) parseHandshakeResponse(payload []byte) {
+	if len(payload) < 32 {
+		return
+	}
+	d.capabilities = binary.LittleEndian.Uint32(payload[0:4])
+	if d.capabilities&(mysqlClientSSL|mysqlClientCompress) != 0 {
+		utils.GetGlobalLogger().Warn("Skipped encrypted or compressed MySQL connection",
+			utils.Field{Key: "client", Value: d.conn.client.String()})
+		d.ignore()
+		return
+	}
+	rest := payload[32:]
+	user, rest, ok := readNullTerminated(rest)
+	if !ok {
+		return
+	}
+	d.user = user
+	switch {
+	case d.capabilities&mysqlClientPluginAuthLenenc != 0:
+		length, n, ok := readLenencInt(rest)
+		if !ok || uint64(len(rest)-n) < length {
+			return
+		}
+		rest = rest[n+int(length):]
+	case d.capabilities&mysqlClientSecureConnection != 0:
+		if len(rest) < 1 || len(rest) < 1+int(rest[0]) {
+			return
+		}
+		rest = rest[1+int(rest[0]):]
+	default:
+		if _, rest, ok = readNullTerminated(rest); !ok {
+			return
+		}
+	}
+	if d.capabilities&mysqlClientConnectWithDB != 0 {
+		if db, _, ok := readNullTerminated(rest); ok {
+			d.database = db
+		}
+	}
+}
+
+// parseQuery reads the query text of a COM_QUERY body, skipping the query
+// attributes the client may send before it.
+func (d *mysqlWireDecoder) parseQuery(body []byte) (string, error) {
+	if d.capabilities&mysqlClientQueryAttributes == 0 {
+		return string(body), nil
+	}
+	count, n, ok := readLenencInt(body)
+	if !ok {
+		return "", errShortPacket
+	}
+	body = body[n:]
+	if _, n, ok = readLenencInt(body); !ok { // parameter_set_count
+		return "", errShortPacket
+	}
+	body = body[n:]
+	if count == 0 {
+		return string(body), nil
+	}
+	_, rest, err := readMySQLBinaryParams(body, int(count), nil, true)
+	if err != nil {
+		return "", err
+	}
+	return string(rest), nil
+}
+
+// parseExecute decodes a COM_STMT_EXECUTE body into a trace of the prepared query
+// with its bound parameters.
+func (d *mysqlWireDecoder) parseExecute(body []byte, t time.Time) (*pendingRequest, error) {
+	if len(body) < 9 {
+		return nil, errShortPacket
+	}
+	id := binary.LittleEndian.Uint32(body[0:4])
+	flags := body[4]
+	body = body[9:] // statement id, flags, iteration count
+	stmt, ok := d.statements[id]
+	if !ok {
+		d.unknownExecutes++
+		return nil, nil
+	}
+
+	nparams := stmt.nparams
+	withNames := d.capabilities&mysqlClientQueryAttributes != 0
+	if withNames && (nparams > 0 || flags&0x08 != 0) {
+		count, n, ok := readLenencInt(body)
+		if !ok {
+			return nil, errShortPacket
+		}
+		nparams, body = int(count), body[n:]
+	}
+
+	trace := d.conn.newTrace(stmt.query, t)
+	if nparams > 0 {
+		values, _, err := readMySQLBinaryParams(body, nparams, stmt, withNames)
+		if err != nil {
+			return nil, err
+		}
+		trace.Parameters = values
+	}
+	return &pendingRequest{trace: trace, kind: mysqlComStmtExecute}, nil
+}
+
+// readMySQLBinaryParams reads the null bitmap, optional types and binary values of
+// n parameters, keyed :p1..:pN. When stmt is given, types sent by the client are
+// remembered for later executes.
+func readMySQLBinaryParams(body []byte, n int, stmt *mysqlStatement, withNames bool) (map[string]interface{}, []byte, error) {
+	bitmapLen := (n + 7) / 8
+	if len(body) < bitmapLen+1 {
+		return nil, nil, errShortPacket
+	}
+	nulls := body[:bitmapLen]
+	newBound := body[bitmapLen] == 1
+	body = body[bitmapLen+1:]
+
+	var types []uint16
+	if newBound {
+		types = make([]uint16, n)
+		for i := 0; i < n; i++ {
+			if len(body) < 2 {
+				return nil, nil, errShortPacket
+			}
+			types[i], body = binary.LittleEndian.Uint16(body[0:2]), body[2:]
+			if withNames {
+				_, rest, ok := readLenencString(body)
+				if !ok {
+					return nil, nil, errShortPacket
+				}
+				body = rest
+			}
+		}
+		if stmt != nil {
+			stmt.types = types
+		}
+	} else if stmt != nil {
+		types = stmt.types
+	}
+	if len(types) != n {
+		return nil, nil, fmt.Errorf("parameter types of %d parameters are unknown", n)
+	}
+
+	params := make(map[string]interface{}, n)
+	for i := 0; i < n; i++ {
+		name := ":p" + strconv.Itoa(i+1)
+		if nulls[i/8]&(1<<(i%8)) != 0 {
+			params[name] = nil
+			continue
+		}
+		value, rest, err := readMySQLBinaryValue(body, types[i])
+		if err != nil {
+			return nil, nil, fmt.Errorf("parameter %d: %w", i+1, err)
+		}
+		params[name], body = value, rest
+	}
+	return params, body, nil
+}
+
+// readMySQLBinaryValue decodes one value of the binary protocol. The high byte of t
+// holds the unsigned flag.
+func readMySQLBinaryValue(b []byte, t uint16) (interface{}, []byte, error) {
+	unsigned := t&0x8000 != 0
+	fixed := func(size int) ([]byte, []byte, error) {
+		if len(b) < size {
+			return nil, nil, errShortPacket
+		}
+		return b[:size], b[size:], nil
+	}
+	switch byte(t) {
+	case mysqlTypeNull:
+		return nil, b, nil
+	case mysqlTypeTiny:
+		v, rest, err := fixed(1)
+		if err != nil {
+			return nil, nil, err
+		}
+		if unsigned {
+			return int64(v[0]), rest, nil
+		}
+		return int64(int8(v[0])), rest, nil
+	case mysqlTypeShort, mysqlTypeYear:
+		v, rest, err := fixed(2)
+		if err != nil {
+			return nil, nil, err
+		}
+		if unsigned {
+			return int64(binary.LittleEndian.Uint16(v)), rest, nil
+		}
+		return int64(int16(binary.LittleEndian.Uint16(v))), rest, nil
+	case mysqlTypeLong, mysqlTypeInt24:
+		v, rest, err := fixed(4)
+		if err != nil {
+			return nil, nil, err
+		}
+		if unsigned {
+			return int64(binary.LittleEndian.Uint32(v)), rest, nil
+		}
+		return int64(int32(binary.LittleEndian.Uint32(v))), rest, nil
+	case mysqlTypeLongLong:
+		v, rest, err := fixed(8)
+		if err != nil {
+			return nil, nil, err
+		}
+		u := binary.LittleEndian.Uint64(v)
+		if unsigned && u > math.MaxInt64 {
+			return u, rest, nil
+		}
+		return int64(u), rest, nil
+	case mysqlTypeFloat:
+		v, rest, err := fixed(4)
+		if err != nil {
+			return nil, nil, err
+		}
+		return float64(math.Float32frombits(binary.LittleEndian.Uint32(v))), rest, nil
+	case mysqlTypeDouble:
+		v, rest, err := fixed(8)
+		if err != nil {
+			return nil, nil, err
+		}
+		return math.Float64frombits(binary.LittleEndian.Uint64(v)), rest, nil
+	case mysqlTypeDate, mysqlTypeDateTime, mysqlTypeTimestamp:
+		if len(b) < 1 || len(b) < 1+int(b[0]) {
+			return nil, nil, errShortPacket
+		}
+		v, rest := b[1:1+int(b[0])], b[1+int(b[0]):]
+		return formatMySQLDateTime(v, byte(t) == mysqlTypeDate), rest, nil
+	case mysqlTypeTime:
+		if len(b) < 1 || len(b) < 1+int(b[0]) {
+			return nil, nil, errShortPacket
+		}
+		v, rest := b[1:1+int(b[0])], b[1+int(b[0]):]
+		return formatMySQLTime(v), rest, nil
+	default:
+		s, rest, ok := readLenencString(b)
+		if !ok {
+			return nil, nil, errShortPacket
+		}
+		return s, rest, nil
+	}
+}
+
+func formatMySQLDateTime(v []byte, dateOnly bool) string {
+	var year, month, day, hour, minute, second, micros int
+	if len(v) >= 4 {
+		year, month, day = int(binary.LittleEndian.Uint16(v[0:2])), int(v[2]), int(v[3])
+	}
+	if len(v) >= 7 {
+		hour, minute, second = int(v[4]), int(v[5]), int(v[6])
+	}
+	if len(v) >= 11 {
+		micros = int(binary.LittleEndian.Uint32(v[7:11]))
+	}
+	if dateOnly {
+		return fmt.Sprintf("%04d-%02d-%02d", year, month, day)
+	}
+	s := fmt.Sprintf("%04d-%02d-%02d %02d:%02d:%02d", year, month, day, hour, minute, second)
+	if micros > 0 {
+		s += fmt.Sprintf(".%06d", micros)
+	}
+	return s
+}
+
+func formatMySQLTime(v []byte) string {
+	var negative bool
+	var days, hour, minute, second, micros int
+	if len(v) >= 8 {
+		negative = v[0] == 1
+		days = int(binary.LittleEndian.Uint32(v[1:5]))
+		hour, minute, second = int(v[5]), int(v[6]), int(v[7])
+	}
+	if len(v) >= 12 {
+		micros = int(binary.LittleEndian.Uint32(v[8:12]))
+	}
+	s := fmt.Sprintf("%02d:%02d:%02d", days*24+hour, minute, second)
+	if micros > 0 {
+		s += fmt.Sprintf(".%06d", micros)
+	}
+	if negative {
+		s = "-" + s
+	}
+	return s
+}
+
+func (d *mysqlWireDecoder) malformed(command string, err error) {
+	utils.GetGlobalLogger().Error("Skipped malformed MySQL command",
+		utils.Field{Key: "command", Value: command},
+		utils.Field{Key: "client", Value: d.conn.client.String()},
+		utils.Field{Key: "error", Value: err})
+}
+
+// ignore stops decoding the connection.
+func (d *mysqlWireDecoder) ignore() {
+	d.ignored = true
+	d.pending = nil
+	d.client, d.server = streamBuffer{}, streamBuffer{}
+}
+
+func (d *mysqlWireDecoder) oldestPending() time.Time {
+	for _, req := range d.pending {
+		if req.kind != 0 && req.kind != mysqlComStmtPrepare {
+			return req.trace.Timestamp
+		}
+	}
+	return time.Time{}
+}
+
+func (d *mysqlWireDecoder) close() {
+	for _, req := range d.pending {
+		if req.kind == mysqlComQuery || req.kind == mysqlComStmtExecute {
+			d.conn.emit(req.finish(time.Time{}))
+		}
+	}
+	d.pending = nil
+	if d.unknownExecutes > 0 {
+		utils.GetGlobalLogger().Warn("Skipped executions of statements prepared before the capture started",
+			utils.Field{Key: "client", Value: d.conn.client.String()},
+			utils.Field{Key: "count", Value: d.unknownExecutes})
+	}
+}
+
+// readLenencInt reads a MySQL length-encoded integer and returns it with its size.
+func readLenencInt(b []byte) (uint64, int, bool) {
+	if len(b) == 0 {
+		return 0, 0, false
+	}
+	switch b[0] {
+	case 0xfc:
+		if len(b) < 3 {
+			return 0, 0, false
+		}
+		return uint64(binary.LittleEndian.Uint16(b[1:3])), 3, true
+	case 0xfd:
+		if len(b) < 4 {
+			return 0, 0, false
+		}
+		return uint64(b[1]) | uint64(b[2])<<8 | uint64(b[3])<<16, 4, true
+	case 0xfe:
+		if len(b) < 9 {
+			return 0, 0, false
+		}
+		return binary.LittleEndian.Uint64(b[1:9]), 9, true
+	case 0xfb, 0xff:
+		return 0, 0, false
+	}
+	return uint64(b[0]), 1, true
+}
+
+func readLenencString(b []byte) (string, []byte, bool) {
+	length, n, ok := readLenencInt(b)
+	if !ok || uint64(len(b)-n) < length {
+		return "", nil, false
+	}
+	return string(b[n : n+int(length)]), b[n+int(length):], true
+}
+
+func readNullTerminated(b []byte) (string, []byte, bool) {
+	for i, c := range b {
+		if c == 0 {
+			return string(b[:i]), b[i+1:], true
+		}
+	}
+	return "", nil, false
+}
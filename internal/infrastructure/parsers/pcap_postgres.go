@@ -0,0 +1,486 @@
+package parsers
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/turtacn/SQLTraceBench/pkg/utils"
+)
+
+// PostgreSQL frontend/backend protocol constants.
+const (
+	pgProtocolVersion3 = 196608
+	pgCancelRequest    = 80877102
+	pgSSLRequest       = 80877103
+	pgGSSENCRequest    = 80877104
+
+	pgMaxMessage = 1 << 30
+)
+
+// PostgreSQL type OIDs decoded from binary parameters.
+const (
+	pgOidBool   = 16
+	pgOidInt8   = 20
+	pgOidInt2   = 21
+	pgOidInt4   = 23
+	pgOidFloat4 = 700
+	pgOidFloat8 = 701
+)
+
+// Kinds of pending PostgreSQL requests.
+const (
+	pgRequestQuery   = 'Q' // simple query, completed by ReadyForQuery
+	pgRequestExecute = 'E' // Execute of a bound portal, completed by CommandComplete
+	pgRequestSync    = 'S' // Sync, ends an extended query batch
+)
+
+// pgStatement is a parsed statement and the parameter types it declared.
+type pgStatement struct {
+	query string
+	oids  []uint32
+}
+
+// pgPortal is a statement bound to its parameters.
+type pgPortal struct {
+	query  string
+	params map[string]interface{}
+}
+
+// postgresWireDecoder decodes one PostgreSQL connection.
+type postgresWireDecoder struct {
+	conn     *wireConn
+	client   streamBuffer
+	server   streamBuffer
+	started  bool
+	awaitTLS bool
+	ignored  bool
+	user     string
+	database string
+	// pending holds the requests awaiting a response. Requests without a query
+	// (Sync, or executes of unknown statements) produce no trace.
+	pending    []*pendingRequest
+	statements map[string]*pgStatement
+	portals    map[string]*pgPortal
+	// unknownPortals counts executes of statements parsed before the capture started.
+	unknownPortals int
+}
+
+func newPostgresWireDecoder(conn *wireConn) *postgresWireDecoder {
+	return &postgresWireDecoder{
+		conn:       conn,
+		statements: make(map[string]*pgStatement),
+		portals:    make(map[string]*pgPortal),
+	}
+}
+
+func (d *postgresWireDecoder) feed(fromClient bool, data []byte, t time.Time) {
+	if d.ignored {
+		return
+	}
+	if fromClient {
+		d.client.append(data, t)
+		d.clientMessages()
+	} else {
+		d.server.append(data, t)
+		d.serverMessages()
+	}
+}
+
+func (d *postgresWireDecoder) clientMessages() {
+	buf := &d.client
+	for !d.ignored && len(buf.data) > 0 {
+		// Startup-phase messages carry no type byte. Their length prefix starts with a
+		// zero byte, which no message type does.
+		if !d.started && buf.data[0] == 0 {
+			if len(buf.data) < 8 {
+				return
+			}
+			length := int(binary.BigEndian.Uint32(buf.data[0:4]))
+			if length < 8 || length > pgMaxMessage {
+				d.ignore("invalid startup message")
+				return
+			}
+			if len(buf.data) < length {
+				return
+			}
+			body := buf.data[4:length]
+			buf.consume(length)
+			d.startupMessage(body)
+			continue
+		}
+		d.started = true
+
+		if len(buf.data) < 5 {
+			return
+		}
+		length := int(binary.BigEndian.Uint32(buf.data[1:5]))
+		if length < 4 || length > pgMaxMessage {
+			d.ignore("invalid message length")
+			return
+		}
+		if len(buf.data) < 1+length {
+			return
+		}
+		typ, body, t := buf.data[0], buf.data[5:1+length], buf.timeAt(0)
+		buf.consume(1 + length)
+		d.clientMessage(typ, body, t)
+	}
+}
+
+func (d *postgresWireDecoder) startupMessage(body []byte) {
+	switch binary.BigEndian.Uint32(body[0:4]) {
+	case pgSSLRequest, pgGSSENCRequest:
+		d.awaitTLS = true
+	case pgCancelRequest:
+		// A cancel request connection carries no queries.
+	case pgProtocolVersion3:
+		d.started = true
+		params := body[4:]
+		for {
+			key, rest, ok := readNullTerminated(params)
+			if !ok || key == "" {
+				break
+			}
+			value, rest, ok := readNullTerminated(rest)
+			if !ok {
+				break
+			}
+			switch key {
+			case "user":
+				d.user = value
+			case "database":
+				d.database = value
+			}
+			params = rest
+		}
+		if d.database == "" {
+			d.database = d.user
+		}
+	default:
+		d.ignore("unsupported protocol version")
+	}
+}
+
+func (d *postgresWireDecoder) clientMessage(typ byte, body []byte, t time.Time) {
+	switch typ {
+	case 'Q':
+		query, _, _ := readNullTerminated(body)
+		trace := d.conn.newTrace(query, t)
+		trace.User, trace.Database = d.user, d.database
+		d.pending = append(d.pending, &pendingRequest{trace: trace, kind: pgRequestQuery})
+	case 'P':
+		name, rest, ok := readNullTerminated(body)
+		if !ok {
+			d.malformed("Parse")
+			return
+		}
+		query, rest, ok := readNullTerminated(rest)
+		if !ok || len(rest) < 2 {
+			d.malformed("Parse")
+			return
+		}
+		stmt := &pgStatement{query: RewritePgPlaceholders(query)}
+		n := int(binary.BigEndian.Uint16(rest[0:2]))
+		for i, rest := 0, rest[2:]; i < n && len(rest) >= 4; i, rest = i+1, rest[4:] {
+			stmt.oids = append(stmt.oids, binary.BigEndian.Uint32(rest[0:4]))
+		}
+		d.statements[name] = stmt
+	case 'B':
+		if !d.parseBind(body) {
+			d.malformed("Bind")
+		}
+	case 'E':
+		name, _, _ := readNullTerminated(body)
+		portal, ok := d.portals[name]
+		if !ok {
+			d.unknownPortals++
+			d.pending = append(d.pending, &pendingRequest{kind: pgRequestExecute})
+			return
+		}
+		trace := d.conn.newTrace(portal.query, t)
+		trace.User, trace.Database = d.user, d.database
+		trace.Parameters = portal.params
+		d.pending = append(d.pending, &pendingRequest{trace: trace, kind: pgRequestExecute})
+	case 'S':
+		d.pending = append(d.pending, &pendingRequest{kind: pgRequestSync})
+	case 'C':
+		if len(body) < 1 {
+			return
+		}
+		name, _, _ := readNullTerminated(body[1:])
+		if body[0] == 'S' {
+			delete(d.statements, name)
+		} else {
+			delete(d.portals, name)
+		}
+	}
+}
+
+// parseBind reads a Bind message and stores the resulting portal.
+func (d *postgresWireDecoder) parseBind(body []byte) bool {
+	portalName, rest, ok := readNullTerminated(body)
+	if !ok {
+		return false
+	}
+	stmtName, rest, ok := readNullTerminated(rest)
+	if !ok || len(rest) < 2 {
+		return false
+	}
+	stmt, known := d.statements[stmtName]
+
+	nformats := int(binary.BigEndian.Uint16(rest[0:2]))
+	rest = rest[2:]
+	if len(rest) < 2*nformats+2 {
+		return false
+	}
+	formats := make([]uint16, nformats)
+	for i := range formats {
+		formats[i] = binary.BigEndian.Uint16(rest[2*i:])
+	}
+	rest = rest[2*nformats:]
+	nparams := int(binary.BigEndian.Uint16(rest[0:2]))
+	rest = rest[2:]
+
+	params := make(map[string]interface{}, nparams)
+	for i := 0; i < nparams; i++ {
+		if len(rest) < 4 {
+			return false
+		}
+		length := int32(binary.BigEndian.Uint32(rest[0:4]))
+		rest = rest[4:]
+		name := ":p" + strconv.Itoa(i+1)
+		if length < 0 {
+			params[name] = nil
+			continue
+		}
+		if len(rest) < int(length) {
+			return false
+		}
+		value := rest[:length]
+		rest = rest[length:]
+
+		format := uint16(0)
+		switch {
+		case nformats == 1:
+			format = formats[0]
+		case i < nformats:
+			format = formats[i]
+		}
+		if format == 0 {
+			params[name] = string(value)
+			continue
+		}
+		var oid uint32
+		if known && i < len(stmt.oids) {
+			oid = stmt.oids[i]
+		}
+		params[name] = decodePgBinary(value, oid)
+	}
+
+	if !known {
+		// The statement was parsed before the capture started.
+		delete(d.portals, portalName)
+		return true
+	}
+	portal := &pgPortal{query: stmt.query}
+	if nparams > 0 {
+		portal.params = params
+	}
+	d.portals[portalName] = portal
+	return true
+}
+
+// decodePgBinary decodes a binary-format parameter of the given type. Values of
+// other types are kept as text, or hex-encoded when they are not valid UTF-8.
+func decodePgBinary(v []byte, oid uint32) interface{} {
+	switch {
+	case oid == pgOidBool && len(v) == 1:
+		return v[0] != 0
+	case oid == pgOidInt2 && len(v) == 2:
+		return int64(int16(binary.BigEndian.Uint16(v)))
+	case oid == pgOidInt4 && len(v) == 4:
+		return int64(int32(binary.BigEndian.Uint32(v)))
+	case oid == pgOidInt8 && len(v) == 8:
+		return int64(binary.BigEndian.Uint64(v))
+	case oid == pgOidFloat4 && len(v) == 4:
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(v)))
+	case oid == pgOidFloat8 && len(v) == 8:
+		return math.Float64frombits(binary.BigEndian.Uint64(v))
+	}
+	if utf8.Valid(v) {
+		return string(v)
+	}
+	return `\x` + hex.EncodeToString(v)
+}
+
+func (d *postgresWireDecoder) serverMessages() {
+	buf := &d.server
+	for !d.ignored && len(buf.data) > 0 {
+		if d.awaitTLS {
+			// The server answers SSLRequest and GSSENCRequest with a single byte.
+			d.awaitTLS = false
+			if buf.data[0] != 'N' {
+				utils.GetGlobalLogger().Warn("Skipped encrypted PostgreSQL connection",
+					utils.Field{Key: "client", Value: d.conn.client.String()})
+				d.ignore("")
+				return
+			}
+			buf.consume(1)
+			continue
+		}
+		if len(buf.data) < 5 {
+			return
+		}
+		length := int(binary.BigEndian.Uint32(buf.data[1:5]))
+		if length < 4 || length > pgMaxMessage {
+			d.ignore("invalid message length")
+			return
+		}
+		if len(buf.data) < 1+length {
+			return
+		}
+		typ, body, t := buf.data[0], buf.data[5:1+length], buf.timeAt(0)
+		buf.consume(1 + length)
+		d.serverMessage(typ, body, t)
+	}
+}
+
+func (d *postgresWireDecoder) serverMessage(typ byte, body []byte, t time.Time) {
+	switch typ {
+	case 'A', 'S', 'N', 'K', 'R':
+		// Asynchronous and authentication messages do not answer a request.
+		return
+	}
+	if len(d.pending) == 0 {
+		return
+	}
+	head := d.pending[0]
+	if head.kind != pgRequestSync && head.firstResp.IsZero() {
+		head.firstResp = t
+	}
+
+	switch typ {
+	case 'C': // CommandComplete
+		tag, _, _ := readNullTerminated(body)
+		if rows, ok := pgAffectedRows(tag); ok && head.kind != pgRequestSync {
+			head.trace.RowsAffected = rows
+		}
+		if head.kind == pgRequestExecute {
+			d.complete()
+		}
+	case 'E': // ErrorResponse
+		if head.kind != pgRequestSync {
+			head.trace.ErrorCode = pgErrorCode(body)
+		}
+		if head.kind == pgRequestExecute {
+			// The server skips the rest of the batch up to Sync.
+			d.complete()
+		}
+	case 'I', 's': // EmptyQueryResponse, PortalSuspended
+		if head.kind == pgRequestExecute {
+			d.complete()
+		}
+	case 'Z': // ReadyForQuery
+		// Completes a simple query, or ends the batch: executes not answered yet
+		// were skipped after an error.
+		for len(d.pending) > 0 {
+			req := d.pending[0]
+			d.pending = d.pending[1:]
+			if req.kind == pgRequestQuery {
+				if req.trace.Query != "" {
+					d.conn.emit(req.finish(req.firstResp))
+				}
+				break
+			}
+			if req.kind == pgRequestSync {
+				break
+			}
+		}
+	}
+}
+
+// complete emits the trace of the request at the head of the queue.
+func (d *postgresWireDecoder) complete() {
+	req := d.pending[0]
+	d.pending = d.pending[1:]
+	if req.trace.Query != "" {
+		d.conn.emit(req.finish(req.firstResp))
+	}
+}
+
+// pgAffectedRows returns the row count of an INSERT, UPDATE, DELETE, MERGE or COPY
+// command tag.
+func pgAffectedRows(tag string) (int64, bool) {
+	fields := strings.Fields(tag)
+	if len(fields) < 2 {
+		return 0, false
+	}
+	switch fields[0] {
+	case "INSERT", "UPDATE", "DELETE", "MERGE", "COPY":
+		rows, err := strconv.ParseInt(fields[len(fields)-1], 10, 64)
+		return rows, err == nil
+	}
+	return 0, false
+}
+
+// pgErrorCode returns the SQLSTATE field of an ErrorResponse.
+func pgErrorCode(body []byte) string {
+	for len(body) > 0 && body[0] != 0 {
+		field := body[0]
+		value, rest, ok := readNullTerminated(body[1:])
+		if !ok {
+			break
+		}
+		if field == 'C' {
+			return value
+		}
+		body = rest
+	}
+	return "ERROR"
+}
+
+func (d *postgresWireDecoder) malformed(message string) {
+	utils.GetGlobalLogger().Error("Skipped malformed PostgreSQL message",
+		utils.Field{Key: "message", Value: message},
+		utils.Field{Key: "client", Value: d.conn.client.String()})
+}
+
+// ignore stops decoding the connection.
+func (d *postgresWireDecoder) ignore(reason string) {
+	if reason != "" {
+		utils.GetGlobalLogger().Error("Stopped decoding PostgreSQL connection",
+			utils.Field{Key: "client", Value: d.conn.client.String()},
+			utils.Field{Key: "reason", Value: reason})
+	}
+	d.ignored = true
+	d.pending = nil
+	d.client, d.server = streamBuffer{}, streamBuffer{}
+}
+
+func (d *postgresWireDecoder) oldestPending() time.Time {
+	for _, req := range d.pending {
+		if req.trace.Query != "" {
+			return req.trace.Timestamp
+		}
+	}
+	return time.Time{}
+}
+
+func (d *postgresWireDecoder) close() {
+	for _, req := range d.pending {
+		if req.trace.Query != "" {
+			d.conn.emit(req.finish(req.firstResp))
+		}
+	}
+	d.pending = nil
+	if d.unknownPortals > 0 {
+		utils.GetGlobalLogger().Warn("Skipped executions of statements prepared before the capture started",
+			utils.Field{Key: "client", Value: d.conn.client.String()},
+			utils.Field{Key: "count", Value: d.unknownPortals})
+	}
+}
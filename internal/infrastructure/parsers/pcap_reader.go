@@ -0,0 +1,806 @@
+package parsers
+
+import (
+	"bufio"
+	"container/heap"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+	"github.com/turtacn/SQLTraceBench/pkg/utils"
+)
+
+// Wire protocols decoded by PcapTraceParser.
+const (
+	WireProtocolMySQL    = "mysql"
+	WireProtocolPostgres = "postgres"
+)
+
+// Capture file magic numbers.
+var (
+	pcapMagicMicros = []byte{0xd4, 0xc3, 0xb2, 0xa1}
+	pcapMagicNanos  = []byte{0x4d, 0x3c, 0xb2, 0xa1}
+	pcapNGMagic     = []byte{0x0a, 0x0d, 0x0d, 0x0a}
+)
+
+// maxPendingSegments bounds the out-of-order TCP segments buffered per direction.
+// A connection with a larger gap lost packets in the capture and is abandoned.
+const maxPendingSegments = 4096
+
+// flushEveryPackets is how often completed traces are released in timestamp order.
+const flushEveryPackets = 4096
+
+// PcapTraceParser reads MySQL and PostgreSQL queries from tcpdump captures (pcap or
+// pcapng). TCP streams are reassembled and the client/server protocol is decoded:
+// MySQL COM_QUERY and prepared statements (COM_STMT_PREPARE/COM_STMT_EXECUTE) and
+// PostgreSQL simple and extended (Parse/Bind/Execute) queries. Each trace carries the
+// bound parameters of prepared statements and the server-side latency, measured from
+// the end of the request to the first response packet.
+//
+// Connections are attributed to a protocol by server port. TLS-encrypted and
+// compressed connections cannot be decoded and are skipped.
+type PcapTraceParser struct {
+	// MySQLPorts and PostgresPorts are the server ports of each protocol.
+	MySQLPorts    []int
+	PostgresPorts []int
+	// Stats, when set, counts skipped records and enforces the error threshold.
+	Stats *ParseStats
+}
+
+// NewPcapTraceParser creates a PcapTraceParser listening on the default ports:
+// 3306 (MySQL), 4000 (TiDB) and 9030 (StarRocks) for MySQL and 5432 for PostgreSQL.
+func NewPcapTraceParser() *PcapTraceParser {
+	return &PcapTraceParser{
+		MySQLPorts:    []int{3306, 4000, 9030},
+		PostgresPorts: []int{5432},
+	}
+}
+
+// isPcap reports whether head starts with a pcap or pcapng magic number.
+func isPcap(head []byte) bool {
+	if len(head) < 4 {
+		return false
+	}
+	magic := head[:4]
+	for _, m := range [][]byte{pcapMagicMicros, pcapMagicNanos, pcapNGMagic} {
+		if string(magic) == string(m) || string(magic) == string(reverseBytes(m)) {
+			return true
+		}
+	}
+	return false
+}
+
+func reverseBytes(b []byte) []byte {
+	out := make([]byte, len(b))
+	for i := range b {
+		out[len(b)-1-i] = b[i]
+	}
+	return out
+}
+
+// Parse decodes the capture and calls the callback for each query, in request order.
+func (p *PcapTraceParser) Parse(reader io.Reader, callback func(models.SQLTrace) error) error {
+	br := bufio.NewReaderSize(reader, 1024*1024)
+	magic, err := br.Peek(4)
+	if err != nil {
+		return fmt.Errorf("failed to read capture header: %w", err)
+	}
+
+	var packets packetSource
+	if string(magic) == string(pcapNGMagic) {
+		packets = &pcapNGReader{r: br}
+	} else {
+		packets, err = newPcapFileReader(br)
+		if err != nil {
+			return err
+		}
+	}
+
+	asm := newWireAssembler(p.MySQLPorts, p.PostgresPorts)
+	out := &traceHeap{}
+	emit := func() error {
+		watermark := asm.watermark()
+		for out.Len() > 0 && (watermark.IsZero() || !(*out)[0].Timestamp.After(watermark)) {
+			trace := heap.Pop(out).(models.SQLTrace)
+			p.Stats.addParsed()
+			if err := callback(trace); err != nil {
+				return fmt.Errorf("callback failed at %s: %w", trace.Timestamp.Format(time.RFC3339Nano), err)
+			}
+		}
+		return nil
+	}
+	asm.emit = func(trace models.SQLTrace) { heap.Push(out, trace) }
+
+	logger := utils.GetGlobalLogger()
+	for n := 1; ; n++ {
+		pkt, err := packets.next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("packet %d: %w", n, err)
+		}
+		if pkt.data == nil {
+			continue // Not a packet block.
+		}
+		seg, err := decodeTCPSegment(pkt.linkType, pkt.data)
+		if err != nil {
+			logger.Error("Skipped malformed packet", utils.Field{Key: "packet", Value: n}, utils.Field{Key: "error", Value: err})
+			if err := p.Stats.addSkipped(); err != nil {
+				return fmt.Errorf("packet %d: %w", n, err)
+			}
+			continue
+		}
+		if seg == nil {
+			continue // Not TCP.
+		}
+		seg.ts = pkt.ts
+		asm.add(seg)
+		if n%flushEveryPackets == 0 {
+			if err := emit(); err != nil {
+				return err
+			}
+		}
+	}
+
+	asm.close()
+	return emit()
+}
+
+// traceHeap orders completed traces by request time.
+type traceHeap []models.SQLTrace
+
+func (h traceHeap) Len() int            { return len(h) }
+func (h traceHeap) Less(i, j int) bool  { return h[i].Timestamp.Before(h[j].Timestamp) }
+func (h traceHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *traceHeap) Push(x interface{}) { *h = append(*h, x.(models.SQLTrace)) }
+func (h *traceHeap) Pop() interface{} {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+// capturedPacket is a link-layer frame from a capture file.
+type capturedPacket struct {
+	ts       time.Time
+	linkType uint32
+	data     []byte
+}
+
+type packetSource interface {
+	next() (capturedPacket, error)
+}
+
+// pcapFileReader reads the classic libpcap format.
+type pcapFileReader struct {
+	r        io.Reader
+	order    binary.ByteOrder
+	nanos    bool
+	linkType uint32
+	hdr      [16]byte
+}
+
+func newPcapFileReader(r io.Reader) (*pcapFileReader, error) {
+	var hdr [24]byte
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return nil, fmt.Errorf("failed to read pcap header: %w", err)
+	}
+	pr := &pcapFileReader{r: r}
+	switch {
+	case string(hdr[:4]) == string(pcapMagicMicros):
+		pr.order = binary.LittleEndian
+	case string(hdr[:4]) == string(pcapMagicNanos):
+		pr.order, pr.nanos = binary.LittleEndian, true
+	case string(hdr[:4]) == string(reverseBytes(pcapMagicMicros)):
+		pr.order = binary.BigEndian
+	case string(hdr[:4]) == string(reverseBytes(pcapMagicNanos)):
+		pr.order, pr.nanos = binary.BigEndian, true
+	default:
+		return nil, fmt.Errorf("not a pcap file")
+	}
+	pr.linkType = pr.order.Uint32(hdr[20:24]) & 0xffff
+	return pr, nil
+}
+
+func (pr *pcapFileReader) next() (capturedPacket, error) {
+	if _, err := io.ReadFull(pr.r, pr.hdr[:]); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return capturedPacket{}, fmt.Errorf("truncated packet header")
+		}
+		return capturedPacket{}, err
+	}
+	sec := pr.order.Uint32(pr.hdr[0:4])
+	frac := pr.order.Uint32(pr.hdr[4:8])
+	capLen := pr.order.Uint32(pr.hdr[8:12])
+	if capLen > 256*1024*1024 {
+		return capturedPacket{}, fmt.Errorf("invalid captured length %d", capLen)
+	}
+	data := make([]byte, capLen)
+	if _, err := io.ReadFull(pr.r, data); err != nil {
+		return capturedPacket{}, fmt.Errorf("truncated packet data: %w", err)
+	}
+	nsec := int64(frac) * 1000
+	if pr.nanos {
+		nsec = int64(frac)
+	}
+	return capturedPacket{ts: time.Unix(int64(sec), nsec).UTC(), linkType: pr.linkType, data: data}, nil
+}
+
+// pcapNGReader reads the pcapng block format.
+type pcapNGReader struct {
+	r          io.Reader
+	order      binary.ByteOrder
+	interfaces []pcapNGInterface
+}
+
+type pcapNGInterface struct {
+	linkType uint32
+	// tsUnit is the duration of one timestamp tick.
+	tsUnit float64
+}
+
+const (
+	pcapNGBlockSection   = 0x0a0d0d0a
+	pcapNGBlockInterface = 0x00000001
+	pcapNGBlockPacket    = 0x00000002
+	pcapNGBlockSimple    = 0x00000003
+	pcapNGBlockEnhanced  = 0x00000006
+)
+
+func (pr *pcapNGReader) next() (capturedPacket, error) {
+	var hdr [8]byte
+	if _, err := io.ReadFull(pr.r, hdr[:]); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return capturedPacket{}, fmt.Errorf("truncated block header")
+		}
+		return capturedPacket{}, err
+	}
+
+	if binary.LittleEndian.Uint32(hdr[0:4]) == pcapNGBlockSection {
+		// The byte order magic follows the length; the length is read once it is known.
+		var bom [4]byte
+		if _, err := io.ReadFull(pr.r, bom[:]); err != nil {
+			return capturedPacket{}, fmt.Errorf("truncated section header")
+		}
+		switch binary.LittleEndian.Uint32(bom[:]) {
+		case 0x1a2b3c4d:
+			pr.order = binary.LittleEndian
+		case 0x4d3c2b1a:
+			pr.order = binary.BigEndian
+		default:
+			return capturedPacket{}, fmt.Errorf("invalid pcapng byte order magic")
+		}
+		pr.interfaces = nil
+		total := pr.order.Uint32(hdr[4:8])
+		if total < 16 {
+			return capturedPacket{}, fmt.Errorf("invalid section header length %d", total)
+		}
+		_, err := io.CopyN(io.Discard, pr.r, int64(total)-12)
+		return capturedPacket{}, err
+	}
+	if pr.order == nil {
+		return capturedPacket{}, fmt.Errorf("pcapng block before section header")
+	}
+
+	blockType := pr.order.Uint32(hdr[0:4])
+	total := pr.order.Uint32(hdr[4:8])
+	if total < 12 || total%4 != 0 || total > 256*1024*1024 {
+		return capturedPacket{}, fmt.Errorf("invalid block length %d", total)
+	}
+	body := make([]byte, total-12)
+	if _, err := io.ReadFull(pr.r, body); err != nil {
+		return capturedPacket{}, fmt.Errorf("truncated block: %w", err)
+	}
+	if _, err := io.CopyN(io.Discard, pr.r, 4); err != nil {
+		return capturedPacket{}, fmt.Errorf("truncated block trailer")
+	}
+
+	switch blockType {
+	case pcapNGBlockInterface:
+		if len(body) < 8 {
+			return capturedPacket{}, fmt.Errorf("short interface block")
+		}
+		iface := pcapNGInterface{linkType: uint32(pr.order.Uint16(body[0:2])), tsUnit: 1e-6}
+		for opts := body[8:]; len(opts) >= 4; {
+			code, length := pr.order.Uint16(opts[0:2]), int(pr.order.Uint16(opts[2:4]))
+			if code == 0 || 4+length > len(opts) {
+				break
+			}
+			if code == 9 && length >= 1 { // if_tsresol
+				v := opts[4]
+				if v&0x80 == 0 {
+					iface.tsUnit = math.Pow10(-int(v))
+				} else {
+					iface.tsUnit = math.Pow(2, -float64(v&0x7f))
+				}
+			}
+			opts = opts[4+(length+3)/4*4:]
+		}
+		pr.interfaces = append(pr.interfaces, iface)
+		return capturedPacket{}, nil
+	case pcapNGBlockEnhanced, pcapNGBlockPacket:
+		if len(body) < 20 {
+			return capturedPacket{}, fmt.Errorf("short packet block")
+		}
+		var ifaceID uint32
+		if blockType == pcapNGBlockEnhanced {
+			ifaceID = pr.order.Uint32(body[0:4])
+		} else {
+			ifaceID = uint32(pr.order.Uint16(body[0:2]))
+		}
+		if int(ifaceID) >= len(pr.interfaces) {
+			return capturedPacket{}, fmt.Errorf("packet for unknown interface %d", ifaceID)
+		}
+		iface := pr.interfaces[ifaceID]
+		ticks := uint64(pr.order.Uint32(body[4:8]))<<32 | uint64(pr.order.Uint32(body[8:12]))
+		capLen := pr.order.Uint32(body[12:16])
+		if int(capLen) > len(body)-20 {
+			return capturedPacket{}, fmt.Errorf("captured length %d exceeds block", capLen)
+		}
+		return capturedPacket{
+			ts:       pcapNGTime(ticks, iface.tsUnit),
+			linkType: iface.linkType,
+			data:     body[20 : 20+capLen],
+		}, nil
+	case pcapNGBlockSimple:
+		// Simple packet blocks carry no timestamp, so latencies cannot be measured.
+		return capturedPacket{}, nil
+	}
+	return capturedPacket{}, nil
+}
+
+func pcapNGTime(ticks uint64, unit float64) time.Time {
+	if unit == 1e-6 {
+		return time.UnixMicro(int64(ticks)).UTC()
+	}
+	if unit == 1e-9 {
+		return time.Unix(0, int64(ticks)).UTC()
+	}
+	secs := float64(ticks) * unit
+	whole := math.Floor(secs)
+	return time.Unix(int64(whole), int64((secs-whole)*1e9)).UTC()
+}
+
+// Link-layer types (https://www.tcpdump.org/linktypes.html).
+const (
+	linkTypeNull     = 0
+	linkTypeEthernet = 1
+	linkTypeRaw      = 101
+	linkTypeLoop     = 108
+	linkTypeLinuxSLL = 113
+	linkTypeIPv4     = 228
+	linkTypeIPv6     = 229
+	linkTypeSLL2     = 276
+)
+
+// tcpSegment is the part of a TCP packet needed for stream reassembly.
+type tcpSegment struct {
+	src, dst endpoint
+	seq      uint32
+	syn, ack bool
+	fin, rst bool
+	payload  []byte
+	ts       time.Time
+}
+
+type endpoint struct {
+	ip   string
+	port int
+}
+
+func (e endpoint) String() string {
+	return net.JoinHostPort(e.ip, strconv.Itoa(e.port))
+}
+
+var errShortPacket = errors.New("packet too short")
+
+// decodeTCPSegment strips the link and IP layers. It returns nil for non-TCP packets.
+func decodeTCPSegment(linkType uint32, data []byte) (*tcpSegment, error) {
+	var etherType uint16
+	switch linkType {
+	case linkTypeEthernet:
+		if len(data) < 14 {
+			return nil, errShortPacket
+		}
+		etherType, data = binary.BigEndian.Uint16(data[12:14]), data[14:]
+		for etherType == 0x8100 || etherType == 0x88a8 { // VLAN tags
+			if len(data) < 4 {
+				return nil, errShortPacket
+			}
+			etherType, data = binary.BigEndian.Uint16(data[2:4]), data[4:]
+		}
+	case linkTypeLinuxSLL:
+		if len(data) < 16 {
+			return nil, errShortPacket
+		}
+		etherType, data = binary.BigEndian.Uint16(data[14:16]), data[16:]
+	case linkTypeSLL2:
+		if len(data) < 20 {
+			return nil, errShortPacket
+		}
+		etherType, data = binary.BigEndian.Uint16(data[0:2]), data[20:]
+	case linkTypeNull, linkTypeLoop:
+		if len(data) < 4 {
+			return nil, errShortPacket
+		}
+		// The address family is in host byte order (null) or network order (loop);
+		// the IP version nibble is a more reliable hint.
+		data = data[4:]
+		etherType = ipEtherType(data)
+	case linkTypeRaw, linkTypeIPv4, linkTypeIPv6:
+		etherType = ipEtherType(data)
+	default:
+		return nil, fmt.Errorf("unsupported link type %d", linkType)
+	}
+
+	var src, dst string
+	var proto byte
+	switch etherType {
+	case 0x0800:
+		if len(data) < 20 {
+			return nil, errShortPacket
+		}
+		ihl := int(data[0]&0x0f) * 4
+		total := int(binary.BigEndian.Uint16(data[2:4]))
+		if ihl < 20 || len(data) < ihl {
+			return nil, errShortPacket
+		}
+		if binary.BigEndian.Uint16(data[6:8])&0x1fff != 0 {
+			return nil, nil // Non-first fragment.
+		}
+		proto = data[9]
+		src, dst = net.IP(data[12:16]).String(), net.IP(data[16:20]).String()
+		if total >= ihl && total < len(data) {
+			data = data[:total] // Drop Ethernet padding.
+		}
+		data = data[ihl:]
+	case 0x86dd:
+		if len(data) < 40 {
+			return nil, errShortPacket
+		}
+		payloadLen := int(binary.BigEndian.Uint16(data[4:6]))
+		proto = data[6]
+		src, dst = net.IP(data[8:24]).String(), net.IP(data[24:40]).String()
+		data = data[40:]
+		if payloadLen > 0 && payloadLen < len(data) {
+			data = data[:payloadLen]
+		}
+		// Skip hop-by-hop, routing and destination options headers.
+		for proto == 0 || proto == 43 || proto == 60 {
+			if len(data) < 8 {
+				return nil, errShortPacket
+			}
+			proto, data = data[0], data[(int(data[1])+1)*8:]
+		}
+	default:
+		return nil, nil
+	}
+	if proto != 6 {
+		return nil, nil
+	}
+
+	if len(data) < 20 {
+		return nil, errShortPacket
+	}
+	offset := int(data[12]>>4) * 4
+	if offset < 20 || len(data) < offset {
+		return nil, errShortPacket
+	}
+	flags := data[13]
+	return &tcpSegment{
+		src:     endpoint{src, int(binary.BigEndian.Uint16(data[0:2]))},
+		dst:     endpoint{dst, int(binary.BigEndian.Uint16(data[2:4]))},
+		seq:     binary.BigEndian.Uint32(data[4:8]),
+		fin:     flags&0x01 != 0,
+		syn:     flags&0x02 != 0,
+		rst:     flags&0x04 != 0,
+		ack:     flags&0x10 != 0,
+		payload: data[offset:],
+	}, nil
+}
+
+func ipEtherType(data []byte) uint16 {
+	if len(data) == 0 {
+		return 0
+	}
+	switch data[0] >> 4 {
+	case 4:
+		return 0x0800
+	case 6:
+		return 0x86dd
+	}
+	return 0
+}
+
+// wireDecoder decodes one direction-aware database connection.
+type wireDecoder interface {
+	// feed handles reassembled bytes. fromClient tells the direction; t is the
+	// capture time of the packet that carried them.
+	feed(fromClient bool, data []byte, t time.Time)
+	// oldestPending returns the time of the oldest request still waiting for a
+	// response, or the zero time.
+	oldestPending() time.Time
+	// close emits the requests that never received a response.
+	close()
+}
+
+// wireConn is a TCP connection between a database client and server.
+type wireConn struct {
+	client, server endpoint
+	decoder        wireDecoder
+	dirs           [2]tcpDirection // client to server, server to client
+	broken         bool
+	closed         bool
+	emit           func(models.SQLTrace)
+}
+
+// newTrace returns a trace carrying the connection's session context.
+func (c *wireConn) newTrace(query string, ts time.Time) models.SQLTrace {
+	return models.SQLTrace{
+		Query:     query,
+		Timestamp: ts,
+		SessionID: c.client.String(),
+		Host:      c.client.ip,
+	}
+}
+
+// tcpDirection reassembles one direction of a TCP stream.
+type tcpDirection struct {
+	started bool
+	next    uint32
+	pending map[uint32]tcpSegment
+}
+
+// wireAssembler routes TCP segments to per-connection decoders.
+type wireAssembler struct {
+	protocols map[int]string
+	conns     map[[2]endpoint]*wireConn
+	emit      func(models.SQLTrace)
+}
+
+func newWireAssembler(mysqlPorts, postgresPorts []int) *wireAssembler {
+	a := &wireAssembler{protocols: make(map[int]string), conns: make(map[[2]endpoint]*wireConn)}
+	for _, port := range mysqlPorts {
+		a.protocols[port] = WireProtocolMySQL
+	}
+	for _, port := range postgresPorts {
+		a.protocols[port] = WireProtocolPostgres
+	}
+	return a
+}
+
+func (a *wireAssembler) add(seg *tcpSegment) {
+	fromClient := true
+	protocol, ok := a.protocols[seg.dst.port]
+	if !ok {
+		if protocol, ok = a.protocols[seg.src.port]; !ok {
+			return
+		}
+		fromClient = false
+	}
+	client, server := seg.src, seg.dst
+	if !fromClient {
+		client, server = seg.dst, seg.src
+	}
+	key := [2]endpoint{client, server}
+
+	conn := a.conns[key]
+	if conn != nil && seg.syn && !seg.ack && fromClient && conn.dirs[0].next != seg.seq+1 {
+		// The client reused the port for a new connection.
+		conn.decoder.close()
+		conn = nil
+	}
+	if conn == nil {
+		conn = &wireConn{client: client, server: server, emit: a.emit}
+		if protocol == WireProtocolMySQL {
+			conn.decoder = newMySQLWireDecoder(conn)
+		} else {
+			conn.decoder = newPostgresWireDecoder(conn)
+		}
+		a.conns[key] = conn
+	}
+	if conn.broken {
+		return
+	}
+
+	dir := &conn.dirs[1]
+	if fromClient {
+		dir = &conn.dirs[0]
+	}
+	if seg.fin || seg.rst {
+		conn.closed = true
+	}
+	if seg.syn {
+		dir.started, dir.next = true, seg.seq+1
+		return
+	}
+	if len(seg.payload) == 0 {
+		return
+	}
+	if !dir.started {
+		// The capture started mid-connection.
+		dir.started, dir.next = true, seg.seq
+	}
+
+	diff := int32(seg.seq - dir.next)
+	switch {
+	case diff < 0:
+		if len(seg.payload) <= int(-diff) {
+			return // Retransmission of data already delivered.
+		}
+		seg.payload, seg.seq = seg.payload[-diff:], dir.next
+	case diff > 0:
+		if dir.pending == nil {
+			dir.pending = make(map[uint32]tcpSegment)
+		}
+		if len(dir.pending) >= maxPendingSegments {
+			utils.GetGlobalLogger().Error("Abandoned connection with missing TCP segments",
+				utils.Field{Key: "client", Value: conn.client.String()},
+				utils.Field{Key: "server", Value: conn.server.String()})
+			conn.broken = true
+			conn.decoder.close()
+			return
+		}
+		dir.pending[seg.seq] = *seg
+		return
+	}
+
+	conn.decoder.feed(fromClient, seg.payload, seg.ts)
+	dir.next += uint32(len(seg.payload))
+	for len(dir.pending) > 0 {
+		next, ok := dir.pending[dir.next]
+		if !ok {
+			break
+		}
+		delete(dir.pending, dir.next)
+		conn.decoder.feed(fromClient, next.payload, next.ts)
+		dir.next += uint32(len(next.payload))
+	}
+}
+
+// watermark returns the time before which every trace has been completed, or the
+// zero time if no request is pending.
+func (a *wireAssembler) watermark() time.Time {
+	var oldest time.Time
+	for _, conn := range a.conns {
+		if t := conn.decoder.oldestPending(); !t.IsZero() && (oldest.IsZero() || t.Before(oldest)) {
+			oldest = t
+		}
+	}
+	return oldest
+}
+
+func (a *wireAssembler) close() {
+	for key, conn := range a.conns {
+		if !conn.broken {
+			conn.decoder.close()
+		}
+		delete(a.conns, key)
+	}
+}
+
+// streamBuffer accumulates the bytes of one direction and remembers the capture
+// time at which each byte arrived.
+type streamBuffer struct {
+	data  []byte
+	marks []streamMark
+}
+
+type streamMark struct {
+	end int // offset just past the bytes that arrived at t
+	t   time.Time
+}
+
+func (b *streamBuffer) append(data []byte, t time.Time) {
+	b.data = append(b.data, data...)
+	b.marks = append(b.marks, streamMark{end: len(b.data), t: t})
+}
+
+// timeAt returns the arrival time of the byte at offset off.
+func (b *streamBuffer) timeAt(off int) time.Time {
+	for _, m := range b.marks {
+		if off < m.end {
+			return m.t
+		}
+	}
+	if len(b.marks) > 0 {
+		return b.marks[len(b.marks)-1].t
+	}
+	return time.Time{}
+}
+
+// consume drops the first n bytes.
+func (b *streamBuffer) consume(n int) {
+	b.data = b.data[n:]
+	i := 0
+	for i < len(b.marks) && b.marks[i].end <= n {
+		i++
+	}
+	b.marks = b.marks[i:]
+	for j := range b.marks {
+		b.marks[j].end -= n
+	}
+	if len(b.data) == 0 {
+		b.data, b.marks = nil, nil
+	}
+}
+
+// pendingRequest is a request waiting for the first packet of its response.
+type pendingRequest struct {
+	trace     models.SQLTrace
+	kind      byte // protocol-specific request kind
+	firstResp time.Time
+}
+
+// finish completes the request's trace with the latency to its first response.
+func (r *pendingRequest) finish(firstResp time.Time) models.SQLTrace {
+	if !firstResp.IsZero() && firstResp.After(r.trace.Timestamp) {
+		r.trace.Latency = firstResp.Sub(r.trace.Timestamp)
+	}
+	return r.trace
+}
+
+// rewriteQuestionPlaceholders rewrites the ? placeholders of a MySQL prepared
+// statement to the :p1, :p2, ... names used for trace parameters. Placeholders in
+// string literals, quoted identifiers and comments are left alone.
+func rewriteQuestionPlaceholders(query string) string {
+	var b []byte
+	n := 0
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case c == '\'' || c == '"' || c == '`':
+			end := scanSQLQuoted(query, i)
+			b = append(b, query[i:end]...)
+			i = end - 1
+		case c == '-' && i+1 < len(query) && query[i+1] == '-', c == '#':
+			end := i
+			for end < len(query) && query[end] != '\n' {
+				end++
+			}
+			b = append(b, query[i:end]...)
+			i = end - 1
+		case c == '/' && i+1 < len(query) && query[i+1] == '*':
+			end := i + 2
+			for end+1 < len(query) && !(query[end] == '*' && query[end+1] == '/') {
+				end++
+			}
+			end = min(end+2, len(query))
+			b = append(b, query[i:end]...)
+			i = end - 1
+		case c == '?':
+			n++
+			b = append(b, ":p"+strconv.Itoa(n)...)
+		default:
+			b = append(b, c)
+		}
+	}
+	return string(b)
+}
+
+// scanSQLQuoted returns the index just past the quoted string starting at i.
+func scanSQLQuoted(query string, i int) int {
+	quote := query[i]
+	for j := i + 1; j < len(query); j++ {
+		switch query[j] {
+		case '\\':
+			if quote != '`' {
+				j++
+			}
+		case quote:
+			if j+1 < len(query) && query[j+1] == quote {
+				j++
+				continue
+			}
+			return j + 1
+		}
+	}
+	return len(query)
+}
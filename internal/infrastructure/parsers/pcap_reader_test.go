@@ -0,0 +1,159 @@
+package parsers
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+)
+
+// The capture fixtures are written by testdata/gen_wire_captures.py.
+var captureBase = time.Unix(1700000000, 0).UTC()
+
+func parseCapture(t *testing.T, parser *PcapTraceParser, path string) []models.SQLTrace {
+	t.Helper()
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var traces []models.SQLTrace
+	require.NoError(t, parser.Parse(f, func(trace models.SQLTrace) error {
+		traces = append(traces, trace)
+		return nil
+	}))
+	return traces
+}
+
+func TestPcapTraceParser_MySQL(t *testing.T) {
+	stats := &ParseStats{}
+	parser := NewPcapTraceParser()
+	parser.Stats = stats
+	traces := parseCapture(t, parser, "testdata/mysql_wire.pcap")
+	require.Len(t, traces, 5)
+
+	query := traces[0]
+	assert.Equal(t, "SELECT * FROM users WHERE id = 7", query.Query)
+	assert.Equal(t, captureBase.Add(time.Second), query.Timestamp)
+	assert.Equal(t, 2500*time.Microsecond, query.Latency)
+	assert.Nil(t, query.Parameters)
+	assert.Equal(t, "app", query.User)
+	assert.Equal(t, "shop", query.Database)
+	assert.Equal(t, "10.0.0.5:50001", query.SessionID)
+	assert.Equal(t, "10.0.0.5", query.Host)
+
+	execute := traces[1]
+	assert.Equal(t, "SELECT name FROM users WHERE id = :p1 AND status = :p2 AND note = '?'", execute.Query)
+	assert.Equal(t, map[string]interface{}{":p1": int64(42), ":p2": "active"}, execute.Parameters)
+	assert.Equal(t, 1500*time.Microsecond, execute.Latency)
+
+	// The second execute reuses the parameter types of the first.
+	reexecute := traces[2]
+	assert.Equal(t, execute.Query, reexecute.Query)
+	assert.Equal(t, map[string]interface{}{":p1": int64(-43), ":p2": nil}, reexecute.Parameters)
+	assert.Equal(t, time.Millisecond, reexecute.Latency)
+
+	// Reassembled from out-of-order and retransmitted segments; timed from the first
+	// segment that arrived in order.
+	update := traces[3]
+	assert.Equal(t, "UPDATE users SET status = 'x' WHERE id = 9", update.Query)
+	assert.Equal(t, int64(1), update.RowsAffected)
+	assert.Equal(t, 3900*time.Microsecond, update.Latency)
+
+	failed := traces[4]
+	assert.Equal(t, "INSERT INTO missing VALUES (1)", failed.Query)
+	assert.Equal(t, "1146", failed.ErrorCode)
+	assert.Equal(t, time.Millisecond, failed.Latency)
+
+	assert.Equal(t, int64(5), stats.Parsed())
+	assert.Zero(t, stats.Skipped())
+}
+
+func TestPcapTraceParser_Postgres(t *testing.T) {
+	traces := parseCapture(t, NewPcapTraceParser(), "testdata/postgres_wire.pcapng")
+	require.Len(t, traces, 4)
+
+	simple := traces[0]
+	assert.Equal(t, "SELECT count(*) FROM orders", simple.Query)
+	assert.Equal(t, captureBase.Add(10*time.Second), simple.Timestamp)
+	assert.Equal(t, 3*time.Millisecond, simple.Latency)
+	assert.Equal(t, "bench", simple.User)
+	assert.Equal(t, "orders", simple.Database)
+	assert.Equal(t, "192.168.1.20:40000", simple.SessionID)
+
+	extended := traces[1]
+	assert.Equal(t, "SELECT * FROM orders WHERE id = :p1 AND region = :p2", extended.Query)
+	assert.Equal(t, map[string]interface{}{":p1": int64(1001), ":p2": "eu"}, extended.Parameters)
+	assert.Equal(t, 1500*time.Microsecond, extended.Latency)
+
+	named := traces[2]
+	assert.Equal(t, "UPDATE orders SET total = :p1, note = :p3 WHERE id = :p2", named.Query)
+	assert.Equal(t, map[string]interface{}{":p1": 99.5, ":p2": int64(5), ":p3": nil}, named.Parameters)
+	assert.Equal(t, int64(1), named.RowsAffected)
+	assert.Equal(t, 2*time.Millisecond, named.Latency)
+
+	failed := traces[3]
+	assert.Equal(t, "SELECT * FROM nope", failed.Query)
+	assert.Equal(t, "42P01", failed.ErrorCode)
+	assert.Equal(t, 700*time.Microsecond, failed.Latency)
+}
+
+func TestPcapTraceParser_Ports(t *testing.T) {
+	parser := NewPcapTraceParser()
+	parser.MySQLPorts = []int{3307}
+	assert.Empty(t, parseCapture(t, parser, "testdata/mysql_wire.pcap"))
+}
+
+func TestPcapTraceParser_UnansweredRequest(t *testing.T) {
+	// A capture that ends before the server answered.
+	capture, err := os.ReadFile("testdata/mysql_wire.pcap")
+	require.NoError(t, err)
+	cut := truncatePcapBefore(t, capture, captureBase.Add(4001*time.Millisecond))
+
+	traces := parseCapture(t, NewPcapTraceParser(), writeTemp(t, cut))
+	require.Len(t, traces, 5)
+	assert.Equal(t, "INSERT INTO missing VALUES (1)", traces[4].Query)
+	assert.Zero(t, traces[4].Latency)
+	assert.Empty(t, traces[4].ErrorCode)
+}
+
+func TestPcapTraceParser_Truncated(t *testing.T) {
+	capture, err := os.ReadFile("testdata/mysql_wire.pcap")
+	require.NoError(t, err)
+	err = NewPcapTraceParser().Parse(bytes.NewReader(capture[:len(capture)-3]), func(models.SQLTrace) error { return nil })
+	assert.ErrorContains(t, err, "truncated packet data")
+}
+
+func TestRewriteQuestionPlaceholders(t *testing.T) {
+	assert.Equal(t, "SELECT :p1, ':?', `a?`, \"b?\" -- ?\n, :p2 /* ? */",
+		rewriteQuestionPlaceholders("SELECT ?, ':?', `a?`, \"b?\" -- ?\n, ? /* ? */"))
+	assert.Equal(t, `SELECT 'it''s ?', :p1`, rewriteQuestionPlaceholders(`SELECT 'it''s ?', ?`))
+}
+
+// truncatePcapBefore drops the packets of a little-endian microsecond pcap captured
+// at or after the given time.
+func truncatePcapBefore(t *testing.T, capture []byte, cutoff time.Time) []byte {
+	t.Helper()
+	out := append([]byte(nil), capture[:24]...)
+	for rest := capture[24:]; len(rest) >= 16; {
+		sec, usec := binary.LittleEndian.Uint32(rest[0:4]), binary.LittleEndian.Uint32(rest[4:8])
+		size := 16 + int(binary.LittleEndian.Uint32(rest[8:12]))
+		if !time.Unix(int64(sec), int64(usec)*1000).Before(cutoff) {
+			break
+		}
+		out = append(out, rest[:size]...)
+		rest = rest[size:]
+	}
+	return out
+}
+
+func writeTemp(t *testing.T, data []byte) string {
+	t.Helper()
+	path := t.TempDir() + "/capture.pcap"
+	require.NoError(t, os.WriteFile(path, data, 0o644))
+	return path
+}
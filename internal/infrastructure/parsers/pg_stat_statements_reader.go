@@ -0,0 +1,94 @@
+package parsers
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+)
+
+// PgStatStatementsReader reads a CSV export of the pg_stat_statements view, e.g.
+//
+//	\copy (SELECT * FROM pg_stat_statements) TO 'stats.csv' CSV HEADER
+//
+// pg_stat_statements already aggregates executions, so each row becomes a SQL template
+// weighted by its "calls" column instead of a list of individual traces.
+type PgStatStatementsReader struct{}
+
+// NewPgStatStatementsReader creates a new PgStatStatementsReader.
+func NewPgStatStatementsReader() *PgStatStatementsReader {
+	return &PgStatStatementsReader{}
+}
+
+// ReadTemplates parses the export and returns the templates sorted by weight in descending order.
+// Rows with the same normalized query (e.g. from different users or databases) are merged.
+func (r *PgStatStatementsReader) ReadTemplates(reader io.Reader) ([]models.SQLTemplate, error) {
+	cr := csv.NewReader(reader)
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pg_stat_statements header: %w", err)
+	}
+	queryCol, callsCol := -1, -1
+	for i, name := range header {
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "query":
+			queryCol = i
+		case "calls":
+			callsCol = i
+		}
+	}
+	if queryCol < 0 || callsCol < 0 {
+		return nil, fmt.Errorf("pg_stat_statements export must contain 'query' and 'calls' columns")
+	}
+
+	agg := make(map[string]*models.SQLTemplate)
+	row := 1
+	for {
+		fields, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		row++
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", row, err)
+		}
+		if len(fields) <= queryCol || len(fields) <= callsCol {
+			continue
+		}
+
+		calls, err := strconv.ParseInt(strings.TrimSpace(fields[callsCol]), 10, 64)
+		if err != nil || calls <= 0 {
+			continue
+		}
+		query := RewritePgPlaceholders(strings.TrimSpace(fields[queryCol]))
+		if query == "" {
+			continue
+		}
+
+		key := strings.ToLower(query)
+		if _, ok := agg[key]; !ok {
+			tpl := &models.SQLTemplate{
+				RawSQL:   query,
+				GroupKey: key,
+			}
+			tpl.ExtractParameters()
+			agg[key] = tpl
+		}
+		agg[key].Weight += int(calls)
+	}
+
+	out := make([]models.SQLTemplate, 0, len(agg))
+	for _, t := range agg {
+		out = append(out, *t)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Weight > out[j].Weight
+	})
+	return out, nil
+}
@@ -0,0 +1,478 @@
+package parsers
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+	"github.com/turtacn/SQLTraceBench/pkg/utils"
+)
+
+var (
+	// pgStderrLineRe splits a stderr log line into its log_line_prefix, severity and message.
+	pgStderrLineRe = regexp.MustCompile(`^(.*?)\b(LOG|DETAIL|ERROR|STATEMENT|HINT|CONTEXT|WARNING|NOTICE|INFO|FATAL|PANIC|DEBUG[1-5]?):\s+(.*)$`)
+	// pgPrefixTimeRe matches the %m / %t escape at the start of log_line_prefix.
+	pgPrefixTimeRe = regexp.MustCompile(`^(\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}(?:\.\d+)?(?: [A-Za-z]{2,5}| [+-]\d{2}(?::?\d{2})?)?)`)
+	// pgPrefixUserDBRe matches the "%u@%d" escapes commonly placed in log_line_prefix.
+	pgPrefixUserDBRe = regexp.MustCompile(`(?:^|\s)([A-Za-z0-9_.\-\[\]]+)@([A-Za-z0-9_.\-\[\]]+)(?:\s|$)`)
+	// pgPrefixPIDRe matches the "[%p]" process id escape, which identifies the backend (session).
+	pgPrefixPIDRe = regexp.MustCompile(`\[(\d+)\]`)
+	// pgStatementRe matches the message logged by log_min_duration_statement or log_statement.
+	pgStatementRe = regexp.MustCompile(`(?s)^(?:duration:\s*([0-9.]+)\s*ms\s+)?(?:statement|execute\s+[^:]*):\s*(.*)$`)
+	// pgParamRe matches a positional placeholder such as $1.
+	pgParamRe = regexp.MustCompile(`\$(\d+)`)
+)
+
+var pgLogTimeLayouts = []string{
+	"2006-01-02 15:04:05 MST",
+	"2006-01-02 15:04:05 -07",
+	"2006-01-02 15:04:05 -0700",
+	"2006-01-02 15:04:05 -07:00",
+	"2006-01-02 15:04:05",
+}
+
+// PostgresLogParser parses PostgreSQL server logs written with log_min_duration_statement
+// (or log_statement) enabled. It supports the stderr, csvlog and jsonlog destinations.
+type PostgresLogParser struct {
+	Format     string
+	BufferSize int
+	// Location applies to log times written without a zone. Defaults to UTC.
+	Location *time.Location
+	// Stats, when set, counts skipped and defaulted records and enforces the error threshold.
+	Stats *ParseStats
+}
+
+// NewPostgresLogParser creates a parser for the given log format
+// (TraceFormatPgLog, TraceFormatPgCSVLog or TraceFormatPgJSONLog).
+func NewPostgresLogParser(format string, bufferSize int) *PostgresLogParser {
+	if bufferSize <= 0 {
+		bufferSize = 1024 * 1024 // Default 1MB
+	}
+	return &PostgresLogParser{
+		Format:     format,
+		BufferSize: bufferSize,
+	}
+}
+
+// pgLogEntry holds the fields of a single log record that are relevant for tracing.
+type pgLogEntry struct {
+	timestamp time.Time
+	user      string
+	host      string
+	database  string
+	sessionID string
+	txnID     string
+	sqlState  string
+	severity  string
+	message   string
+	detail    string
+	statement string // the failed statement of an ERROR entry
+	badTime   bool   // the log time could not be parsed
+	line      int
+}
+
+// Parse reads the log and calls the callback for every logged statement.
+func (p *PostgresLogParser) Parse(reader io.Reader, callback func(models.SQLTrace) error) error {
+	switch p.Format {
+	case TraceFormatPgCSVLog:
+		return p.parseCSV(reader, callback)
+	case TraceFormatPgJSONLog:
+		return p.parseJSON(reader, callback)
+	default:
+		return p.parseStderr(reader, callback)
+	}
+}
+
+func (p *PostgresLogParser) newScanner(reader io.Reader) *bufio.Scanner {
+	scanner := bufio.NewScanner(reader)
+	buf := make([]byte, 64*1024)
+	bufferSize := p.BufferSize
+	if bufferSize == 0 {
+		bufferSize = 1024 * 1024 // Default fallback
+	}
+	scanner.Buffer(buf, bufferSize)
+	return scanner
+}
+
+func (p *PostgresLogParser) parseStderr(reader io.Reader, callback func(models.SQLTrace) error) error {
+	scanner := p.newScanner(reader)
+	lineNum := 0
+
+	var pending *pgLogEntry
+	// target is the field that continuation lines (which start with a tab) extend.
+	var target *string
+
+	flush := func() error {
+		if pending == nil {
+			return nil
+		}
+		entry := pending
+		pending, target = nil, nil
+		return p.emit(entry, callback)
+	}
+
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, "\t") {
+			if target != nil {
+				*target += "\n" + strings.TrimPrefix(line, "\t")
+			}
+			continue
+		}
+
+		m := pgStderrLineRe.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		prefix, severity, message := m[1], m[2], m[3]
+
+		// DETAIL lines carry the bind parameters of the preceding statement.
+		if severity == "DETAIL" && pending != nil {
+			pending.detail = message
+			target = &pending.detail
+			continue
+		}
+
+		if err := flush(); err != nil {
+			return err
+		}
+		if severity != "LOG" {
+			continue
+		}
+
+		entry := &pgLogEntry{severity: severity, message: message, line: lineNum}
+		if tm := pgPrefixTimeRe.FindString(prefix); tm != "" {
+			entry.timestamp, entry.badTime = p.parseTime(tm)
+		}
+		if um := pgPrefixUserDBRe.FindStringSubmatch(prefix); um != nil {
+			entry.user = um[1]
+			entry.database = um[2]
+		}
+		if pm := pgPrefixPIDRe.FindStringSubmatch(prefix); pm != nil {
+			entry.sessionID = pm[1]
+		}
+		pending = entry
+		target = &pending.message
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("scanner error: %w", err)
+	}
+	return flush()
+}
+
+// csvlog column positions, stable since PostgreSQL 9.0.
+const (
+	pgCSVLogTime        = 0
+	pgCSVUserName       = 1
+	pgCSVDatabaseName   = 2
+	pgCSVConnectionFrom = 4
+	pgCSVSessionID      = 5
+	pgCSVTransactionID  = 10
+	pgCSVErrorSeverity  = 11
+	pgCSVSQLStateCode   = 12
+	pgCSVMessage        = 13
+	pgCSVDetail         = 14
+	pgCSVQuery          = 19
+)
+
+func (p *PostgresLogParser) parseCSV(reader io.Reader, callback func(models.SQLTrace) error) error {
+	r := csv.NewReader(reader)
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+	logger := utils.GetGlobalLogger()
+
+	record := 0
+	for {
+		fields, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		record++
+		if err != nil {
+			logger.Error("Skipped malformed csvlog record", utils.Field{Key: "record", Value: record}, utils.Field{Key: "error", Value: err})
+			if err := p.Stats.addSkipped(); err != nil {
+				return fmt.Errorf("record %d: %w", record, err)
+			}
+			continue
+		}
+		if len(fields) <= pgCSVDetail {
+			continue
+		}
+		severity := fields[pgCSVErrorSeverity]
+		if severity != "LOG" && severity != "ERROR" {
+			continue
+		}
+
+		entry := &pgLogEntry{
+			user:      fields[pgCSVUserName],
+			host:      stripPort(fields[pgCSVConnectionFrom]),
+			database:  fields[pgCSVDatabaseName],
+			sessionID: fields[pgCSVSessionID],
+			txnID:     fields[pgCSVTransactionID],
+			sqlState:  fields[pgCSVSQLStateCode],
+			severity:  severity,
+			message:   fields[pgCSVMessage],
+			detail:    fields[pgCSVDetail],
+			line:      record,
+		}
+		if len(fields) > pgCSVQuery {
+			entry.statement = fields[pgCSVQuery]
+		}
+		entry.timestamp, entry.badTime = p.parseTime(fields[pgCSVLogTime])
+		if err := p.emit(entry, callback); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pgJSONLogRecord mirrors the keys written by the jsonlog destination (PostgreSQL 15+).
+type pgJSONLogRecord struct {
+	Timestamp     string     `json:"timestamp"`
+	User          string     `json:"user"`
+	DBName        string     `json:"dbname"`
+	RemoteHost    string     `json:"remote_host"`
+	SessionID     string     `json:"session_id"`
+	TxID          flexString `json:"txid"`
+	ErrorSeverity string     `json:"error_severity"`
+	StateCode     string     `json:"state_code"`
+	Message       string     `json:"message"`
+	Detail        string     `json:"detail"`
+	Statement     string     `json:"statement"`
+}
+
+func (p *PostgresLogParser) parseJSON(reader io.Reader, callback func(models.SQLTrace) error) error {
+	scanner := p.newScanner(reader)
+	logger := utils.GetGlobalLogger()
+	lineNum := 0
+
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Bytes()
+		if len(strings.TrimSpace(string(line))) == 0 {
+			continue
+		}
+
+		var rec pgJSONLogRecord
+		if err := json.Unmarshal(line, &rec); err != nil {
+			logger.Error("Skipped malformed line", utils.Field{Key: "line", Value: lineNum}, utils.Field{Key: "error", Value: err})
+			if err := p.Stats.addSkipped(); err != nil {
+				return fmt.Errorf("line %d: %w", lineNum, err)
+			}
+			continue
+		}
+		if rec.ErrorSeverity != "LOG" && rec.ErrorSeverity != "ERROR" {
+			continue
+		}
+
+		entry := &pgLogEntry{
+			user:      rec.User,
+			host:      rec.RemoteHost,
+			database:  rec.DBName,
+			sessionID: rec.SessionID,
+			txnID:     string(rec.TxID),
+			sqlState:  rec.StateCode,
+			severity:  rec.ErrorSeverity,
+			message:   rec.Message,
+			detail:    rec.Detail,
+			statement: rec.Statement,
+			line:      lineNum,
+		}
+		entry.timestamp, entry.badTime = p.parseTime(rec.Timestamp)
+		if err := p.emit(entry, callback); err != nil {
+			return err
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("scanner error: %w", err)
+	}
+	return nil
+}
+
+// emit converts a LOG entry, or an ERROR entry that records the failed statement,
+// into a SQLTrace and hands it to the callback. Entries that do not log a statement
+// (checkpoints, connections, ...) are ignored.
+func (p *PostgresLogParser) emit(entry *pgLogEntry, callback func(models.SQLTrace) error) error {
+	var query, errorCode string
+	var latency time.Duration
+	if entry.severity == "ERROR" {
+		query = strings.TrimSpace(entry.statement)
+		errorCode = entry.sqlState
+	} else {
+		m := pgStatementRe.FindStringSubmatch(entry.message)
+		if m == nil {
+			return nil
+		}
+		query = strings.TrimSpace(m[2])
+		if m[1] != "" {
+			ms, _ := strconv.ParseFloat(m[1], 64)
+			latency = time.Duration(ms * float64(time.Millisecond))
+		}
+	}
+	if query == "" {
+		return nil
+	}
+
+	trace := models.SQLTrace{
+		Query:     RewritePgPlaceholders(query),
+		Timestamp: entry.timestamp,
+		Latency:   latency,
+		User:      entry.user,
+		Host:      entry.host,
+		Database:  entry.database,
+		SessionID: entry.sessionID,
+		ErrorCode: errorCode,
+	}
+	// A transaction id of 0 means no transaction id was assigned (read-only or autocommit).
+	if entry.txnID != "" && entry.txnID != "0" {
+		trace.TxnID = entry.txnID
+	}
+	if params := parsePgParameters(entry.detail); len(params) > 0 {
+		trace.Parameters = params
+	}
+	if entry.badTime {
+		p.Stats.addDefaulted()
+	}
+	p.Stats.addParsed()
+
+	if err := callback(trace); err != nil {
+		return fmt.Errorf("callback failed at line %d: %w", entry.line, err)
+	}
+	return nil
+}
+
+// RewritePgPlaceholders replaces positional $n placeholders outside string literals
+// with the named :pn form used by SQL templates.
+func RewritePgPlaceholders(query string) string {
+	if !strings.Contains(query, "$") {
+		return query
+	}
+	var b strings.Builder
+	inQuote := false
+	last := 0
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		if c == '\'' {
+			inQuote = !inQuote
+			continue
+		}
+		if inQuote || c != '$' {
+			continue
+		}
+		loc := pgParamRe.FindStringSubmatchIndex(query[i:])
+		if loc == nil || loc[0] != 0 {
+			continue
+		}
+		b.WriteString(query[last:i])
+		b.WriteString(":p")
+		b.WriteString(query[i+loc[2] : i+loc[3]])
+		i += loc[1] - 1
+		last = i + 1
+	}
+	b.WriteString(query[last:])
+	return b.String()
+}
+
+// parsePgParameters parses a "parameters: $1 = '42', $2 = NULL" detail message
+// into a map keyed by the rewritten placeholder names (":p1", ":p2").
+func parsePgParameters(detail string) map[string]interface{} {
+	const marker = "parameters:"
+	idx := strings.Index(detail, marker)
+	if idx < 0 {
+		return nil
+	}
+	rest := detail[idx+len(marker):]
+	params := make(map[string]interface{})
+
+	for {
+		rest = strings.TrimLeft(rest, " ,\n\t")
+		m := pgParamRe.FindStringSubmatchIndex(rest)
+		if m == nil || m[0] != 0 {
+			break
+		}
+		name := ":p" + rest[m[2]:m[3]]
+		rest = strings.TrimLeft(rest[m[1]:], " ")
+		if !strings.HasPrefix(rest, "=") {
+			break
+		}
+		rest = strings.TrimLeft(rest[1:], " ")
+
+		if strings.HasPrefix(rest, "NULL") {
+			params[name] = nil
+			rest = rest[len("NULL"):]
+			continue
+		}
+		if !strings.HasPrefix(rest, "'") {
+			break
+		}
+		value, n := readPgQuoted(rest)
+		params[name] = value
+		rest = rest[n:]
+	}
+	return params
+}
+
+// readPgQuoted reads a single-quoted literal, where a doubled quote is an escaped quote, from the start of s.
+// It returns the unescaped value and the number of bytes consumed.
+func readPgQuoted(s string) (string, int) {
+	var b strings.Builder
+	i := 1
+	for i < len(s) {
+		if s[i] == '\'' {
+			if i+1 < len(s) && s[i+1] == '\'' {
+				b.WriteByte('\'')
+				i += 2
+				continue
+			}
+			return b.String(), i + 1
+		}
+		b.WriteByte(s[i])
+		i++
+	}
+	return b.String(), len(s)
+}
+
+// parseTime parses a log time, reporting true when it could not be parsed.
+func (p *PostgresLogParser) parseTime(value string) (time.Time, bool) {
+	ts, err := parsePgLogTime(value, p.Location)
+	return ts, err != nil
+}
+
+func parsePgLogTime(value string, loc *time.Location) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if loc == nil {
+		loc = time.UTC
+	}
+	// jsonlog writes "2025-01-01 00:00:00.123 UTC"; some tools export RFC3339.
+	if ts, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return ts, nil
+	}
+	for _, layout := range pgLogTimeLayouts {
+		if ts, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return ts, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognized log time %q", value)
+}
+
+// stripPort removes the ":port" suffix from a csvlog connection_from value.
+func stripPort(connFrom string) string {
+	if i := strings.LastIndex(connFrom, ":"); i > 0 && !strings.Contains(connFrom[:i], ":") {
+		return connFrom[:i]
+	}
+	return connFrom
+}
@@ -0,0 +1,116 @@
+package parsers
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+)
+
+func collectTraces(t *testing.T, p TraceReader, input string) []models.SQLTrace {
+	t.Helper()
+	var traces []models.SQLTrace
+	err := p.Parse(strings.NewReader(input), func(trace models.SQLTrace) error {
+		traces = append(traces, trace)
+		return nil
+	})
+	require.NoError(t, err)
+	return traces
+}
+
+func TestPostgresLogParser_Stderr(t *testing.T) {
+	log := `2025-01-01 00:00:00.123 UTC [1234] app@shop LOG:  duration: 12.500 ms  statement: SELECT *
+	FROM orders
+	WHERE id = 1
+2025-01-01 00:00:01.000 UTC [1234] app@shop LOG:  checkpoint starting: time
+2025-01-01 00:00:02.000 UTC [1235] app@shop LOG:  duration: 0.250 ms  execute <unnamed>: SELECT * FROM users WHERE id = $1 AND name = $2
+2025-01-01 00:00:02.000 UTC [1235] app@shop DETAIL:  parameters: $1 = '42', $2 = 'O''Brien'
+2025-01-01 00:00:03.000 UTC [1236] app@shop ERROR:  relation "nope" does not exist
+`
+	traces := collectTraces(t, NewPostgresLogParser(TraceFormatPgLog, 0), log)
+	require.Len(t, traces, 2)
+
+	assert.Equal(t, "SELECT *\nFROM orders\nWHERE id = 1", traces[0].Query)
+	assert.Equal(t, 12500*time.Microsecond, traces[0].Latency)
+	assert.Equal(t, time.Date(2025, 1, 1, 0, 0, 0, 123000000, time.UTC), traces[0].Timestamp.UTC())
+	assert.Equal(t, "app", traces[0].User)
+	assert.Equal(t, "shop", traces[0].Database)
+	assert.Equal(t, "1234", traces[0].SessionID)
+
+	assert.Equal(t, "SELECT * FROM users WHERE id = :p1 AND name = :p2", traces[1].Query)
+	assert.Equal(t, map[string]interface{}{":p1": "42", ":p2": "O'Brien"}, traces[1].Parameters)
+}
+
+func TestPostgresLogParser_CSVLog(t *testing.T) {
+	log := `2025-01-01 00:00:00.123 UTC,"app","shop",1234,"10.0.0.5:51234",6774a1.4d2,1,"SELECT",2025-01-01 00:00:00 UTC,3/7,0,LOG,00000,"duration: 3.000 ms  execute S_1: SELECT * FROM users WHERE id = $1","parameters: $1 = '7'",,,,,,,,"psql","client backend",,0
+2025-01-01 00:00:01.000 UTC,"app","shop",1234,"10.0.0.5:51234",6774a1.4d2,2,"idle",2025-01-01 00:00:00 UTC,3/8,0,LOG,00000,"duration: 1.000 ms  statement: SELECT 'multi
+line'",,,,,,,,,"psql","client backend",,0
+2025-01-01 00:00:02.000 UTC,"app","shop",1234,"10.0.0.5:51234",6774a1.4d2,3,"INSERT",2025-01-01 00:00:00 UTC,3/9,7781,ERROR,23505,"duplicate key value violates unique constraint ""users_pkey""","Key (id)=(7) already exists.",,,,,"INSERT INTO users VALUES (7)",,,"psql","client backend",,0
+`
+	traces := collectTraces(t, NewPostgresLogParser(TraceFormatPgCSVLog, 0), log)
+	require.Len(t, traces, 3)
+
+	assert.Equal(t, "SELECT * FROM users WHERE id = :p1", traces[0].Query)
+	assert.Equal(t, map[string]interface{}{":p1": "7"}, traces[0].Parameters)
+	assert.Equal(t, 3*time.Millisecond, traces[0].Latency)
+	assert.Equal(t, "app", traces[0].User)
+	assert.Equal(t, "10.0.0.5", traces[0].Host)
+	assert.Equal(t, "shop", traces[0].Database)
+	assert.Equal(t, "6774a1.4d2", traces[0].SessionID)
+	assert.Empty(t, traces[0].TxnID)
+	assert.Empty(t, traces[0].ErrorCode)
+
+	assert.Equal(t, "SELECT 'multi\nline'", traces[1].Query)
+
+	assert.Equal(t, "INSERT INTO users VALUES (7)", traces[2].Query)
+	assert.Equal(t, "23505", traces[2].ErrorCode)
+	assert.Equal(t, "7781", traces[2].TxnID)
+}
+
+func TestPostgresLogParser_JSONLog(t *testing.T) {
+	log := `{"timestamp":"2025-01-01 00:00:00.123 UTC","user":"app","dbname":"shop","pid":1234,"remote_host":"10.0.0.5","error_severity":"LOG","message":"duration: 0.500 ms  statement: SELECT 1"}
+{"timestamp":"2025-01-01 00:00:01.000 UTC","user":"app","error_severity":"LOG","message":"connection authorized: user=app"}
+{"timestamp":"2025-01-01 00:00:02.000 UTC","user":"app","dbname":"shop","session_id":"6774a1.4d2","txid":7782,"error_severity":"ERROR","state_code":"42P01","message":"relation \"nope\" does not exist","statement":"SELECT * FROM nope"}
+`
+	traces := collectTraces(t, NewPostgresLogParser(TraceFormatPgJSONLog, 0), log)
+	require.Len(t, traces, 2)
+	assert.Equal(t, "SELECT 1", traces[0].Query)
+	assert.Equal(t, 500*time.Microsecond, traces[0].Latency)
+	assert.Equal(t, "10.0.0.5", traces[0].Host)
+	assert.Equal(t, "shop", traces[0].Database)
+
+	assert.Equal(t, "SELECT * FROM nope", traces[1].Query)
+	assert.Equal(t, "42P01", traces[1].ErrorCode)
+	assert.Equal(t, "6774a1.4d2", traces[1].SessionID)
+	assert.Equal(t, "7782", traces[1].TxnID)
+}
+
+func TestRewritePgPlaceholders(t *testing.T) {
+	assert.Equal(t, "SELECT :p1, '$2' FROM t WHERE a = :p10", RewritePgPlaceholders("SELECT $1, '$2' FROM t WHERE a = $10"))
+	assert.Equal(t, "SELECT 1", RewritePgPlaceholders("SELECT 1"))
+}
+
+func TestPgStatStatementsReader_ReadTemplates(t *testing.T) {
+	export := `userid,dbid,queryid,query,calls,total_exec_time,rows
+10,16384,1,"SELECT * FROM users WHERE id = $1",120,35.5,120
+11,16384,1,"SELECT * FROM users WHERE id = $1",30,8.1,30
+10,16384,2,"UPDATE orders SET status = $1 WHERE id = $2",50,12.0,50
+10,16384,3,"SELECT 1",0,0,0
+`
+	templates, err := NewPgStatStatementsReader().ReadTemplates(strings.NewReader(export))
+	require.NoError(t, err)
+	require.Len(t, templates, 2)
+
+	assert.Equal(t, "SELECT * FROM users WHERE id = :p1", templates[0].RawSQL)
+	assert.Equal(t, 150, templates[0].Weight)
+	assert.Equal(t, []string{":p1"}, templates[0].Parameters)
+
+	assert.Equal(t, 50, templates[1].Weight)
+	assert.Equal(t, []string{":p1", ":p2"}, templates[1].Parameters)
+
+	_, err = NewPgStatStatementsReader().ReadTemplates(strings.NewReader("a,b\n1,2\n"))
+	assert.Error(t, err)
+}
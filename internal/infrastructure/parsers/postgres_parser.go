@@ -0,0 +1,517 @@
+package parsers
+
+import (
+	"strings"
+	"sync/atomic"
+
+	"github.com/antlr4-go/antlr/v4"
+	postgresql "github.com/turtacn/SQLTraceBench/internal/infrastructure/parsers/antlr/postgresql"
+	"github.com/turtacn/SQLTraceBench/pkg/utils"
+)
+
+// PostgresParser extracts information from PostgreSQL queries with the generated
+// PostgreSQL grammar, which reads syntax the MySQL grammar cannot: "::" casts,
+// RETURNING, CTEs with MATERIALIZED, ON CONFLICT, FOR UPDATE OF. Statements the
+// grammar does not cover fall back to a tokenizer with PostgreSQL's lexical rules
+// (double-quoted identifiers, escape and dollar-quoted strings, nested comments).
+// The first fallback is logged as a warning and later ones at debug level; Fallbacks
+// counts them.
+type PostgresParser struct {
+	fallbacks atomic.Int64
+}
+
+// NewPostgresParser creates a new PostgresParser.
+func NewPostgresParser() *PostgresParser {
+	return &PostgresParser{}
+}
+
+// ListTables returns the tables a query reads or writes, in order of appearance and
+// without duplicates. Unquoted names are folded to lower case; CTE names, function
+// calls in FROM and subqueries are not tables.
+func (p *PostgresParser) ListTables(sql string) ([]string, error) {
+	errs := &syntaxErrorCounter{DefaultErrorListener: antlr.NewDefaultErrorListener()}
+
+	lexer := postgresql.NewPostgreSqlLexer(antlr.NewInputStream(sql))
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(errs)
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+
+	parser := postgresql.NewPostgreSqlParser(stream)
+	parser.RemoveErrorListeners()
+	parser.AddErrorListener(errs)
+	parser.BuildParseTrees = true
+
+	tree := parser.Query()
+	if errs.count > 0 {
+		p.fallback(sql, errs.first)
+		return listPostgresTables(sql), nil
+	}
+
+	listener := NewPostgresTableListener()
+	antlr.ParseTreeWalkerDefault.Walk(listener, tree)
+	return listener.TableNames(), nil
+}
+
+// Fallbacks returns the number of queries the grammar did not cover, whose tables
+// were listed by the tokenizer.
+func (p *PostgresParser) Fallbacks() int64 {
+	return p.fallbacks.Load()
+}
+
+func (p *PostgresParser) fallback(sql, reason string) {
+	logger := utils.GetGlobalLogger()
+	fields := logger.WithField("query", sql).WithField("error", reason)
+	if p.fallbacks.Add(1) == 1 {
+		fields.Warn("PostgreSQL grammar does not cover the query; listing its tables with the tokenizer")
+		return
+	}
+	fields.Debug("PostgreSQL grammar does not cover the query; listing its tables with the tokenizer")
+}
+
+// pgTokenKind classifies the tokens of a PostgreSQL query.
+type pgTokenKind int
+
+const (
+	pgWord   pgTokenKind = iota // keyword or unquoted identifier, lowercased
+	pgIdent                     // quoted identifier, unquoted
+	pgString                    // string literal
+	pgNumber
+	pgParam // $n or :name
+	pgOp    // operators and punctuation
+)
+
+type pgToken struct {
+	kind pgTokenKind
+	text string
+}
+
+// pgFromFunctions are the functions whose arguments use FROM as a keyword.
+var pgFromFunctions = map[string]bool{"extract": true, "substring": true, "trim": true, "overlay": true}
+
+// listPostgresTables is the tokenizer-based ListTables, used for statements outside
+// the PostgreSQL grammar.
+func listPostgresTables(sql string) []string {
+	tokens := lexPostgres(sql)
+	var (
+		tables  []string
+		seen    = make(map[string]bool)
+		ctes    = make(map[string]bool)
+		openers []string // the word before each open parenthesis
+	)
+	add := func(name string) {
+		if !seen[name] {
+			seen[name] = true
+			tables = append(tables, name)
+		}
+	}
+
+	for i := 0; i < len(tokens); i++ {
+		tok := tokens[i]
+		if tok.kind == pgOp {
+			switch tok.text {
+			case "(":
+				opener := ""
+				if i > 0 && (tokens[i-1].kind == pgWord || tokens[i-1].kind == pgIdent) {
+					opener = tokens[i-1].text
+				}
+				openers = append(openers, opener)
+			case ")":
+				if len(openers) > 0 {
+					openers = openers[:len(openers)-1]
+				}
+			}
+			continue
+		}
+		if tok.kind != pgWord {
+			continue
+		}
+		prev := ""
+		if i > 0 {
+			prev = tokens[i-1].text
+		}
+
+		switch tok.text {
+		case "with":
+			for _, name := range pgCTENames(tokens, i+1) {
+				ctes[name] = true
+			}
+		case "from":
+			if prev == "distinct" || len(openers) > 0 && pgFromFunctions[openers[len(openers)-1]] {
+				continue
+			}
+			pgTableList(tokens, i+1, true, add)
+		case "join":
+			pgTableList(tokens, i+1, false, add)
+		case "using":
+			// DELETE ... USING and MERGE ... USING; JOIN ... USING (columns) is skipped.
+			pgTableList(tokens, i+1, true, add)
+		case "into":
+			pgTarget(tokens, i+1, add)
+		case "update":
+			// Not a table in ON CONFLICT DO UPDATE and FOR [NO KEY] UPDATE.
+			if prev == "do" || prev == "for" || prev == "key" {
+				continue
+			}
+			pgTarget(tokens, i+1, add)
+		case "table":
+			if prev != "truncate" {
+				pgTableList(tokens, i+1, true, add)
+			}
+		case "truncate":
+			if i+1 < len(tokens) && tokens[i+1].text == "table" {
+				i++
+			}
+			pgTableList(tokens, i+1, true, add)
+		}
+	}
+
+	out := make([]string, 0, len(tables))
+	for _, name := range tables {
+		if !ctes[name] {
+			out = append(out, name)
+		}
+	}
+	return out
+}
+
+// pgCTENames returns the names of the common table expressions of a WITH clause whose
+// first token is at i, or nil if the tokens are not a CTE list (as in "timestamp with
+// time zone" or "WITH ORDINALITY").
+func pgCTENames(tokens []pgToken, i int) []string {
+	if i < len(tokens) && tokens[i].text == "recursive" {
+		i++
+	}
+	var names []string
+	for i < len(tokens) && (tokens[i].kind == pgWord || tokens[i].kind == pgIdent) {
+		name := tokens[i].text
+		i++
+		if i < len(tokens) && tokens[i].text == "(" {
+			i = pgSkipGroup(tokens, i)
+		}
+		if i >= len(tokens) || tokens[i].text != "as" {
+			return names
+		}
+		i++
+		for i < len(tokens) && (tokens[i].text == "not" || tokens[i].text == "materialized") {
+			i++
+		}
+		if i >= len(tokens) || tokens[i].text != "(" {
+			return names
+		}
+		names = append(names, name)
+		i = pgSkipGroup(tokens, i)
+		if i >= len(tokens) || tokens[i].text != "," {
+			return names
+		}
+		i++
+	}
+	return names
+}
+
+// pgTableList reads the table references starting at i. With list set, it follows
+// comma-separated references as in "FROM a x, b y".
+func pgTableList(tokens []pgToken, i int, list bool, add func(string)) {
+	for i < len(tokens) {
+		for i < len(tokens) && (tokens[i].text == "only" || tokens[i].text == "lateral") {
+			i++
+		}
+		name, next := pgQualifiedName(tokens, i)
+		if name == "" {
+			return
+		}
+		if next < len(tokens) && tokens[next].text == "(" {
+			// A function call such as generate_series(1, 10).
+			next = pgSkipGroup(tokens, next)
+		} else {
+			add(name)
+		}
+		if next < len(tokens) && tokens[next].text == "*" {
+			next++ // inheritance: FROM parent *
+		}
+		// Skip the alias and its column names.
+		if next < len(tokens) && tokens[next].text == "as" {
+			next++
+		}
+		if next < len(tokens) && (tokens[next].kind == pgIdent || tokens[next].kind == pgWord && !pgClauseWords[tokens[next].text]) {
+			next++
+			if next < len(tokens) && tokens[next].text == "(" {
+				next = pgSkipGroup(tokens, next)
+			}
+		}
+		if !list || next >= len(tokens) || tokens[next].text != "," {
+			return
+		}
+		i = next + 1
+	}
+}
+
+// pgTarget reads the target table of INSERT INTO, UPDATE and MERGE INTO.
+func pgTarget(tokens []pgToken, i int, add func(string)) {
+	if i < len(tokens) && tokens[i].text == "only" {
+		i++
+	}
+	if name, _ := pgQualifiedName(tokens, i); name != "" {
+		add(name)
+	}
+}
+
+// pgQualifiedName reads a possibly schema-qualified name at i and returns it with the
+// index of the next token. It returns "" if there is no name at i.
+func pgQualifiedName(tokens []pgToken, i int) (string, int) {
+	var parts []string
+	for i < len(tokens) {
+		tok := tokens[i]
+		if tok.kind != pgIdent && (tok.kind != pgWord || len(parts) == 0 && pgClauseWords[tok.text]) {
+			break
+		}
+		parts = append(parts, tok.text)
+		i++
+		if i >= len(tokens) || tokens[i].text != "." {
+			break
+		}
+		i++
+	}
+	return strings.Join(parts, "."), i
+}
+
+// pgSkipGroup returns the index after the parenthesized group opening at i.
+func pgSkipGroup(tokens []pgToken, i int) int {
+	depth := 0
+	for ; i < len(tokens); i++ {
+		switch tokens[i].text {
+		case "(":
+			depth++
+		case ")":
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+	return i
+}
+
+// pgClauseWords are reserved words that end a table reference.
+var pgClauseWords = map[string]bool{
+	"select": true, "where": true, "on": true, "using": true, "join": true, "inner": true,
+	"left": true, "right": true, "full": true, "cross": true, "natural": true, "group": true,
+	"order": true, "having": true, "limit": true, "offset": true, "fetch": true, "for": true,
+	"union": true, "intersect": true, "except": true, "window": true, "returning": true,
+	"set": true, "values": true, "default": true, "do": true, "when": true, "then": true,
+	"tablesample": true, "with": true, "lateral": true, "only": true, "as": true,
+}
+
+// lexPostgres splits a query into tokens. Comments are dropped and cast types are
+// skipped, so "ts::timestamp with time zone" yields only "ts".
+func lexPostgres(sql string) []pgToken {
+	var tokens []pgToken
+	for i := 0; i < len(sql); {
+		c := sql[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n' || c == '\f':
+			i++
+		case strings.HasPrefix(sql[i:], "--"):
+			if end := strings.IndexByte(sql[i:], '\n'); end >= 0 {
+				i += end + 1
+			} else {
+				i = len(sql)
+			}
+		case strings.HasPrefix(sql[i:], "/*"):
+			i = pgSkipComment(sql, i)
+		case c == '\'':
+			end := pgScanString(sql, i, false)
+			tokens = append(tokens, pgToken{kind: pgString, text: sql[i:end]})
+			i = end
+		case (c == 'e' || c == 'E') && i+1 < len(sql) && sql[i+1] == '\'':
+			end := pgScanString(sql, i+1, true)
+			tokens = append(tokens, pgToken{kind: pgString, text: sql[i:end]})
+			i = end
+		case c == '"':
+			end := pgScanString(sql, i, false)
+			tokens = append(tokens, pgToken{kind: pgIdent, text: strings.ReplaceAll(sql[i+1:end-1], `""`, `"`)})
+			i = end
+		case c == '$' && i+1 < len(sql) && sql[i+1] >= '0' && sql[i+1] <= '9':
+			end := i + 1
+			for end < len(sql) && sql[end] >= '0' && sql[end] <= '9' {
+				end++
+			}
+			tokens = append(tokens, pgToken{kind: pgParam, text: sql[i:end]})
+			i = end
+		case c == '$':
+			if end, ok := pgScanDollarQuoted(sql, i); ok {
+				tokens = append(tokens, pgToken{kind: pgString, text: sql[i:end]})
+				i = end
+			} else {
+				tokens = append(tokens, pgToken{kind: pgOp, text: "$"})
+				i++
+			}
+		case strings.HasPrefix(sql[i:], "::"):
+			i = pgSkipCastType(sql, i+2)
+		case c == ':' && i+1 < len(sql) && isPgWordStart(sql[i+1]):
+			end := i + 1
+			for end < len(sql) && isPgWordByte(sql[end]) {
+				end++
+			}
+			tokens = append(tokens, pgToken{kind: pgParam, text: sql[i:end]})
+			i = end
+		case c >= '0' && c <= '9':
+			end := i
+			for end < len(sql) && (isPgWordByte(sql[end]) || sql[end] == '.') {
+				end++
+			}
+			tokens = append(tokens, pgToken{kind: pgNumber, text: sql[i:end]})
+			i = end
+		case isPgWordStart(c):
+			end := i
+			for end < len(sql) && isPgWordByte(sql[end]) {
+				end++
+			}
+			tokens = append(tokens, pgToken{kind: pgWord, text: strings.ToLower(sql[i:end])})
+			i = end
+		default:
+			tokens = append(tokens, pgToken{kind: pgOp, text: sql[i : i+1]})
+			i++
+		}
+	}
+	return tokens
+}
+
+// pgSkipComment returns the index after the (possibly nested) block comment at i.
+func pgSkipComment(sql string, i int) int {
+	depth := 0
+	for i < len(sql) {
+		switch {
+		case strings.HasPrefix(sql[i:], "/*"):
+			depth++
+			i += 2
+		case strings.HasPrefix(sql[i:], "*/"):
+			depth--
+			i += 2
+			if depth == 0 {
+				return i
+			}
+		default:
+			i++
+		}
+	}
+	return i
+}
+
+// pgScanString returns the index after the quoted string or identifier at i. A
+// doubled quote is part of it, as is a backslash escape in E'...' strings.
+func pgScanString(sql string, i int, escapes bool) int {
+	quote := sql[i]
+	for j := i + 1; j < len(sql); j++ {
+		switch {
+		case escapes && sql[j] == '\\':
+			j++
+		case sql[j] == quote:
+			if j+1 < len(sql) && sql[j+1] == quote {
+				j++
+				continue
+			}
+			return j + 1
+		}
+	}
+	return len(sql)
+}
+
+// pgScanDollarQuoted returns the index after the dollar-quoted string ($$...$$ or
+// $tag$...$tag$) at i.
+func pgScanDollarQuoted(sql string, i int) (int, bool) {
+	end := i + 1
+	for end < len(sql) && sql[end] != '$' {
+		if !isPgWordByte(sql[end]) {
+			return 0, false
+		}
+		end++
+	}
+	if end >= len(sql) {
+		return 0, false
+	}
+	tag := sql[i : end+1]
+	close := strings.Index(sql[end+1:], tag)
+	if close < 0 {
+		return len(sql), true
+	}
+	return end + 1 + close + len(tag), true
+}
+
+// pgMultiWordTypes maps the first word of a multi-word type name to the words that
+// may follow it.
+var pgMultiWordTypes = map[string][]string{
+	"double":    {"precision"},
+	"character": {"varying"},
+	"bit":       {"varying"},
+	"timestamp": {"with", "without", "time", "zone"},
+	"time":      {"with", "without", "time", "zone"},
+	"interval":  {"year", "month", "day", "hour", "minute", "second", "to"},
+}
+
+// pgSkipCastType returns the index after the type name of a "::" cast at i, including
+// its schema, modifiers and array brackets.
+func pgSkipCastType(sql string, i int) int {
+	skipSpace := func(i int) int {
+		for i < len(sql) && (sql[i] == ' ' || sql[i] == '\t' || sql[i] == '\r' || sql[i] == '\n') {
+			i++
+		}
+		return i
+	}
+	word := func(i int) (string, int) {
+		i = skipSpace(i)
+		if i < len(sql) && sql[i] == '"' {
+			end := pgScanString(sql, i, false)
+			return sql[i:end], end
+		}
+		end := i
+		for end < len(sql) && isPgWordByte(sql[end]) {
+			end++
+		}
+		return strings.ToLower(sql[i:end]), end
+	}
+
+	first, i := word(i)
+	for i < len(sql) && sql[i] == '.' {
+		first, i = word(i + 1)
+	}
+	if follow, ok := pgMultiWordTypes[first]; ok {
+		for {
+			next, end := word(i)
+			if !containsString(follow, next) {
+				break
+			}
+			i = end
+		}
+	}
+	for {
+		j := skipSpace(i)
+		switch {
+		case j < len(sql) && sql[j] == '(':
+			if end := strings.IndexByte(sql[j:], ')'); end >= 0 {
+				i = j + end + 1
+				continue
+			}
+		case strings.HasPrefix(sql[j:], "[]"):
+			i = j + 2
+			continue
+		}
+		return i
+	}
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func isPgWordStart(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= 0x80
+}
+
+func isPgWordByte(c byte) bool {
+	return isPgWordStart(c) || c >= '0' && c <= '9' || c == '$'
+}
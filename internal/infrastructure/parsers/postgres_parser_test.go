@@ -0,0 +1,123 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	postgresql "github.com/turtacn/SQLTraceBench/internal/infrastructure/parsers/antlr/postgresql"
+)
+
+func TestPostgresParser_ListTables(t *testing.T) {
+	testCases := []struct {
+		name     string
+		sql      string
+		expected []string
+		// fallback is set if the grammar does not cover the query.
+		fallback bool
+	}{
+		{
+			name:     "casts",
+			sql:      "SELECT id::text, created_at::timestamp with time zone FROM events e WHERE tags::varchar(20)[] && $1 AND amount::double precision > 0",
+			expected: []string{"events"},
+		},
+		{
+			name:     "returning",
+			sql:      "DELETE FROM sessions USING users u WHERE sessions.user_id = u.id RETURNING sessions.id",
+			expected: []string{"sessions", "users"},
+		},
+		{
+			name:     "materialized cte",
+			sql:      "WITH recent AS MATERIALIZED (SELECT * FROM orders WHERE ts > now() - interval '1 day'), top AS NOT MATERIALIZED (SELECT user_id FROM recent) SELECT * FROM top JOIN public.users ON users.id = top.user_id",
+			expected: []string{"orders", "public.users"},
+		},
+		{
+			name:     "on conflict",
+			sql:      "INSERT INTO counters (key, n) VALUES ($1, 1) ON CONFLICT (key) DO UPDATE SET n = counters.n + 1 RETURNING n",
+			expected: []string{"counters"},
+		},
+		{
+			name:     "quoted identifiers",
+			sql:      `UPDATE "Accounts" SET balance = balance - $1 FROM "billing"."Ledger" l WHERE "Accounts".id = l.account_id`,
+			expected: []string{"Accounts", "billing.Ledger"},
+		},
+		{
+			name:     "comma join, functions and subqueries",
+			sql:      "SELECT * FROM a, ONLY b AS x, generate_series(1, 10) g, LATERAL (SELECT * FROM c WHERE c.id = a.id) s WHERE extract(epoch FROM a.ts) > 0",
+			expected: []string{"a", "b", "c"},
+		},
+		{
+			name:     "locking and distinct from",
+			sql:      "SELECT * FROM jobs WHERE state IS DISTINCT FROM 'done' FOR NO KEY UPDATE SKIP LOCKED",
+			expected: []string{"jobs"},
+		},
+		{
+			name:     "literals and comments",
+			sql:      "SELECT $$ FROM fake $$, E'from \\' x', 'join y' /* FROM /* nested */ z */ FROM real_table -- FROM other",
+			expected: []string{"real_table"},
+			// The lexer does not nest block comments.
+			fallback: true,
+		},
+		{
+			name:     "join using",
+			sql:      "SELECT * FROM a JOIN b USING (id) LEFT JOIN c USING (x, y)",
+			expected: []string{"a", "b", "c"},
+		},
+		{
+			name:     "truncate",
+			sql:      "TRUNCATE TABLE logs, audit",
+			expected: []string{"logs", "audit"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			parser := NewPostgresParser()
+			tables, err := parser.ListTables(tc.sql)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, tables)
+			assert.Equal(t, tc.fallback, parser.Fallbacks() > 0)
+		})
+	}
+}
+
+func TestPostgresTableListener(t *testing.T) {
+	testCases := []struct {
+		name     string
+		sql      string
+		expected []string
+	}{
+		{
+			name:     "cte names are not tables",
+			sql:      "WITH recent AS MATERIALIZED (SELECT * FROM orders) SELECT * FROM recent JOIN users ON users.id = recent.user_id",
+			expected: []string{"orders", "users"},
+		},
+		{
+			name:     "functions in from are not tables",
+			sql:      "SELECT * FROM generate_series(1, 10) g CROSS JOIN ONLY Events e",
+			expected: []string{"events"},
+		},
+		{
+			name:     "write targets",
+			sql:      `INSERT INTO "billing"."Ledger" (key) SELECT id FROM accounts ON CONFLICT DO NOTHING RETURNING key`,
+			expected: []string{"billing.Ledger", "accounts"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := &syntaxErrorCounter{DefaultErrorListener: antlr.NewDefaultErrorListener()}
+			lexer := postgresql.NewPostgreSqlLexer(antlr.NewInputStream(tc.sql))
+			parser := postgresql.NewPostgreSqlParser(antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel))
+			parser.RemoveErrorListeners()
+			parser.AddErrorListener(errs)
+			tree := parser.Query()
+			require.Zero(t, errs.count, "the grammar should cover the query")
+
+			listener := NewPostgresTableListener()
+			antlr.ParseTreeWalkerDefault.Walk(listener, tree)
+			assert.Equal(t, tc.expected, listener.TableNames())
+		})
+	}
+}
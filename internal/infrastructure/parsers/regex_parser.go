@@ -7,8 +7,12 @@ import (
 )
 
 // re is the regular expression used to find table names in SQL queries.
-// It specifically looks for tables following `FROM` or `JOIN` clauses.
-var re = regexp.MustCompile(`(?is)(?:from|join)\s+([a-zA-Z0-9_\.]+)`)
+// It looks for tables following `FROM`, `JOIN`, `INTO` and `UPDATE` keywords.
+var re = regexp.MustCompile("(?is)\\b(from|join|into|update)\\s+([a-zA-Z0-9_\\.`\"]+)")
+
+// lockingWordRe matches the words before an UPDATE keyword that does not name a table,
+// as in `FOR UPDATE` and `ON DUPLICATE KEY UPDATE`.
+var lockingWordRe = regexp.MustCompile(`(?i)\b(for|key)\s+$`)
 
 // RegexParser is a SQL parser that uses regular expressions to extract information from queries.
 type RegexParser struct{}
@@ -19,9 +23,9 @@ func NewRegexParser() *RegexParser {
 }
 
 // ListTables extracts table names from a SQL query using a regular expression.
-// It finds all matches for tables in `FROM` and `JOIN` clauses and returns a deduplicated slice of table names.
+// It finds all matches for tables in `FROM`, `JOIN`, `INTO` and `UPDATE` clauses and returns a deduplicated slice of table names.
 func (p *RegexParser) ListTables(sql string) ([]string, error) {
-	matches := re.FindAllStringSubmatch(sql, -1)
+	matches := re.FindAllStringSubmatchIndex(sql, -1)
 	if matches == nil {
 		return []string{}, nil
 	}
@@ -29,13 +33,14 @@ func (p *RegexParser) ListTables(sql string) ([]string, error) {
 	seen := make(map[string]struct{})
 	tables := make([]string, 0)
 	for _, match := range matches {
-		if len(match) > 1 {
-			tableName := strings.TrimSpace(match[1])
-			if _, ok := seen[tableName]; !ok {
-				seen[tableName] = struct{}{}
-				tables = append(tables, tableName)
-			}
+		if strings.EqualFold(sql[match[2]:match[3]], "update") && lockingWordRe.MatchString(sql[:match[0]]) {
+			continue
+		}
+		tableName := strings.NewReplacer("`", "", `"`, "").Replace(strings.TrimSpace(sql[match[4]:match[5]]))
+		if _, ok := seen[tableName]; !ok {
+			seen[tableName] = struct{}{}
+			tables = append(tables, tableName)
 		}
 	}
 	return tables, nil
-}
\ No newline at end of file
+}
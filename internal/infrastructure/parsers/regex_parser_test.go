@@ -38,6 +38,16 @@ func TestRegexParser_ListTables(t *testing.T) {
 			sql:      "select 1 + 1",
 			expected: []string{},
 		},
+		{
+			name:     "insert and update targets",
+			sql:      "insert into `orders` select * from staging_orders on duplicate key update total = values(total)",
+			expected: []string{"orders", "staging_orders"},
+		},
+		{
+			name:     "locking read",
+			sql:      "UPDATE accounts SET balance = 0 WHERE id IN (SELECT id FROM holds FOR UPDATE skip locked)",
+			expected: []string{"accounts", "holds"},
+		},
 		{
 			name:     "deduplicate tables",
 			sql:      "select * from users join users_metadata on users.id = users_metadata.user_id",
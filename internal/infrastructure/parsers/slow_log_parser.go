@@ -0,0 +1,295 @@
+package parsers
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+	"github.com/turtacn/SQLTraceBench/pkg/utils"
+)
+
+var (
+	// userHostRe matches "# User@Host: app[app] @ localhost [10.0.0.1]  Id:    12".
+	userHostRe = regexp.MustCompile(`^#\s*User@Host:\s*([^\[\s]*)(?:\[[^\]]*\])?\s*@\s*([^\s\[]*)\s*(?:\[([^\]]*)\])?`)
+	// slowLogIDRe matches the connection id at the end of the "# User@Host:" header.
+	slowLogIDRe = regexp.MustCompile(`\bId:\s*(\d+)`)
+	// slowLogKVRe matches the "Key: value" pairs of the "# Query_time:" header line.
+	slowLogKVRe = regexp.MustCompile(`([A-Za-z_]+):\s*([0-9.]+)`)
+	// setTimestampRe matches "SET timestamp=1735689600;".
+	setTimestampRe = regexp.MustCompile(`(?i)^SET\s+timestamp\s*=\s*([0-9.]+)\s*;?\s*$`)
+)
+
+// slowLogTimeLayouts lists the "# Time:" formats written by MySQL 5.7+/8.0 and by MySQL 5.6 and older.
+var slowLogTimeLayouts = []string{
+	time.RFC3339Nano,
+	"060102 15:04:05",
+	"060102  15:04:05",
+}
+
+// SlowLogParser parses MySQL slow query log files in a streaming fashion.
+// Each entry is introduced by "# Time:" / "# User@Host:" / "# Query_time:" headers,
+// optionally followed by "use <db>;" and "SET timestamp=<epoch>;", and then the
+// statement itself, which may span multiple lines.
+type SlowLogParser struct {
+	BufferSize int
+	// Location applies to the zone-less "# Time:" headers of MySQL 5.6 and older. Defaults to UTC.
+	Location *time.Location
+	// Stats, when set, counts defaulted entries.
+	Stats *ParseStats
+}
+
+// NewSlowLogParser creates a new SlowLogParser with the given buffer size.
+func NewSlowLogParser(bufferSize int) *SlowLogParser {
+	if bufferSize <= 0 {
+		bufferSize = 1024 * 1024 // Default 1MB
+	}
+	return &SlowLogParser{
+		BufferSize: bufferSize,
+	}
+}
+
+// slowLogEntry accumulates the header fields and statement lines of a single slow log entry.
+type slowLogEntry struct {
+	logTime      time.Time
+	execTime     time.Time
+	user         string
+	host         string
+	queryTime    float64
+	rowsExamined int64
+	rowsAffected int64
+	sessionID    string
+	database     string
+	errorCode    string
+	hasHeader    bool
+	badTime      bool
+	lines        []string
+	startLine    int
+}
+
+func (e *slowLogEntry) empty() bool {
+	return !e.hasHeader && len(e.lines) == 0
+}
+
+// Parse reads a MySQL slow query log and calls the callback for every statement found.
+func (p *SlowLogParser) Parse(reader io.Reader, callback func(models.SQLTrace) error) error {
+	scanner := bufio.NewScanner(reader)
+	buf := make([]byte, 64*1024)
+	bufferSize := p.BufferSize
+	if bufferSize == 0 {
+		bufferSize = 1024 * 1024 // Default fallback
+	}
+	scanner.Buffer(buf, bufferSize)
+
+	logger := utils.GetGlobalLogger()
+	lineNum := 0
+	entry := &slowLogEntry{}
+
+	// lastLogTime carries the most recent "# Time:" header forward, since MySQL only writes
+	// it when the second changes and consecutive entries share it.
+	var lastLogTime time.Time
+	// lastDB carries the default database forward, since mysqld only writes "use <db>;"
+	// when it differs from the database of the previously logged statement.
+	var lastDB string
+
+	flush := func() error {
+		if entry.empty() {
+			return nil
+		}
+		if entry.database != "" {
+			lastDB = entry.database
+		} else {
+			entry.database = lastDB
+		}
+		trace, ok := entry.toTrace(lastLogTime)
+		startLine := entry.startLine
+		badTime := entry.badTime
+		entry = &slowLogEntry{}
+		if !ok {
+			return nil
+		}
+		if badTime {
+			p.Stats.addDefaulted()
+		}
+		p.Stats.addParsed()
+		if err := callback(trace); err != nil {
+			return fmt.Errorf("callback failed at line %d: %w", startLine, err)
+		}
+		return nil
+	}
+
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimRight(scanner.Text(), "\r")
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			continue
+		}
+
+		if strings.HasPrefix(trimmed, "#") {
+			// A header after statement lines starts a new entry.
+			if len(entry.lines) > 0 {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+			if entry.startLine == 0 {
+				entry.startLine = lineNum
+			}
+			p.parseHeader(entry, trimmed, lineNum, logger)
+			if !entry.logTime.IsZero() {
+				lastLogTime = entry.logTime
+			}
+			continue
+		}
+
+		if isSlowLogPreamble(trimmed) {
+			continue
+		}
+
+		if m := setTimestampRe.FindStringSubmatch(trimmed); m != nil && len(entry.lines) == 0 {
+			if secs, err := strconv.ParseFloat(m[1], 64); err == nil {
+				entry.execTime = epochSecondsToTime(secs)
+			}
+			continue
+		}
+
+		// "use <db>;" precedes the statement when the default database changed.
+		if len(entry.lines) == 0 && isUseStatement(trimmed) {
+			entry.database = useStatementDB(trimmed)
+			continue
+		}
+
+		if entry.startLine == 0 {
+			entry.startLine = lineNum
+		}
+		entry.lines = append(entry.lines, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("scanner error: %w", err)
+	}
+
+	return flush()
+}
+
+// parseHeader applies a single "#" comment line to the entry being built.
+func (p *SlowLogParser) parseHeader(entry *slowLogEntry, line string, lineNum int, logger *utils.Logger) {
+	body := strings.TrimSpace(strings.TrimPrefix(line, "#"))
+	switch {
+	case strings.HasPrefix(body, "Time:"):
+		value := strings.TrimSpace(strings.TrimPrefix(body, "Time:"))
+		ts, err := parseSlowLogTime(value, p.Location)
+		if err != nil {
+			logger.Error("Invalid slow log time header", utils.Field{Key: "line", Value: lineNum}, utils.Field{Key: "time", Value: value})
+			entry.badTime = true
+			return
+		}
+		entry.logTime = ts
+		entry.hasHeader = true
+	case strings.HasPrefix(body, "User@Host:"):
+		if m := userHostRe.FindStringSubmatch(line); m != nil {
+			entry.user = m[1]
+			entry.host = m[2]
+			if entry.host == "" {
+				entry.host = m[3]
+			}
+		}
+		if m := slowLogIDRe.FindStringSubmatch(line); m != nil {
+			entry.sessionID = m[1]
+		}
+		entry.hasHeader = true
+	case strings.HasPrefix(body, "Query_time:"):
+		for _, kv := range slowLogKVRe.FindAllStringSubmatch(body, -1) {
+			switch kv[1] {
+			case "Query_time":
+				entry.queryTime, _ = strconv.ParseFloat(kv[2], 64)
+			case "Rows_examined":
+				entry.rowsExamined, _ = strconv.ParseInt(kv[2], 10, 64)
+			case "Rows_affected":
+				entry.rowsAffected, _ = strconv.ParseInt(kv[2], 10, 64)
+			case "Thread_id":
+				entry.sessionID = kv[2]
+			case "Errno":
+				if kv[2] != "0" {
+					entry.errorCode = kv[2]
+				}
+			}
+		}
+		entry.hasHeader = true
+	}
+	// Other comment lines (e.g. Percona's "# Bytes_sent:" or "# administrator command:") are ignored.
+}
+
+// toTrace converts the accumulated entry into a SQLTrace.
+// It reports false for entries without a statement.
+func (e *slowLogEntry) toTrace(lastLogTime time.Time) (models.SQLTrace, bool) {
+	query := strings.TrimSpace(strings.Join(e.lines, "\n"))
+	query = strings.TrimSpace(strings.TrimSuffix(query, ";"))
+	if query == "" {
+		return models.SQLTrace{}, false
+	}
+
+	// SET timestamp is the statement start time; "# Time:" is when the entry was written.
+	ts := e.execTime
+	if ts.IsZero() {
+		ts = e.logTime
+	}
+	if ts.IsZero() {
+		ts = lastLogTime
+	}
+
+	return models.SQLTrace{
+		Query:        query,
+		Timestamp:    ts,
+		Latency:      time.Duration(e.queryTime * float64(time.Second)),
+		User:         e.user,
+		Host:         e.host,
+		RowsExamined: e.rowsExamined,
+		RowsAffected: e.rowsAffected,
+		SessionID:    e.sessionID,
+		Database:     e.database,
+		ErrorCode:    e.errorCode,
+	}, true
+}
+
+func parseSlowLogTime(value string, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	for _, layout := range slowLogTimeLayouts {
+		if ts, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return ts, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognized slow log time %q", value)
+}
+
+func epochSecondsToTime(secs float64) time.Time {
+	whole := int64(secs)
+	frac := int64((secs - float64(whole)) * float64(time.Second))
+	return time.Unix(whole, frac).UTC()
+}
+
+func isUseStatement(line string) bool {
+	fields := strings.Fields(line)
+	return len(fields) == 2 && strings.EqualFold(fields[0], "use") && strings.HasSuffix(fields[1], ";")
+}
+
+// useStatementDB returns the database named by a "use <db>;" line.
+func useStatementDB(line string) string {
+	fields := strings.Fields(line)
+	return strings.Trim(strings.TrimSuffix(fields[1], ";"), "`")
+}
+
+// isSlowLogPreamble reports whether the line is part of the banner mysqld writes
+// when it (re)opens the slow log.
+func isSlowLogPreamble(line string) bool {
+	return strings.Contains(line, ", Version: ") && strings.HasSuffix(line, "started with:") ||
+		strings.HasPrefix(line, "Tcp port:") ||
+		strings.HasPrefix(line, "Time ") && strings.Contains(line, "Id Command")
+}
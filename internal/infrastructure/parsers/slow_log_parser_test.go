@@ -0,0 +1,106 @@
+package parsers
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+)
+
+const sampleSlowLog = `/usr/sbin/mysqld, Version: 8.0.36 (MySQL Community Server - GPL). started with:
+Tcp port: 3306  Unix socket: /var/run/mysqld/mysqld.sock
+Time                 Id Command    Argument
+# Time: 2025-01-01T00:00:01.000123Z
+# User@Host: app[app] @ localhost [127.0.0.1]  Id:    12
+# Query_time: 0.250000  Lock_time: 0.000061 Rows_sent: 1  Rows_examined: 1042
+use shop;
+SET timestamp=1735689600;
+SELECT *
+FROM orders
+WHERE user_id = 7;
+# User@Host: report[report] @  [10.0.0.5]  Id:    13
+# Query_time: 1.5  Lock_time: 0.0 Rows_sent: 0  Rows_examined: 99 Thread_id: 13 Errno: 1213 Killed: 0 Rows_affected: 1
+SET timestamp=1735689601;
+UPDATE orders SET status = 'shipped' WHERE id = 1;
+# Time: 2025-01-01T00:00:05Z
+# User@Host: app[app] @ localhost []  Id:    12
+# Query_time: 0.000100  Lock_time: 0.0 Rows_sent: 0  Rows_examined: 0
+# administrator command: Ping;
+`
+
+func TestSlowLogParser_Parse(t *testing.T) {
+	parser := NewSlowLogParser(0)
+
+	var traces []models.SQLTrace
+	err := parser.Parse(strings.NewReader(sampleSlowLog), func(trace models.SQLTrace) error {
+		traces = append(traces, trace)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, traces, 2)
+
+	first := traces[0]
+	assert.Equal(t, "SELECT *\nFROM orders\nWHERE user_id = 7", first.Query)
+	assert.Equal(t, time.Unix(1735689600, 0).UTC(), first.Timestamp)
+	assert.Equal(t, 250*time.Millisecond, first.Latency)
+	assert.Equal(t, int64(1042), first.RowsExamined)
+	assert.Equal(t, "app", first.User)
+	assert.Equal(t, "localhost", first.Host)
+	assert.Equal(t, "12", first.SessionID)
+	assert.Equal(t, "shop", first.Database)
+	assert.Empty(t, first.ErrorCode)
+
+	second := traces[1]
+	assert.Equal(t, "UPDATE orders SET status = 'shipped' WHERE id = 1", second.Query)
+	assert.Equal(t, 1500*time.Millisecond, second.Latency)
+	assert.Equal(t, int64(99), second.RowsExamined)
+	assert.Equal(t, "report", second.User)
+	assert.Equal(t, "10.0.0.5", second.Host)
+	assert.Equal(t, "13", second.SessionID)
+	assert.Equal(t, "shop", second.Database, "database carries over until the next use statement")
+	assert.Equal(t, "1213", second.ErrorCode)
+	assert.Equal(t, int64(1), second.RowsAffected)
+}
+
+func TestSlowLogParser_LegacyTimeHeader(t *testing.T) {
+	log := `# Time: 150101  9:05:07
+# User@Host: root[root] @ localhost []
+# Query_time: 2.000000  Lock_time: 0.000000 Rows_sent: 1  Rows_examined: 5
+SELECT SLEEP(2);
+`
+	parser := NewSlowLogParser(0)
+
+	var traces []models.SQLTrace
+	err := parser.Parse(strings.NewReader(log), func(trace models.SQLTrace) error {
+		traces = append(traces, trace)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, traces, 1)
+	assert.Equal(t, "SELECT SLEEP(2)", traces[0].Query)
+	assert.Equal(t, time.Date(2015, 1, 1, 9, 5, 7, 0, time.UTC), traces[0].Timestamp)
+	assert.Equal(t, 2*time.Second, traces[0].Latency)
+}
+
+func TestSlowLogParser_LegacyTimeHeaderLocation(t *testing.T) {
+	log := `# Time: 150101  9:05:07
+# Query_time: 1.000000  Lock_time: 0.000000 Rows_sent: 1  Rows_examined: 1
+SELECT 1;
+`
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	parser := NewSlowLogParser(0)
+	parser.Location = berlin
+
+	var traces []models.SQLTrace
+	err = parser.Parse(strings.NewReader(log), func(trace models.SQLTrace) error {
+		traces = append(traces, trace)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, traces, 1)
+	assert.True(t, traces[0].Timestamp.Equal(time.Date(2015, 1, 1, 8, 5, 7, 0, time.UTC)))
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
 	"time"
 
@@ -16,6 +17,18 @@ import (
 // StreamingTraceParser is responsible for parsing trace files in a streaming fashion.
 type StreamingTraceParser struct {
 	BufferSize int
+
+	// TimestampField and LatencyField override the "timestamp" and "latency" keys.
+	TimestampField string
+	LatencyField   string
+	// TimestampLayouts are tried in order; see parseTimestamp for the default.
+	TimestampLayouts []string
+	// Location applies to timestamps without a zone. Defaults to UTC.
+	Location *time.Location
+	// LatencyUnit is the unit of numeric latencies. Defaults to seconds.
+	LatencyUnit time.Duration
+	// Stats, when set, counts skipped and defaulted lines and enforces the error threshold.
+	Stats *ParseStats
 }
 
 // NewStreamingTraceParser creates a new StreamingTraceParser with the given buffer size.
@@ -50,10 +63,50 @@ func (p *StreamingTraceParser) ListTables(sql string) ([]string, error) {
 // traceDTO is a data transfer object used for unmarshalling the JSON lines.
 // It handles the mapping between the JSON fields and the models.SQLTrace struct.
 type traceDTO struct {
-	Query     string  `json:"query_text"`
-	QueryAlt  string  `json:"query"` // Fallback
-	Timestamp string  `json:"timestamp"`
-	Latency   float64 `json:"latency"`
+	Query     string          `json:"query_text"`
+	QueryAlt  string          `json:"query,omitempty"` // Fallback
+	Timestamp json.RawMessage `json:"timestamp"`       // RFC3339 string or epoch number
+	Latency   json.RawMessage `json:"latency"`         // Number or numeric string
+
+	// Optional session context.
+	Parameters   map[string]interface{} `json:"parameters,omitempty"`
+	SessionID    flexString             `json:"session_id,omitempty"`
+	TxnID        flexString             `json:"txn_id,omitempty"`
+	Database     string                 `json:"database,omitempty"`
+	DatabaseAlt  string                 `json:"db,omitempty"` // Fallback
+	User         string                 `json:"user,omitempty"`
+	Host         string                 `json:"host,omitempty"`
+	RowsAffected int64                  `json:"rows_affected,omitempty"`
+	RowsExamined int64                  `json:"rows_examined,omitempty"`
+	MemoryUsage  int64                  `json:"memory_usage,omitempty"`
+	QueryKind    string                 `json:"query_kind,omitempty"`
+	ErrorCode    flexString             `json:"error_code,omitempty"`
+}
+
+// flexString accepts a JSON string or number, since exporters differ on whether
+// identifiers such as connection ids and error codes are quoted.
+type flexString string
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (f *flexString) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*f = ""
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*f = flexString(s)
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return err
+	}
+	*f = flexString(n.String())
+	return nil
 }
 
 // Parse reads the provided reader line by line, unmarshals each line into a SQLTrace object,
@@ -81,32 +134,85 @@ func (p *StreamingTraceParser) Parse(reader io.Reader, callback func(models.SQLT
 		var dto traceDTO
 		if err := json.Unmarshal(line, &dto); err != nil {
 			logger.Error("Skipped malformed line", utils.Field{Key: "line", Value: lineNum}, utils.Field{Key: "error", Value: err})
+			if err := p.Stats.addSkipped(); err != nil {
+				return fmt.Errorf("line %d: %w", lineNum, err)
+			}
 			continue
 		}
 
-		// Map DTO to domain model
-		// Handle timestamp parsing (assuming ISO8601/RFC3339)
-		ts, err := time.Parse(time.RFC3339, dto.Timestamp)
-		if err != nil {
-			// Try fallback format if RFC3339 fails, e.g. "2025-01-01 00:00:00"
-			// For now, log error and skip
-			logger.Error("Invalid timestamp format", utils.Field{Key: "line", Value: lineNum}, utils.Field{Key: "timestamp", Value: dto.Timestamp})
+		rawTimestamp, rawLatency := dto.Timestamp, dto.Latency
+		if p.TimestampField != "" || p.LatencyField != "" {
+			var fields map[string]json.RawMessage
+			_ = json.Unmarshal(line, &fields) // Already validated above
+			if p.TimestampField != "" {
+				rawTimestamp = fields[p.TimestampField]
+			}
+			if p.LatencyField != "" {
+				rawLatency = fields[p.LatencyField]
+			}
+		}
+
+		// Map DTO to domain model. A missing timestamp or latency is defaulted to zero;
+		// a timestamp that matches none of the layouts makes the line unusable.
+		defaulted := false
+		ts, err := parseTimestamp(rawTimestamp, p.TimestampLayouts, p.Location)
+		if err == errMissingValue {
+			defaulted = true
+		} else if err != nil {
+			logger.Error("Invalid timestamp format", utils.Field{Key: "line", Value: lineNum}, utils.Field{Key: "timestamp", Value: string(rawTimestamp)})
+			if err := p.Stats.addSkipped(); err != nil {
+				return fmt.Errorf("line %d: %w", lineNum, err)
+			}
 			continue
 		}
 
+		latencyUnit := p.LatencyUnit
+		if latencyUnit == 0 {
+			latencyUnit = time.Second
+		}
+		latency, err := parseLatency(rawLatency, latencyUnit)
+		if err != nil {
+			if err != errMissingValue {
+				logger.Warn("Invalid latency, defaulting to zero", utils.Field{Key: "line", Value: lineNum}, utils.Field{Key: "latency", Value: string(rawLatency)})
+			}
+			defaulted = true
+		}
+		if defaulted {
+			p.Stats.addDefaulted()
+		}
+
 		query := dto.Query
 		if query == "" {
 			query = dto.QueryAlt
 		}
 
+		database := dto.Database
+		if database == "" {
+			database = dto.DatabaseAlt
+		}
+		errorCode := string(dto.ErrorCode)
+		if errorCode == "0" {
+			errorCode = ""
+		}
+
 		trace := models.SQLTrace{
-			Query:     query,
-			Timestamp: ts,
-			// Assuming Latency is in seconds if float. If it's ms, use Millisecond.
-			// I'll use Second as default for float latency.
-			Latency:   time.Duration(dto.Latency * float64(time.Second)),
+			Query:        query,
+			Timestamp:    ts,
+			Latency:      latency,
+			Parameters:   dto.Parameters,
+			SessionID:    string(dto.SessionID),
+			TxnID:        string(dto.TxnID),
+			Database:     database,
+			User:         dto.User,
+			Host:         dto.Host,
+			RowsAffected: dto.RowsAffected,
+			RowsExamined: dto.RowsExamined,
+			MemoryUsage:  dto.MemoryUsage,
+			QueryKind:    dto.QueryKind,
+			ErrorCode:    errorCode,
 		}
 
+		p.Stats.addParsed()
 		if err := callback(trace); err != nil {
 			return fmt.Errorf("callback failed at line %d: %w", lineNum, err)
 		}
@@ -118,3 +224,69 @@ func (p *StreamingTraceParser) Parse(reader io.Reader, callback func(models.SQLT
 
 	return nil
 }
+
+// JSONLTraceWriter writes traces in the JSONL format read by StreamingTraceParser,
+// so converted traces keep their session context when they are read back.
+type JSONLTraceWriter struct {
+	encoder *json.Encoder
+}
+
+// NewJSONLTraceWriter creates a JSONLTraceWriter that writes to w.
+func NewJSONLTraceWriter(w io.Writer) *JSONLTraceWriter {
+	return &JSONLTraceWriter{encoder: json.NewEncoder(w)}
+}
+
+// Write encodes a single trace as one line.
+func (w *JSONLTraceWriter) Write(trace models.SQLTrace) error {
+	dto := traceDTO{
+		Query:        trace.Query,
+		Timestamp:    json.RawMessage(strconv.Quote(trace.Timestamp.Format(time.RFC3339Nano))),
+		Latency:      json.RawMessage(strconv.FormatFloat(trace.Latency.Seconds(), 'f', -1, 64)),
+		Parameters:   trace.Parameters,
+		SessionID:    flexString(trace.SessionID),
+		TxnID:        flexString(trace.TxnID),
+		Database:     trace.Database,
+		User:         trace.User,
+		Host:         trace.Host,
+		RowsAffected: trace.RowsAffected,
+		RowsExamined: trace.RowsExamined,
+		MemoryUsage:  trace.MemoryUsage,
+		QueryKind:    trace.QueryKind,
+		ErrorCode:    flexString(trace.ErrorCode),
+	}
+	return w.encoder.Encode(dto)
+}
+
+// JSONArrayTraceParser parses a trace file holding a single JSON array of SQLTrace
+// objects, as read by the generate command. Elements are decoded one at a time so the
+// whole array is never held in memory.
+type JSONArrayTraceParser struct{}
+
+// NewJSONArrayTraceParser creates a new JSONArrayTraceParser.
+func NewJSONArrayTraceParser() *JSONArrayTraceParser {
+	return &JSONArrayTraceParser{}
+}
+
+// Parse decodes the array elements and calls the callback for each of them.
+func (p *JSONArrayTraceParser) Parse(reader io.Reader, callback func(models.SQLTrace) error) error {
+	dec := json.NewDecoder(reader)
+
+	tok, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("failed to read trace array: %w", err)
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("expected a JSON array of traces")
+	}
+
+	for index := 0; dec.More(); index++ {
+		var trace models.SQLTrace
+		if err := dec.Decode(&trace); err != nil {
+			return fmt.Errorf("failed to decode trace %d: %w", index, err)
+		}
+		if err := callback(trace); err != nil {
+			return fmt.Errorf("callback failed at element %d: %w", index, err)
+		}
+	}
+	return nil
+}
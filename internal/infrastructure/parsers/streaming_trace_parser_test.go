@@ -65,6 +65,91 @@ func TestStreamingParser_InvalidTimestamp(t *testing.T) {
 	assert.Equal(t, 0, count)
 }
 
+func TestStreamingParser_SessionContext(t *testing.T) {
+	jsonl := `{"timestamp":"2025-01-01T00:00:00Z","query":"UPDATE t SET a = 1","session_id":42,"txn_id":"tx-9","db":"shop","user":"app","rows_affected":3,"error_code":1213,"parameters":{":id":7}}
+{"timestamp":"2025-01-01T00:00:01Z","query":"SELECT 1","database":"shop","error_code":0}`
+
+	parser := StreamingTraceParser{}
+	var traces []models.SQLTrace
+	err := parser.Parse(strings.NewReader(jsonl), func(trace models.SQLTrace) error {
+		traces = append(traces, trace)
+		return nil
+	})
+
+	assert.NoError(t, err)
+	if assert.Len(t, traces, 2) {
+		assert.Equal(t, "42", traces[0].SessionID)
+		assert.Equal(t, "tx-9", traces[0].TxnID)
+		assert.Equal(t, "shop", traces[0].Database)
+		assert.Equal(t, "app", traces[0].User)
+		assert.Equal(t, int64(3), traces[0].RowsAffected)
+		assert.Equal(t, "1213", traces[0].ErrorCode)
+		assert.Equal(t, map[string]interface{}{":id": float64(7)}, traces[0].Parameters)
+		assert.False(t, traces[1].Failed())
+	}
+}
+
+func TestStreamingParser_CustomFieldsAndUnits(t *testing.T) {
+	jsonl := `{"ts":1735689600250,"query":"SELECT 1","duration_us":1500}
+{"ts":"1735689601000","query":"SELECT 2"}
+{"ts":1735689602000,"query":"SELECT 3","duration_us":"slow"}
+{"ts":"2025-01-01","query":"SELECT 4","duration_us":1}`
+
+	stats := &ParseStats{}
+	parser := NewStreamingTraceParser(0)
+	parser.TimestampField = "ts"
+	parser.TimestampLayouts = []string{EpochMilliseconds}
+	parser.LatencyField = "duration_us"
+	parser.LatencyUnit = time.Microsecond
+	parser.Stats = stats
+
+	var traces []models.SQLTrace
+	err := parser.Parse(strings.NewReader(jsonl), func(trace models.SQLTrace) error {
+		traces = append(traces, trace)
+		return nil
+	})
+
+	assert.NoError(t, err)
+	if assert.Len(t, traces, 3) {
+		assert.Equal(t, time.Date(2025, 1, 1, 0, 0, 0, 250000000, time.UTC), traces[0].Timestamp)
+		assert.Equal(t, 1500*time.Microsecond, traces[0].Latency)
+		assert.Equal(t, time.Date(2025, 1, 1, 0, 0, 1, 0, time.UTC), traces[1].Timestamp)
+		assert.Zero(t, traces[1].Latency)
+		assert.Zero(t, traces[2].Latency)
+	}
+	assert.Equal(t, int64(3), stats.Parsed())
+	assert.Equal(t, int64(1), stats.Skipped(), "the date-only timestamp matches no layout")
+	assert.Equal(t, int64(2), stats.Defaulted(), "missing and invalid latencies are defaulted")
+}
+
+func TestJSONLTraceWriter_RoundTrip(t *testing.T) {
+	in := models.SQLTrace{
+		Query:        "DELETE FROM t WHERE id = :id",
+		Timestamp:    time.Date(2025, 1, 1, 0, 0, 0, 5000, time.UTC),
+		Latency:      250 * time.Millisecond,
+		Parameters:   map[string]interface{}{":id": "7"},
+		SessionID:    "12",
+		TxnID:        "99",
+		Database:     "shop",
+		User:         "app",
+		RowsAffected: 1,
+		ErrorCode:    "1205",
+	}
+
+	var buf strings.Builder
+	assert.NoError(t, NewJSONLTraceWriter(&buf).Write(in))
+
+	var out []models.SQLTrace
+	err := NewStreamingTraceParser(0).Parse(strings.NewReader(buf.String()), func(trace models.SQLTrace) error {
+		out = append(out, trace)
+		return nil
+	})
+	assert.NoError(t, err)
+	if assert.Len(t, out, 1) {
+		assert.Equal(t, in, out[0])
+	}
+}
+
 func BenchmarkStreamingParser_MemoryStability(b *testing.B) {
 	// Generate a repeatable large input
 	line := `{"timestamp":"2025-01-01T00:00:00Z","query_text":"SELECT * FROM table WHERE id = 1","latency":0.001}` + "\n"
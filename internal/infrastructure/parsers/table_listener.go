@@ -1,6 +1,11 @@
 package parsers
 
-import mysql "github.com/turtacn/SQLTraceBench/internal/infrastructure/parsers/antlr/mysql"
+import (
+	"strings"
+
+	mysql "github.com/turtacn/SQLTraceBench/internal/infrastructure/parsers/antlr/mysql"
+	postgresql "github.com/turtacn/SQLTraceBench/internal/infrastructure/parsers/antlr/postgresql"
+)
 
 // TableListener is an ANTLR listener that extracts table names from a SQL query.
 type TableListener struct {
@@ -17,7 +22,85 @@ func NewTableListener() *TableListener {
 }
 
 // EnterTable_reference is called when the listener enters a `table_reference` node in the parse tree.
-// It extracts the table name from the node and adds it to the list of table names.
+// It extracts the table name, without its alias, from the node and adds it to the list of table names.
 func (l *TableListener) EnterTable_reference(ctx *mysql.Table_referenceContext) {
-	l.TableNames = append(l.TableNames, ctx.GetText())
-}
\ No newline at end of file
+	l.TableNames = append(l.TableNames, strings.Trim(ctx.GetStart().GetText(), "`\""))
+}
+
+// PostgresTableListener is an ANTLR listener that extracts table names from a
+// PostgreSQL query parsed with the PostgreSQL grammar.
+type PostgresTableListener struct {
+	*postgresql.BasePostgreSqlParserListener
+	tables []string
+	seen   map[string]bool
+	ctes   map[string]bool
+}
+
+// NewPostgresTableListener creates a new PostgresTableListener.
+func NewPostgresTableListener() *PostgresTableListener {
+	return &PostgresTableListener{
+		BasePostgreSqlParserListener: &postgresql.BasePostgreSqlParserListener{},
+		seen:                         make(map[string]bool),
+		ctes:                         make(map[string]bool),
+	}
+}
+
+// EnterCommon_table_expression records the name of a CTE, so that references to it
+// are not reported as tables.
+func (l *PostgresTableListener) EnterCommon_table_expression(ctx *postgresql.Common_table_expressionContext) {
+	l.ctes[pgIdentifier(ctx.Identifier())] = true
+}
+
+// EnterTable_primary adds the relation a FROM or JOIN item names. Function calls
+// such as generate_series(1, 10) and subqueries are not tables.
+func (l *PostgresTableListener) EnterTable_primary(ctx *postgresql.Table_primaryContext) {
+	if ctx.Qualified_name() == nil {
+		return
+	}
+	if suffix := ctx.Table_suffix(); suffix != nil && suffix.Call_arguments() != nil {
+		return
+	}
+	l.add(pgQualifiedNameText(ctx.Qualified_name()))
+}
+
+// EnterTable_name adds the target table of INSERT, UPDATE, DELETE and TRUNCATE.
+func (l *PostgresTableListener) EnterTable_name(ctx *postgresql.Table_nameContext) {
+	l.add(pgQualifiedNameText(ctx.Qualified_name()))
+}
+
+func (l *PostgresTableListener) add(name string) {
+	if !l.seen[name] {
+		l.seen[name] = true
+		l.tables = append(l.tables, name)
+	}
+}
+
+// TableNames returns the tables in order of appearance, without CTE names.
+func (l *PostgresTableListener) TableNames() []string {
+	out := make([]string, 0, len(l.tables))
+	for _, name := range l.tables {
+		if !l.ctes[name] {
+			out = append(out, name)
+		}
+	}
+	return out
+}
+
+// pgQualifiedNameText joins the parts of a schema-qualified name with dots.
+func pgQualifiedNameText(ctx postgresql.IQualified_nameContext) string {
+	parts := make([]string, 0, 2)
+	for _, id := range ctx.AllIdentifier() {
+		parts = append(parts, pgIdentifier(id))
+	}
+	return strings.Join(parts, ".")
+}
+
+// pgIdentifier returns the name an identifier denotes: quoted identifiers are
+// unquoted, all others are folded to lower case.
+func pgIdentifier(ctx postgresql.IIdentifierContext) string {
+	if quoted := ctx.QUOTED_ID(); quoted != nil {
+		text := quoted.GetText()
+		return strings.ReplaceAll(text[1:len(text)-1], `""`, `"`)
+	}
+	return strings.ToLower(ctx.GetText())
+}
@@ -0,0 +1,207 @@
+#!/usr/bin/env python3
+"""Generates the wire-protocol capture fixtures used by pcap_reader_test.go.
+
+    python3 gen_wire_captures.py
+
+mysql_wire.pcap    classic pcap, Ethernet, MySQL on 10.0.0.1:3306
+postgres_wire.pcapng  pcapng with nanosecond timestamps, Linux SLL, PostgreSQL on 192.168.1.10:5432
+"""
+import os
+import socket
+import struct
+
+HERE = os.path.dirname(os.path.abspath(__file__))
+BASE = 1700000000  # 2023-11-14T22:13:20Z
+
+
+class Flow:
+    """One TCP connection; packets are collected as (time, link-layer frame)."""
+
+    def __init__(self, frame, client, server):
+        self.frame = frame
+        self.client, self.server = client, server
+        self.seq = {True: 1000, False: 5000}
+        self.packets = []
+
+    def tcp(self, t, from_client, payload=b"", flags=0x18, seq=None):
+        src, dst = (self.client, self.server) if from_client else (self.server, self.client)
+        if seq is None:
+            seq = self.seq[from_client]
+            self.seq[from_client] = (seq + len(payload) + (1 if flags & 0x03 else 0)) & 0xFFFFFFFF
+        tcp = struct.pack(">HHIIBBHHH", src[1], dst[1], seq, 0, 5 << 4, flags, 65535, 0, 0) + payload
+        ip = struct.pack(">BBHHHBBH4s4s", 0x45, 0, 20 + len(tcp), 0, 0, 64, 6, 0,
+                         socket.inet_aton(src[0]), socket.inet_aton(dst[0])) + tcp
+        self.packets.append((t, self.frame(ip)))
+        return seq
+
+    def handshake(self, t):
+        self.tcp(t, True, flags=0x02)
+        self.tcp(t + 0.0001, False, flags=0x12)
+        self.tcp(t + 0.0002, True, flags=0x10)
+
+    def send(self, t, from_client, payload):
+        return self.tcp(t, from_client, payload)
+
+
+def ethernet(ip):
+    return b"\x00\x11\x22\x33\x44\x55" + b"\x66\x77\x88\x99\xaa\xbb" + b"\x08\x00" + ip
+
+
+def linux_sll(ip):
+    return struct.pack(">HHH8sH", 0, 1, 6, b"\x00\x11\x22\x33\x44\x55\x00\x00", 0x0800) + ip
+
+
+# --- MySQL -----------------------------------------------------------------
+
+def my_packet(seq, payload):
+    return struct.pack("<I", len(payload))[:3] + bytes([seq]) + payload
+
+
+def lenenc(n):
+    return bytes([n]) if n < 251 else b"\xfc" + struct.pack("<H", n)
+
+
+def my_handshake_response(caps, user, db):
+    auth = b"\x01" * 20
+    return (struct.pack("<IIB", caps, 1 << 24, 33) + b"\x00" * 23 + user + b"\x00" +
+            bytes([len(auth)]) + auth + db + b"\x00" + b"mysql_native_password\x00")
+
+
+def my_ok(rows):
+    return b"\x00" + lenenc(rows) + lenenc(0) + b"\x02\x00\x00\x00"
+
+
+def my_resultset(t, flow):
+    flow.send(t, False, my_packet(1, b"\x01") + my_packet(2, b"\x03def\x00\x00\x00\x04name\x00\x0c") +
+              my_packet(3, b"\xfe\x00\x00\x02\x00") + my_packet(4, b"\x05alice") +
+              my_packet(5, b"\xfe\x00\x00\x02\x00"))
+
+
+def mysql_capture():
+    caps = 0x200 | (1 << 15) | (1 << 3) | (1 << 19)
+    app = Flow(ethernet, ("10.0.0.5", 50001), ("10.0.0.1", 3306))
+    app.handshake(BASE)
+    app.send(BASE + 0.001, False, my_packet(0, b"\x0a8.0.36\x00" + b"\x00" * 40))
+    app.send(BASE + 0.002, True, my_packet(1, my_handshake_response(caps, b"app", b"shop")))
+    app.send(BASE + 0.003, False, my_packet(2, my_ok(0)))
+
+    # Plain query, 2.5ms to the first result set packet.
+    app.send(BASE + 1.0, True, my_packet(0, b"\x03SELECT * FROM users WHERE id = 7"))
+    my_resultset(BASE + 1.0025, app)
+
+    # Prepared statement executed twice; the second execute does not resend types.
+    app.send(BASE + 2.0, True, my_packet(0, b"\x16SELECT name FROM users WHERE id = ? AND status = ? AND note = '?'"))
+    app.send(BASE + 2.0005, False, my_packet(1, b"\x00" + struct.pack("<IHHBH", 1, 1, 2, 0, 0)))
+    execute = (b"\x17" + struct.pack("<IBI", 1, 0, 1) + b"\x00" + b"\x01" +
+               struct.pack("<HH", 0x08, 0xfd) + struct.pack("<q", 42) + lenenc(6) + b"active")
+    app.send(BASE + 2.1, True, my_packet(0, execute))
+    my_resultset(BASE + 2.1015, app)
+    execute = b"\x17" + struct.pack("<IBI", 1, 0, 1) + b"\x02" + b"\x00" + struct.pack("<q", -43)
+    app.send(BASE + 2.2, True, my_packet(0, execute))
+    my_resultset(BASE + 2.201, app)
+
+    # A query split over two segments that arrive out of order, with a retransmission.
+    query = my_packet(0, b"\x03UPDATE users SET status = 'x' WHERE id = 9")
+    first = app.seq[True]
+    app.seq[True] = first + len(query)
+    app.tcp(BASE + 3.0, True, query[10:], seq=first + 10)
+    app.tcp(BASE + 3.0001, True, query[:10], seq=first)
+    app.tcp(BASE + 3.0002, True, query[:10], seq=first)
+    app.send(BASE + 3.004, False, my_packet(1, my_ok(1)))
+
+    # A failing statement: ER_NO_SUCH_TABLE.
+    app.send(BASE + 4.0, True, my_packet(0, b"\x03INSERT INTO missing VALUES (1)"))
+    app.send(BASE + 4.001, False, my_packet(1, b"\xff" + struct.pack("<H", 1146) + b"#42S02Table 'shop.missing' doesn't exist"))
+
+    # A TLS connection that cannot be decoded.
+    tls = Flow(ethernet, ("10.0.0.6", 50002), ("10.0.0.1", 3306))
+    tls.handshake(BASE + 0.5)
+    tls.send(BASE + 0.501, False, my_packet(0, b"\x0a8.0.36\x00" + b"\x00" * 40))
+    tls.send(BASE + 0.502, True, my_packet(1, struct.pack("<IIB", caps | (1 << 11), 1 << 24, 33) + b"\x00" * 23))
+    tls.send(BASE + 1.5, True, my_packet(0, b"\x03SELECT 'encrypted'"))
+
+    # Unrelated traffic.
+    web = Flow(ethernet, ("10.0.0.7", 50003), ("10.0.0.1", 80))
+    web.send(BASE + 1.7, True, b"GET / HTTP/1.1\r\n\r\n")
+
+    packets = sorted(app.packets + tls.packets + web.packets, key=lambda p: p[0])
+    with open(os.path.join(HERE, "mysql_wire.pcap"), "wb") as f:
+        f.write(struct.pack("<IHHiIII", 0xa1b2c3d4, 2, 4, 0, 0, 65535, 1))
+        for t, frame in packets:
+            sec = int(t)
+            usec = int(round((t - sec) * 1e6))
+            f.write(struct.pack("<IIII", sec, usec, len(frame), len(frame)) + frame)
+
+
+# --- PostgreSQL --------------------------------------------------------------
+
+def pg(typ, body):
+    return typ + struct.pack(">I", len(body) + 4) + body
+
+
+def cstr(s):
+    return s + b"\x00"
+
+
+def postgres_capture():
+    app = Flow(linux_sll, ("192.168.1.20", 40000), ("192.168.1.10", 5432))
+    app.handshake(BASE)
+    app.send(BASE + 0.001, True, struct.pack(">II", 8, 80877103))
+    app.send(BASE + 0.002, False, b"N")
+    params = cstr(b"user") + cstr(b"bench") + cstr(b"database") + cstr(b"orders") + b"\x00"
+    app.send(BASE + 0.003, True, struct.pack(">II", 8 + len(params), 196608) + params)
+    app.send(BASE + 0.004, False, pg(b"R", struct.pack(">I", 0)) + pg(b"S", cstr(b"TimeZone") + cstr(b"UTC")) +
+             pg(b"K", struct.pack(">II", 1, 2)) + pg(b"Z", b"I"))
+
+    # Simple query.
+    app.send(BASE + 10.0, True, pg(b"Q", cstr(b"SELECT count(*) FROM orders")))
+    app.send(BASE + 10.003, False, pg(b"T", b"\x00\x00") + pg(b"D", b"\x00\x01\x00\x00\x00\x013") +
+             pg(b"C", cstr(b"SELECT 1")) + pg(b"Z", b"I"))
+
+    # Extended query on the unnamed statement: one binary int4 and one text parameter.
+    batch = (pg(b"P", cstr(b"") + cstr(b"SELECT * FROM orders WHERE id = $1 AND region = $2") + struct.pack(">HII", 2, 23, 25)) +
+             pg(b"B", cstr(b"") + cstr(b"") + struct.pack(">HHHH", 2, 1, 0, 2) +
+                struct.pack(">Ii", 4, 1001) + struct.pack(">I", 2) + b"eu" + b"\x00\x00") +
+             pg(b"D", b"P" + cstr(b"")) + pg(b"E", cstr(b"") + struct.pack(">I", 0)) + pg(b"S", b""))
+    app.send(BASE + 11.0, True, batch)
+    app.send(BASE + 11.0015, False, pg(b"1", b"") + pg(b"2", b"") + pg(b"T", b"\x00\x00") +
+             pg(b"C", cstr(b"SELECT 0")) + pg(b"Z", b"I"))
+
+    # Named statement with binary float8/int8 parameters and a NULL.
+    batch = (pg(b"P", cstr(b"upd") + cstr(b"UPDATE orders SET total = $1, note = $3 WHERE id = $2") + struct.pack(">HIII", 3, 701, 20, 25)) +
+             pg(b"B", cstr(b"") + cstr(b"upd") + struct.pack(">HH", 1, 1) + struct.pack(">H", 3) +
+                struct.pack(">Id", 8, 99.5) + struct.pack(">Iq", 8, 5) + struct.pack(">i", -1) + b"\x00\x00") +
+             pg(b"E", cstr(b"") + struct.pack(">I", 0)) + pg(b"S", b""))
+    app.send(BASE + 12.0, True, batch)
+    app.send(BASE + 12.002, False, pg(b"1", b"") + pg(b"2", b"") + pg(b"C", cstr(b"UPDATE 1")) + pg(b"Z", b"T"))
+
+    # A failing simple query.
+    app.send(BASE + 13.0, True, pg(b"Q", cstr(b"SELECT * FROM nope")))
+    app.send(BASE + 13.0007, False, pg(b"E", b"SERROR\x00C42P01\x00Mrelation \"nope\" does not exist\x00\x00") + pg(b"Z", b"I"))
+
+    # A connection upgraded to TLS.
+    tls = Flow(linux_sll, ("192.168.1.21", 40001), ("192.168.1.10", 5432))
+    tls.handshake(BASE + 5.0)
+    tls.send(BASE + 5.001, True, struct.pack(">II", 8, 80877103))
+    tls.send(BASE + 5.002, False, b"S")
+    tls.send(BASE + 5.003, True, b"\x16\x03\x01\x02\x00" + b"\x00" * 32)
+
+    packets = sorted(app.packets + tls.packets, key=lambda p: p[0])
+    with open(os.path.join(HERE, "postgres_wire.pcapng"), "wb") as f:
+        f.write(block(0x0A0D0D0A, struct.pack("<IHHq", 0x1A2B3C4D, 1, 0, -1)))
+        options = struct.pack("<HHB3x", 9, 1, 9) + struct.pack("<HH", 0, 0)  # if_tsresol: nanoseconds
+        f.write(block(1, struct.pack("<HHI", 113, 0, 65535) + options))
+        for t, frame in packets:
+            ticks = (BASE * 10**6 + round((t - BASE) * 1e6)) * 1000
+            body = struct.pack("<IIIII", 0, ticks >> 32, ticks & 0xFFFFFFFF, len(frame), len(frame))
+            f.write(block(6, body + frame + b"\x00" * (-len(frame) % 4)))
+
+
+def block(typ, body):
+    total = len(body) + 12
+    return struct.pack("<II", typ, total) + body + struct.pack("<I", total)
+
+
+if __name__ == "__main__":
+    mysql_capture()
+    postgres_capture()
@@ -0,0 +1,340 @@
+package parsers
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+	"github.com/turtacn/SQLTraceBench/pkg/types"
+)
+
+// Built-in trace formats.
+const (
+	TraceFormatJSONL            = "jsonl"
+	TraceFormatJSONArray        = "json"
+	TraceFormatSlowLog          = "slowlog"
+	TraceFormatPgLog            = "pglog"
+	TraceFormatPgCSVLog         = "pgcsvlog"
+	TraceFormatPgJSONLog        = "pgjsonlog"
+	TraceFormatPgStatStatements = "pg_stat_statements"
+	TraceFormatCHQueryLog       = "ch_query_log"
+	TraceFormatPcap             = "pcap"
+)
+
+// SniffSize is the number of leading bytes handed to TraceFormat.Sniff.
+const SniffSize = 4096
+
+// TraceReader parses a trace stream and calls the callback for every trace found.
+type TraceReader interface {
+	Parse(reader io.Reader, callback func(models.SQLTrace) error) error
+}
+
+// TemplateReader is implemented by sources that export pre-aggregated, weighted
+// templates instead of individual traces (e.g. pg_stat_statements).
+type TemplateReader interface {
+	ReadTemplates(reader io.Reader) ([]models.SQLTemplate, error)
+}
+
+// TraceFormat describes a trace format that can be selected by name or detected automatically.
+// Exactly one of NewReader and NewTemplateReader must be set.
+type TraceFormat struct {
+	// Name is the identifier used by --trace-format and configuration files.
+	Name string
+	// Dialect is the SQL dialect of the queries in the trace, or DatabaseNone if the
+	// format does not tell (e.g. generic JSON traces).
+	Dialect types.DatabaseType
+	// Extensions are the file extensions (with leading dot) used as a last-resort hint.
+	Extensions []string
+	// Sniff reports whether the first SniffSize bytes of a file look like this format.
+	Sniff func(head []byte) bool
+	// NewReader creates a reader for trace-per-record formats.
+	NewReader func(cfg ReaderConfig) TraceReader
+	// NewTemplateReader creates a reader for pre-aggregated template formats.
+	NewTemplateReader func() TemplateReader
+}
+
+// TraceReaderRegistry holds the trace formats known to the application.
+// Formats are sniffed in registration order, so more specific formats should be registered first.
+type TraceReaderRegistry struct {
+	mu      sync.RWMutex
+	formats map[string]TraceFormat
+	order   []string
+}
+
+// NewTraceReaderRegistry creates an empty registry.
+func NewTraceReaderRegistry() *TraceReaderRegistry {
+	return &TraceReaderRegistry{formats: make(map[string]TraceFormat)}
+}
+
+// Register adds a trace format to the registry.
+func (r *TraceReaderRegistry) Register(f TraceFormat) error {
+	name := strings.ToLower(strings.TrimSpace(f.Name))
+	if name == "" {
+		return fmt.Errorf("trace format name is required")
+	}
+	if (f.NewReader == nil) == (f.NewTemplateReader == nil) {
+		return fmt.Errorf("trace format %s must provide exactly one of NewReader or NewTemplateReader", name)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.formats[name]; exists {
+		return fmt.Errorf("trace format already registered: %s", name)
+	}
+	f.Name = name
+	r.formats[name] = f
+	r.order = append(r.order, name)
+	return nil
+}
+
+// Get retrieves a trace format by name.
+func (r *TraceReaderRegistry) Get(name string) (TraceFormat, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	f, ok := r.formats[strings.ToLower(strings.TrimSpace(name))]
+	return f, ok
+}
+
+// Names returns the registered format names in alphabetical order.
+func (r *TraceReaderRegistry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := append([]string(nil), r.order...)
+	sort.Strings(names)
+	return names
+}
+
+// TraceNames returns, in alphabetical order, the names of the formats that hold
+// individual traces rather than pre-aggregated templates.
+func (r *TraceReaderRegistry) TraceNames() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var names []string
+	for _, name := range r.order {
+		if r.formats[name].NewReader != nil {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Detect identifies the format of a trace from its leading bytes, falling back to
+// the file extension of path. It returns an empty string when nothing matches.
+func (r *TraceReaderRegistry) Detect(path string, head []byte) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// Text formats are sniffed without a leading BOM and blank lines; binary formats
+	// see the raw bytes, since their magic numbers may start with line breaks.
+	text := bytes.TrimLeft(bytes.TrimPrefix(head, []byte("\xef\xbb\xbf")), " \t\r\n")
+	if len(text) > 0 {
+		for _, name := range r.order {
+			if f := r.formats[name]; f.Sniff != nil && (f.Sniff(text) || f.Sniff(head)) {
+				return name
+			}
+		}
+	}
+
+	ext := strings.ToLower(filepath.Ext(path))
+	if ext == "" {
+		return ""
+	}
+	for _, name := range r.order {
+		for _, e := range r.formats[name].Extensions {
+			if e == ext {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+// Resolve returns the format to use for the file at path. An explicit format name wins;
+// otherwise the format is detected from the file's (decompressed) content and extension.
+func (r *TraceReaderRegistry) Resolve(path, format string) (TraceFormat, error) {
+	if format != "" {
+		f, ok := r.Get(format)
+		if !ok {
+			return TraceFormat{}, fmt.Errorf("unsupported trace format: %s (known formats: %s)", format, strings.Join(r.Names(), ", "))
+		}
+		return f, nil
+	}
+
+	file, err := OpenTraceFile(path)
+	if err != nil {
+		return TraceFormat{}, err
+	}
+	defer file.Close()
+
+	head, err := bufio.NewReaderSize(file, SniffSize).Peek(SniffSize)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return TraceFormat{}, err
+	}
+	name := r.Detect(stripCompressionExt(path), head)
+	if name == "" {
+		return TraceFormat{}, fmt.Errorf("could not detect the trace format of %s, please specify one of: %s", path, strings.Join(r.Names(), ", "))
+	}
+	f, _ := r.Get(name)
+	return f, nil
+}
+
+// GlobalTraceReaders is the registry used by the conversion service and the CLI.
+var GlobalTraceReaders = NewTraceReaderRegistry()
+
+// RegisterTraceFormat adds a trace format to the global registry.
+// Additional readers can call it from an init function.
+func RegisterTraceFormat(f TraceFormat) error {
+	return GlobalTraceReaders.Register(f)
+}
+
+var (
+	pgCSVLogSniffRe = regexp.MustCompile(`^\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}(\.\d+)?( [A-Za-z]{2,5}| [+-]\d{2}(:?\d{2})?)?,`)
+	pgStderrSniffRe = regexp.MustCompile(`^\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}.*\b(LOG|DETAIL|ERROR|STATEMENT|WARNING):  `)
+)
+
+func firstLine(head []byte) []byte {
+	if i := bytes.IndexByte(head, '\n'); i >= 0 {
+		return bytes.TrimRight(head[:i], "\r")
+	}
+	return head
+}
+
+func init() {
+	builtins := []TraceFormat{
+		{
+			Name:       TraceFormatPcap,
+			Extensions: []string{".pcap", ".pcapng", ".cap"},
+			Sniff:      isPcap,
+			NewReader:  newPcapReader,
+		},
+		{
+			Name: TraceFormatJSONArray,
+			Sniff: func(head []byte) bool {
+				return head[0] == '['
+			},
+			NewReader: func(ReaderConfig) TraceReader { return NewJSONArrayTraceParser() },
+		},
+		{
+			Name:    TraceFormatPgJSONLog,
+			Dialect: types.DatabasePostgreSQL,
+			Sniff: func(head []byte) bool {
+				return head[0] == '{' && bytes.Contains(firstLine(head), []byte(`"error_severity"`))
+			},
+			NewReader: func(cfg ReaderConfig) TraceReader { return newPostgresReader(TraceFormatPgJSONLog, cfg) },
+		},
+		{
+			Name:       TraceFormatCHQueryLog,
+			Dialect:    types.DatabaseClickHouse,
+			Extensions: []string{".tsv"},
+			Sniff: func(head []byte) bool {
+				return bytes.Contains(firstLine(head), []byte("query_duration_ms"))
+			},
+			NewReader: newClickHouseReader,
+		},
+		{
+			Name:       TraceFormatJSONL,
+			Extensions: []string{".jsonl", ".json"},
+			Sniff: func(head []byte) bool {
+				return head[0] == '{'
+			},
+			NewReader: newStreamingReader,
+		},
+		{
+			Name:       TraceFormatSlowLog,
+			Dialect:    types.DatabaseMySQL,
+			Extensions: []string{".log", ".slowlog"},
+			Sniff: func(head []byte) bool {
+				line := firstLine(head)
+				return bytes.HasPrefix(line, []byte("# Time:")) ||
+					bytes.HasPrefix(line, []byte("# User@Host:")) ||
+					bytes.Contains(line, []byte(", Version: ")) && bytes.HasSuffix(line, []byte("started with:"))
+			},
+			NewReader: newSlowLogReader,
+		},
+		{
+			Name:    TraceFormatPgCSVLog,
+			Dialect: types.DatabasePostgreSQL,
+			Sniff: func(head []byte) bool {
+				return pgCSVLogSniffRe.Match(firstLine(head))
+			},
+			NewReader: func(cfg ReaderConfig) TraceReader { return newPostgresReader(TraceFormatPgCSVLog, cfg) },
+		},
+		{
+			Name:    TraceFormatPgLog,
+			Dialect: types.DatabasePostgreSQL,
+			Sniff: func(head []byte) bool {
+				return pgStderrSniffRe.Match(firstLine(head))
+			},
+			NewReader: func(cfg ReaderConfig) TraceReader { return newPostgresReader(TraceFormatPgLog, cfg) },
+		},
+		{
+			Name:       TraceFormatPgStatStatements,
+			Dialect:    types.DatabasePostgreSQL,
+			Extensions: []string{".csv"},
+			Sniff: func(head []byte) bool {
+				line := string(firstLine(head))
+				return strings.Contains(line, "query") && strings.Contains(line, "calls") && strings.Contains(line, ",")
+			},
+			NewTemplateReader: func() TemplateReader { return NewPgStatStatementsReader() },
+		},
+	}
+	for _, f := range builtins {
+		if err := RegisterTraceFormat(f); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func newStreamingReader(cfg ReaderConfig) TraceReader {
+	p := NewStreamingTraceParser(cfg.BufferSize)
+	p.TimestampField = cfg.TimestampField
+	p.LatencyField = cfg.LatencyField
+	p.TimestampLayouts = cfg.TimestampLayouts
+	p.Location = cfg.Location
+	p.LatencyUnit = cfg.LatencyUnit
+	p.Stats = cfg.Stats
+	return p
+}
+
+func newSlowLogReader(cfg ReaderConfig) TraceReader {
+	p := NewSlowLogParser(cfg.BufferSize)
+	p.Location = cfg.Location
+	p.Stats = cfg.Stats
+	return p
+}
+
+func newPostgresReader(format string, cfg ReaderConfig) TraceReader {
+	p := NewPostgresLogParser(format, cfg.BufferSize)
+	p.Location = cfg.Location
+	p.Stats = cfg.Stats
+	return p
+}
+
+func newClickHouseReader(cfg ReaderConfig) TraceReader {
+	p := NewClickHouseQueryLogParser(cfg.BufferSize)
+	if cfg.Location != nil {
+		p.Location = cfg.Location
+	}
+	p.Stats = cfg.Stats
+	return p
+}
+
+func newPcapReader(cfg ReaderConfig) TraceReader {
+	p := NewPcapTraceParser()
+	if len(cfg.MySQLPorts) > 0 {
+		p.MySQLPorts = cfg.MySQLPorts
+	}
+	if len(cfg.PostgresPorts) > 0 {
+		p.PostgresPorts = cfg.PostgresPorts
+	}
+	p.Stats = cfg.Stats
+	return p
+}
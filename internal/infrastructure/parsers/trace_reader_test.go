@@ -0,0 +1,95 @@
+package parsers
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceReaderRegistry_Detect(t *testing.T) {
+	cases := []struct {
+		name string
+		path string
+		head string
+		want string
+	}{
+		{"jsonl", "traces.txt", `{"query": "SELECT 1", "timestamp": "2025-01-01T00:00:00Z"}` + "\n", TraceFormatJSONL},
+		{"json array", "traces.jsonl", `[{"Query": "SELECT 1"}]`, TraceFormatJSONArray},
+		{"slow log", "mysqld.out", "# Time: 2025-01-01T00:00:01.000123Z\n# User@Host: app[app] @ localhost []\n", TraceFormatSlowLog},
+		{"slow log banner", "trace", "/usr/sbin/mysqld, Version: 8.0.36 (MySQL Community Server - GPL). started with:\n", TraceFormatSlowLog},
+		{"pg stderr", "postgresql.log", "2025-01-01 00:00:00.123 UTC [1234] app@shop LOG:  duration: 1.5 ms  statement: SELECT a, b FROM t\n", TraceFormatPgLog},
+		{"pg csvlog", "postgresql.csv", `2025-01-01 00:00:00.123 UTC,"app","shop",1234,"[local]",` + "\n", TraceFormatPgCSVLog},
+		{"pg jsonlog", "postgresql.json", `{"timestamp":"2025-01-01 00:00:00.123 UTC","error_severity":"LOG","message":"duration: 1 ms"}` + "\n", TraceFormatPgJSONLog},
+		{"pg_stat_statements", "export", "userid,dbid,queryid,query,calls,total_exec_time\n", TraceFormatPgStatStatements},
+		{"clickhouse tsv", "export", "type\tevent_time\tquery_duration_ms\tquery\n", TraceFormatCHQueryLog},
+		{"pcap", "capture", "\xd4\xc3\xb2\xa1\x02\x00\x04\x00", TraceFormatPcap},
+		{"pcap big endian", "capture", "\xa1\xb2\xc3\xd4\x00\x02\x00\x04", TraceFormatPcap},
+		{"pcapng", "capture", "\x0a\x0d\x0d\x0a\x1c\x00\x00\x00\x4d\x3c\x2b\x1a", TraceFormatPcap},
+		{"extension fallback", "slow.log", "SELECT 1;\n", TraceFormatSlowLog},
+		{"unknown", "schema.sql", "CREATE TABLE t (id INT);\n", ""},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, GlobalTraceReaders.Detect(tc.path, []byte(tc.head)))
+		})
+	}
+}
+
+func TestTraceReaderRegistry_Resolve(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "capture.txt")
+	require.NoError(t, os.WriteFile(path, []byte("# Time: 2025-01-01T00:00:01Z\n# Query_time: 0.5\nSELECT 1;\n"), 0644))
+
+	f, err := GlobalTraceReaders.Resolve(path, "")
+	require.NoError(t, err)
+	assert.Equal(t, TraceFormatSlowLog, f.Name)
+	assert.IsType(t, &SlowLogParser{}, f.NewReader(ReaderConfig{}))
+
+	// An explicit format wins over detection.
+	f, err = GlobalTraceReaders.Resolve(path, "JSONL")
+	require.NoError(t, err)
+	assert.Equal(t, TraceFormatJSONL, f.Name)
+
+	_, err = GlobalTraceReaders.Resolve(path, "unknown")
+	assert.Error(t, err)
+
+	empty := filepath.Join(dir, "empty.dat")
+	require.NoError(t, os.WriteFile(empty, nil, 0644))
+	_, err = GlobalTraceReaders.Resolve(empty, "")
+	assert.Error(t, err)
+}
+
+func TestTraceReaderRegistry_Register(t *testing.T) {
+	r := NewTraceReaderRegistry()
+	newReader := func(ReaderConfig) TraceReader { return NewStreamingTraceParser(0) }
+
+	require.NoError(t, r.Register(TraceFormat{Name: "custom", NewReader: newReader}))
+	assert.Error(t, r.Register(TraceFormat{Name: "Custom", NewReader: newReader}), "duplicate names are rejected")
+	assert.Error(t, r.Register(TraceFormat{Name: "none"}), "a factory is required")
+	assert.Error(t, r.Register(TraceFormat{Name: ""}))
+	assert.Equal(t, []string{"custom"}, r.Names())
+
+	require.NoError(t, r.Register(TraceFormat{Name: "aggregated", NewTemplateReader: func() TemplateReader { return NewPgStatStatementsReader() }}))
+	assert.Equal(t, []string{"aggregated", "custom"}, r.Names())
+	assert.Equal(t, []string{"custom"}, r.TraceNames(), "template formats are not trace formats")
+}
+
+func TestJSONArrayTraceParser_Parse(t *testing.T) {
+	input := `[
+  {"Query": "SELECT * FROM users WHERE id = 1", "Timestamp": "2025-01-01T12:00:00Z", "Latency": 120000000},
+  {"Query": "SELECT 2", "Timestamp": "2025-01-01T12:00:01Z"}
+]`
+	traces := collectTraces(t, NewJSONArrayTraceParser(), input)
+	require.Len(t, traces, 2)
+	assert.Equal(t, "SELECT * FROM users WHERE id = 1", traces[0].Query)
+	assert.Equal(t, 120*time.Millisecond, traces[0].Latency)
+	assert.Equal(t, time.Date(2025, 1, 1, 12, 0, 1, 0, time.UTC), traces[1].Timestamp)
+
+	err := NewJSONArrayTraceParser().Parse(strings.NewReader(`{"Query": "SELECT 1"}`), nil)
+	assert.Error(t, err)
+}
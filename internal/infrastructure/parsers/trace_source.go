@@ -0,0 +1,435 @@
+package parsers
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"container/heap"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+	"github.com/turtacn/SQLTraceBench/pkg/types"
+)
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// compressionExtensions are stripped before a file name is used as a format hint.
+var compressionExtensions = []string{".gz", ".gzip", ".zst", ".zstd"}
+
+// errMergeStopped signals a producer goroutine that the merge has finished early.
+var errMergeStopped = errors.New("trace merge stopped")
+
+// mergeBufferSize is the number of decoded traces buffered per file during a merge.
+const mergeBufferSize = 256
+
+// TraceSource is a set of trace files read as a single stream. The files may be
+// gzip or zstd compressed; compression is detected from the file content.
+type TraceSource struct {
+	BufferSize int
+	// Config, when set, supplies per-format timestamp and latency settings.
+	Config *ParserConfig
+	// Stats, when set, is shared by the readers of all files.
+	Stats *ParseStats
+	// Progress, when set, is called as traces are emitted with the number of bytes
+	// read so far and the total size of all files, both measured on disk.
+	Progress func(readBytes, totalBytes int64)
+
+	files      []traceSourceFile
+	totalBytes int64
+	readBytes  int64
+}
+
+type traceSourceFile struct {
+	path   string
+	size   int64
+	format TraceFormat
+}
+
+// OpenTraceSource expands pattern (a file, a directory or a glob) and resolves the
+// format of every file. An empty format is detected per file from its content.
+func OpenTraceSource(pattern, format string, bufferSize int) (*TraceSource, error) {
+	paths, err := ExpandTracePaths(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	src := &TraceSource{BufferSize: bufferSize}
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		f, err := GlobalTraceReaders.Resolve(path, format)
+		if err != nil {
+			return nil, err
+		}
+		if len(src.files) > 0 && (f.NewTemplateReader == nil) != (src.files[0].format.NewTemplateReader == nil) {
+			return nil, fmt.Errorf("cannot mix template and trace formats: %s is %s, %s is %s", src.files[0].path, src.files[0].format.Name, path, f.Name)
+		}
+		src.files = append(src.files, traceSourceFile{path: path, size: info.Size(), format: f})
+		src.totalBytes += info.Size()
+	}
+	return src, nil
+}
+
+// Paths returns the files of the source in read order.
+func (s *TraceSource) Paths() []string {
+	paths := make([]string, len(s.files))
+	for i, f := range s.files {
+		paths[i] = f.path
+	}
+	return paths
+}
+
+// TotalBytes returns the combined on-disk size of all files.
+func (s *TraceSource) TotalBytes() int64 {
+	return s.totalBytes
+}
+
+// Dialect returns the SQL dialect shared by the formats of all files, or DatabaseNone
+// if a format does not tell or the files disagree.
+func (s *TraceSource) Dialect() types.DatabaseType {
+	if len(s.files) == 0 {
+		return types.DatabaseNone
+	}
+	dialect := s.files[0].format.Dialect
+	for _, f := range s.files[1:] {
+		if f.format.Dialect != dialect {
+			return types.DatabaseNone
+		}
+	}
+	return dialect
+}
+
+// HoldsTemplates reports whether the source contains pre-aggregated templates
+// (e.g. pg_stat_statements) rather than individual traces.
+func (s *TraceSource) HoldsTemplates() bool {
+	return len(s.files) > 0 && s.files[0].format.NewTemplateReader != nil
+}
+
+// ReadTemplates reads the templates of every file in the source.
+func (s *TraceSource) ReadTemplates() ([]models.SQLTemplate, error) {
+	if !s.HoldsTemplates() {
+		return nil, fmt.Errorf("trace source does not hold templates")
+	}
+	var templates []models.SQLTemplate
+	for _, f := range s.files {
+		rc, err := s.open(f.path)
+		if err != nil {
+			return nil, err
+		}
+		tpls, err := f.format.NewTemplateReader().ReadTemplates(rc)
+		rc.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", f.path, err)
+		}
+		templates = append(templates, tpls...)
+	}
+	s.reportProgress()
+	return templates, nil
+}
+
+// Parse calls the callback for every trace in the source. When the source has more
+// than one file, the files are read concurrently and their traces are merged in
+// timestamp order. Each file is expected to be ordered by time already, as server
+// logs are; the merge does not reorder traces within a file.
+func (s *TraceSource) Parse(ctx context.Context, callback func(models.SQLTrace) error) error {
+	if s.HoldsTemplates() {
+		return fmt.Errorf("trace format %s holds aggregated templates and cannot be streamed", s.files[0].format.Name)
+	}
+	if len(s.files) == 1 {
+		err := s.parseFile(s.files[0], func(trace models.SQLTrace) error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := callback(trace); err != nil {
+				return err
+			}
+			s.reportProgress()
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		s.reportProgress()
+		return nil
+	}
+	return s.merge(ctx, callback)
+}
+
+func (s *TraceSource) parseFile(f traceSourceFile, callback func(models.SQLTrace) error) error {
+	rc, err := s.open(f.path)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	cfg := s.Config.ReaderConfig(f.format.Name, s.BufferSize)
+	cfg.Stats = s.Stats
+	return f.format.NewReader(cfg).Parse(rc, callback)
+}
+
+// open opens a file for reading, decompressing it if needed, and counts the
+// bytes read from disk towards the progress of the source.
+func (s *TraceSource) open(path string) (io.ReadCloser, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	rc, err := newDecompressingReader(&countingReader{r: file, n: &s.readBytes}, file)
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return rc, nil
+}
+
+func (s *TraceSource) reportProgress() {
+	if s.Progress != nil {
+		s.Progress(atomic.LoadInt64(&s.readBytes), s.totalBytes)
+	}
+}
+
+// mergeStream is one file taking part in a k-way merge.
+type mergeStream struct {
+	index  int
+	path   string
+	traces chan models.SQLTrace
+	errc   chan error
+	head   models.SQLTrace
+}
+
+// next advances the stream. It reports false once the file is exhausted.
+func (m *mergeStream) next() (bool, error) {
+	trace, ok := <-m.traces
+	if !ok {
+		if err := <-m.errc; err != nil && !errors.Is(err, errMergeStopped) {
+			return false, fmt.Errorf("%s: %w", m.path, err)
+		}
+		return false, nil
+	}
+	m.head = trace
+	return true, nil
+}
+
+// mergeHeap orders streams by the timestamp of their head trace, breaking ties by
+// file order so the merge is deterministic.
+type mergeHeap []*mergeStream
+
+func (h mergeHeap) Len() int { return len(h) }
+func (h mergeHeap) Less(i, j int) bool {
+	if !h[i].head.Timestamp.Equal(h[j].head.Timestamp) {
+		return h[i].head.Timestamp.Before(h[j].head.Timestamp)
+	}
+	return h[i].index < h[j].index
+}
+func (h mergeHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *mergeHeap) Push(x interface{}) { *h = append(*h, x.(*mergeStream)) }
+func (h *mergeHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+func (s *TraceSource) merge(ctx context.Context, callback func(models.SQLTrace) error) error {
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	defer func() {
+		close(done)
+		wg.Wait()
+	}()
+
+	streams := make([]*mergeStream, len(s.files))
+	for i, f := range s.files {
+		m := &mergeStream{
+			index:  i,
+			path:   f.path,
+			traces: make(chan models.SQLTrace, mergeBufferSize),
+			errc:   make(chan error, 1),
+		}
+		streams[i] = m
+		wg.Add(1)
+		go func(f traceSourceFile) {
+			defer wg.Done()
+			err := s.parseFile(f, func(trace models.SQLTrace) error {
+				select {
+				case m.traces <- trace:
+					return nil
+				case <-done:
+					return errMergeStopped
+				}
+			})
+			close(m.traces)
+			m.errc <- err
+		}(f)
+	}
+
+	h := make(mergeHeap, 0, len(streams))
+	for _, m := range streams {
+		ok, err := m.next()
+		if err != nil {
+			return err
+		}
+		if ok {
+			h = append(h, m)
+		}
+	}
+	heap.Init(&h)
+
+	for h.Len() > 0 {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		m := h[0]
+		if err := callback(m.head); err != nil {
+			return err
+		}
+		s.reportProgress()
+
+		ok, err := m.next()
+		if err != nil {
+			return err
+		}
+		if ok {
+			heap.Fix(&h, 0)
+		} else {
+			heap.Pop(&h)
+		}
+	}
+	s.reportProgress()
+	return nil
+}
+
+// ExpandTracePaths returns the trace files named by pattern, which may be a single
+// file, a directory (its regular, non-hidden files) or a glob pattern. The result
+// is sorted by path.
+func ExpandTracePaths(pattern string) ([]string, error) {
+	var paths []string
+	info, err := os.Stat(pattern)
+	switch {
+	case err == nil && info.IsDir():
+		entries, err := os.ReadDir(pattern)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range entries {
+			if e.Type().IsRegular() && !strings.HasPrefix(e.Name(), ".") {
+				paths = append(paths, filepath.Join(pattern, e.Name()))
+			}
+		}
+	case err == nil:
+		return []string{pattern}, nil
+	case strings.ContainsAny(pattern, "*?["):
+		matches, globErr := filepath.Glob(pattern)
+		if globErr != nil {
+			return nil, globErr
+		}
+		for _, match := range matches {
+			if fi, err := os.Stat(match); err == nil && fi.Mode().IsRegular() {
+				paths = append(paths, match)
+			}
+		}
+	default:
+		return nil, err
+	}
+
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no trace files found at %s", pattern)
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// OpenTraceFile opens a trace file, transparently decompressing gzip and zstd content.
+func OpenTraceFile(path string) (io.ReadCloser, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	rc, err := newDecompressingReader(file, file)
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return rc, nil
+}
+
+// stripCompressionExt removes a trailing compression extension, so that
+// "slow.log.gz" can still be recognized by its ".log" extension.
+func stripCompressionExt(path string) string {
+	ext := strings.ToLower(filepath.Ext(path))
+	for _, c := range compressionExtensions {
+		if ext == c {
+			return strings.TrimSuffix(path, filepath.Ext(path))
+		}
+	}
+	return path
+}
+
+// decompressingReader closes the decompressor and the underlying file together.
+type decompressingReader struct {
+	io.Reader
+	closeFn func()
+	file    io.Closer
+}
+
+func (d *decompressingReader) Close() error {
+	if d.closeFn != nil {
+		d.closeFn()
+	}
+	return d.file.Close()
+}
+
+// newDecompressingReader wraps r with a gzip or zstd decoder when its content starts
+// with the corresponding magic number. Closing the result closes file.
+func newDecompressingReader(r io.Reader, file io.Closer) (io.ReadCloser, error) {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(len(zstdMagic))
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		// gzip.Reader reads concatenated members, as produced by appending rotated files.
+		zr, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+		return &decompressingReader{Reader: zr, closeFn: func() { zr.Close() }, file: file}, nil
+	case bytes.HasPrefix(magic, zstdMagic):
+		zr, err := zstd.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+		return &decompressingReader{Reader: zr, closeFn: zr.Close, file: file}, nil
+	default:
+		return &decompressingReader{Reader: br, file: file}, nil
+	}
+}
+
+// countingReader adds the number of bytes read to n.
+type countingReader struct {
+	r io.Reader
+	n *int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	atomic.AddInt64(c.n, int64(n))
+	return n, err
+}
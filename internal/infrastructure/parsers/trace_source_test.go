@@ -0,0 +1,131 @@
+package parsers
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+)
+
+func writeGzip(t *testing.T, path, content string) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	_, err := zw.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0644))
+}
+
+func writeZstd(t *testing.T, path, content string) {
+	var buf bytes.Buffer
+	zw, err := zstd.NewWriter(&buf)
+	require.NoError(t, err)
+	_, err = zw.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0644))
+}
+
+func queries(traces []models.SQLTrace) []string {
+	out := make([]string, len(traces))
+	for i, tr := range traces {
+		out[i] = tr.Query
+	}
+	return out
+}
+
+func TestTraceSource_MergesCompressedFiles(t *testing.T) {
+	dir := t.TempDir()
+	writeGzip(t, filepath.Join(dir, "a.jsonl.gz"),
+		`{"query": "a1", "timestamp": "2025-01-01T00:00:01Z"}
+{"query": "a3", "timestamp": "2025-01-01T00:00:03Z"}
+{"query": "a5", "timestamp": "2025-01-01T00:00:05Z"}
+`)
+	writeZstd(t, filepath.Join(dir, "b.jsonl.zst"),
+		`{"query": "b2", "timestamp": "2025-01-01T00:00:02Z"}
+{"query": "b3", "timestamp": "2025-01-01T00:00:03Z"}
+`)
+	// A slow log in the same directory is detected independently.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "c.log"), []byte(`# Time: 2025-01-01T00:00:04Z
+# Query_time: 0.1
+SELECT c4;
+`), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".hidden"), []byte("ignored"), 0644))
+
+	src, err := OpenTraceSource(dir, "", 0)
+	require.NoError(t, err)
+	assert.Len(t, src.Paths(), 3)
+
+	var lastRead, lastTotal int64
+	src.Progress = func(readBytes, totalBytes int64) {
+		lastRead, lastTotal = readBytes, totalBytes
+	}
+
+	var traces []models.SQLTrace
+	err = src.Parse(context.Background(), func(trace models.SQLTrace) error {
+		traces = append(traces, trace)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a1", "b2", "a3", "b3", "SELECT c4", "a5"}, queries(traces))
+	assert.Equal(t, src.TotalBytes(), lastTotal)
+	assert.Equal(t, src.TotalBytes(), lastRead)
+}
+
+func TestTraceSource_Glob(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "1.jsonl"), []byte(`{"query": "one", "timestamp": "2025-01-01T00:00:01Z"}`+"\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "2.jsonl"), []byte(`{"query": "two", "timestamp": "2025-01-01T00:00:02Z"}`+"\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("not a trace\n"), 0644))
+
+	src, err := OpenTraceSource(filepath.Join(dir, "*.jsonl"), "", 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "1.jsonl"), filepath.Join(dir, "2.jsonl")}, src.Paths())
+
+	_, err = OpenTraceSource(filepath.Join(dir, "*.csv"), "", 0)
+	assert.Error(t, err)
+}
+
+func TestTraceSource_CallbackErrorStopsMerge(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"a.jsonl", "b.jsonl"} {
+		var buf bytes.Buffer
+		for i := 0; i < 1000; i++ {
+			buf.WriteString(`{"query": "SELECT 1", "timestamp": "2025-01-01T00:00:00Z"}` + "\n")
+		}
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), buf.Bytes(), 0644))
+	}
+
+	src, err := OpenTraceSource(dir, TraceFormatJSONL, 0)
+	require.NoError(t, err)
+
+	stop := errors.New("stop")
+	count := 0
+	err = src.Parse(context.Background(), func(models.SQLTrace) error {
+		count++
+		if count == 10 {
+			return stop
+		}
+		return nil
+	})
+	assert.ErrorIs(t, err, stop)
+	assert.Equal(t, 10, count)
+}
+
+func TestTraceReaderRegistry_ResolveCompressed(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "mysql-slow.log.gz")
+	writeGzip(t, path, "SELECT 1;\n")
+
+	f, err := GlobalTraceReaders.Resolve(path, "")
+	require.NoError(t, err)
+	assert.Equal(t, TraceFormatSlowLog, f.Name)
+}
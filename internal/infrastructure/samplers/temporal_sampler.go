@@ -5,18 +5,18 @@ import (
 	"sort"
 	"time"
 
-	"github.com/turtacn/SQLTraceBench/internal/domain/services"
+	"github.com/turtacn/SQLTraceBench/internal/domain/models"
 )
 
 type TemporalSampler struct {
-	Pattern    *services.TemporalPattern
+	Pattern    *models.TemporalPattern
 	BaseTime   time.Time
 	weights    []float64
 	cumWeights []float64
 	rand       *rand.Rand
 }
 
-func NewTemporalSampler(pattern *services.TemporalPattern, baseTime time.Time) *TemporalSampler {
+func NewTemporalSampler(pattern *models.TemporalPattern, baseTime time.Time) *TemporalSampler {
 	s := &TemporalSampler{
 		Pattern:  pattern,
 		BaseTime: baseTime,
@@ -0,0 +1,553 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+	"github.com/turtacn/SQLTraceBench/internal/domain/repositories"
+)
+
+// Files of a saved run, relative to its directory.
+const (
+	templatesFile  = "templates.json"
+	parametersFile = "parameters.json"
+)
+
+// ErrRunNotFound is returned when a run has no saved templates or parameter model.
+var ErrRunNotFound = errors.New("run not found")
+
+// NewRunID returns a run ID that sorts by creation time.
+func NewRunID() string {
+	return time.Now().UTC().Format("20060102T150405Z") + "-" + uuid.NewString()[:8]
+}
+
+// ListRuns returns the IDs of the runs saved under baseDir, in name order.
+func ListRuns(baseDir string) ([]string, error) {
+	entries, err := os.ReadDir(baseDir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var runs []string
+	for _, e := range entries {
+		if e.IsDir() {
+			runs = append(runs, e.Name())
+		}
+	}
+	sort.Strings(runs)
+	return runs, nil
+}
+
+// LatestRun returns the ID of the run saved last under baseDir.
+func LatestRun(baseDir string) (string, error) {
+	runs, err := ListRuns(baseDir)
+	if err != nil {
+		return "", err
+	}
+	latest, latestTime := "", time.Time{}
+	for _, run := range runs {
+		info, err := os.Stat(filepath.Join(baseDir, run, templatesFile))
+		if err != nil {
+			continue
+		}
+		if !info.ModTime().Before(latestTime) {
+			latest, latestTime = run, info.ModTime()
+		}
+	}
+	if latest == "" {
+		return "", fmt.Errorf("no runs saved in %s: %w", baseDir, ErrRunNotFound)
+	}
+	return latest, nil
+}
+
+// runPath returns the path of a file of a run, rejecting IDs that would escape baseDir.
+func runPath(baseDir, runID, file string) (string, error) {
+	if runID == "" || runID == "." || runID == ".." || strings.ContainsAny(runID, `/\`) {
+		return "", fmt.Errorf("invalid run ID %q", runID)
+	}
+	return filepath.Join(baseDir, runID, file), nil
+}
+
+// FileTemplateRepository stores the templates of one run as a JSON file under
+// <baseDir>/<runID>/templates.json. Templates are identified by their GroupKey.
+type FileTemplateRepository struct {
+	mu    sync.Mutex
+	path  string
+	runID string
+}
+
+var _ repositories.TemplateRepository = (*FileTemplateRepository)(nil)
+
+// NewFileTemplateRepository creates a repository for the templates of a run.
+func NewFileTemplateRepository(baseDir, runID string) (*FileTemplateRepository, error) {
+	path, err := runPath(baseDir, runID, templatesFile)
+	if err != nil {
+		return nil, err
+	}
+	return &FileTemplateRepository{path: path, runID: runID}, nil
+}
+
+// Save adds a template to the run, replacing the one with the same GroupKey.
+func (r *FileTemplateRepository) Save(ctx context.Context, tpl models.SQLTemplate) error {
+	return r.SaveAll(ctx, []models.SQLTemplate{tpl})
+}
+
+// SaveAll adds templates to the run in one write, replacing those with the same GroupKey.
+func (r *FileTemplateRepository) SaveAll(ctx context.Context, tpls []models.SQLTemplate) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, err := r.load()
+	if err != nil && !errors.Is(err, ErrRunNotFound) {
+		return err
+	}
+	for _, tpl := range tpls {
+		replaced := false
+		for i := range stored {
+			if stored[i].GroupKey == tpl.GroupKey {
+				stored[i], replaced = tpl, true
+				break
+			}
+		}
+		if !replaced {
+			stored = append(stored, tpl)
+		}
+	}
+	return writeJSONFile(r.path, stored)
+}
+
+// List returns the templates of the run in the order they were saved.
+func (r *FileTemplateRepository) List(ctx context.Context) ([]models.SQLTemplate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.load()
+}
+
+// FindByTable returns the templates of the run that reference a table.
+func (r *FileTemplateRepository) FindByTable(ctx context.Context, table string) ([]models.SQLTemplate, error) {
+	tpls, err := r.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var found []models.SQLTemplate
+	for _, tpl := range tpls {
+		for _, t := range tpl.Tables {
+			if strings.EqualFold(t, table) {
+				found = append(found, tpl)
+				break
+			}
+		}
+	}
+	return found, nil
+}
+
+// Delete removes the template with the given GroupKey from the run.
+func (r *FileTemplateRepository) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, err := r.load()
+	if err != nil {
+		return err
+	}
+	for i := range stored {
+		if stored[i].GroupKey == id {
+			return writeJSONFile(r.path, append(stored[:i], stored[i+1:]...))
+		}
+	}
+	return fmt.Errorf("template %q not found in run %s", id, r.runID)
+}
+
+func (r *FileTemplateRepository) load() ([]models.SQLTemplate, error) {
+	var tpls []models.SQLTemplate
+	if err := readJSONFile(r.path, &tpls); err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("templates of run %s: %w", r.runID, ErrRunNotFound)
+		}
+		return nil, err
+	}
+	return tpls, nil
+}
+
+// FileParameterRepository stores parameter models as JSON files under
+// <baseDir>/<runID>/parameters.json. Save and Update write the model of the run the
+// repository was created for; Load reads the model of any run by its ID.
+//
+// Parameter values keep their Go types (int64, float64, string, bool, time.Time, nil
+// and lists of them) across a save and load, so that regenerated queries bind the
+// same argument types as the source traces.
+type FileParameterRepository struct {
+	mu      sync.Mutex
+	baseDir string
+	runID   string
+}
+
+var _ repositories.ParameterRepository = (*FileParameterRepository)(nil)
+
+// NewFileParameterRepository creates a repository that saves the model of a run.
+func NewFileParameterRepository(baseDir, runID string) (*FileParameterRepository, error) {
+	if _, err := runPath(baseDir, runID, parametersFile); err != nil {
+		return nil, err
+	}
+	return &FileParameterRepository{baseDir: baseDir, runID: runID}, nil
+}
+
+// Save writes the parameter model of the repository's run, replacing any saved one.
+func (r *FileParameterRepository) Save(ctx context.Context, m *models.WorkloadParameterModel) error {
+	if m == nil {
+		return fmt.Errorf("parameter model is nil")
+	}
+	path, _ := runPath(r.baseDir, r.runID, parametersFile)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return writeJSONFile(path, encodeParameterModel(m))
+}
+
+// Load reads the parameter model of a run.
+func (r *FileParameterRepository) Load(ctx context.Context, id string) (*models.WorkloadParameterModel, error) {
+	path, err := runPath(r.baseDir, id, parametersFile)
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var stored storedParameterModel
+	if err := readJSONFile(path, &stored); err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("parameter model of run %s: %w", id, ErrRunNotFound)
+		}
+		return nil, err
+	}
+	return stored.decode()
+}
+
+// Update overwrites the parameter model of the repository's run, which must have been saved.
+func (r *FileParameterRepository) Update(ctx context.Context, m *models.WorkloadParameterModel) error {
+	path, _ := runPath(r.baseDir, r.runID, parametersFile)
+	if _, err := os.Stat(path); err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("parameter model of run %s: %w", r.runID, ErrRunNotFound)
+		}
+		return err
+	}
+	return r.Save(ctx, m)
+}
+
+// storedParameterModel is the file format of a WorkloadParameterModel.
+type storedParameterModel struct {
+	Templates map[string]map[string]*storedParameter `json:"templates"`
+	Joints    map[string]*storedJoint                `json:"joints,omitempty"`
+	Ranges    map[string][]*storedRange              `json:"ranges,omitempty"`
+	// Sessions keeps its think times as JSON numbers, which decode as the float64
+	// seconds they are.
+	Sessions *models.SessionModel    `json:"sessions,omitempty"`
+	Temporal *models.TemporalPattern `json:"temporal,omitempty"`
+}
+
+// storedParameter is a ParameterModel whose values are tagged with their types.
+type storedParameter struct {
+	models.ParameterModel
+	TopValues []typedValue `json:"top_values"`
+}
+
+// storedRange is a RangeParameterModel whose width values are tagged with their types.
+type storedRange struct {
+	models.RangeParameterModel
+	Width *storedParameter `json:"width"`
+}
+
+// storedJoint is a JointParameterModel whose values are tagged with their types.
+type storedJoint struct {
+	models.JointParameterModel
+	Tuples       [][]typedValue      `json:"tuples"`
+	Conditionals []storedConditional `json:"conditionals,omitempty"`
+}
+
+// storedConditional is a ConditionalDistribution whose values are tagged with their types.
+type storedConditional struct {
+	models.ConditionalDistribution
+	Given  typedValue     `json:"given"`
+	Values [][]typedValue `json:"values"`
+}
+
+func encodeParameterModel(m *models.WorkloadParameterModel) storedParameterModel {
+	stored := storedParameterModel{
+		Templates: make(map[string]map[string]*storedParameter, len(m.TemplateParameters)),
+		Sessions:  m.Sessions,
+		Temporal:  m.Temporal,
+	}
+	for key, params := range m.TemplateParameters {
+		out := make(map[string]*storedParameter, len(params))
+		for name, p := range params {
+			if p == nil {
+				continue
+			}
+			out[name] = encodeParameter(p)
+		}
+		stored.Templates[key] = out
+	}
+	for key, ranges := range m.TemplateRanges {
+		for _, r := range ranges {
+			if r == nil || r.Width == nil {
+				continue
+			}
+			if stored.Ranges == nil {
+				stored.Ranges = make(map[string][]*storedRange, len(m.TemplateRanges))
+			}
+			sr := &storedRange{RangeParameterModel: *r, Width: encodeParameter(r.Width)}
+			sr.RangeParameterModel.Width = nil
+			stored.Ranges[key] = append(stored.Ranges[key], sr)
+		}
+	}
+	for key, j := range m.TemplateJoints {
+		if j == nil {
+			continue
+		}
+		if stored.Joints == nil {
+			stored.Joints = make(map[string]*storedJoint, len(m.TemplateJoints))
+		}
+		sj := &storedJoint{JointParameterModel: *j, Tuples: make([][]typedValue, len(j.Tuples))}
+		sj.JointParameterModel.Tuples, sj.JointParameterModel.Conditionals = nil, nil
+		for i, tuple := range j.Tuples {
+			sj.Tuples[i] = encodeValues(tuple)
+		}
+		for _, c := range j.Conditionals {
+			sc := storedConditional{ConditionalDistribution: c, Given: encodeValue(c.Given), Values: make([][]typedValue, len(c.Values))}
+			sc.ConditionalDistribution.Given, sc.ConditionalDistribution.Values = nil, nil
+			for i, values := range c.Values {
+				sc.Values[i] = encodeValues(values)
+			}
+			sj.Conditionals = append(sj.Conditionals, sc)
+		}
+		stored.Joints[key] = sj
+	}
+	return stored
+}
+
+func (s storedParameterModel) decode() (*models.WorkloadParameterModel, error) {
+	m := models.NewWorkloadParameterModel()
+	m.Sessions = s.Sessions
+	m.Temporal = s.Temporal
+	for key, params := range s.Templates {
+		out := make(map[string]*models.ParameterModel, len(params))
+		for name, sp := range params {
+			p, err := sp.decode()
+			if err != nil {
+				return nil, fmt.Errorf("parameter %s of template %q: %w", name, key, err)
+			}
+			out[name] = p
+		}
+		m.TemplateParameters[key] = out
+	}
+	for key, ranges := range s.Ranges {
+		for _, sr := range ranges {
+			r := sr.RangeParameterModel
+			if sr.Width != nil {
+				width, err := sr.Width.decode()
+				if err != nil {
+					return nil, fmt.Errorf("range of %s in template %q: %w", r.Column, key, err)
+				}
+				r.Width = width
+			}
+			m.TemplateRanges[key] = append(m.TemplateRanges[key], &r)
+		}
+	}
+	for key, sj := range s.Joints {
+		j := sj.JointParameterModel
+		j.Tuples = make([][]interface{}, len(sj.Tuples))
+		var err error
+		for i, tuple := range sj.Tuples {
+			if j.Tuples[i], err = decodeValues(tuple); err != nil {
+				return nil, fmt.Errorf("joint parameters of template %q: %w", key, err)
+			}
+		}
+		for _, sc := range sj.Conditionals {
+			c := sc.ConditionalDistribution
+			if c.Given, err = sc.Given.decode(); err != nil {
+				return nil, fmt.Errorf("joint parameters of template %q: %w", key, err)
+			}
+			c.Values = make([][]interface{}, len(sc.Values))
+			for i, values := range sc.Values {
+				if c.Values[i], err = decodeValues(values); err != nil {
+					return nil, fmt.Errorf("joint parameters of template %q: %w", key, err)
+				}
+			}
+			j.Conditionals = append(j.Conditionals, c)
+		}
+		m.TemplateJoints[key] = &j
+	}
+	return m, nil
+}
+
+// typedValue is a parameter value with its type, which JSON alone does not keep
+// (every number would decode as float64).
+type typedValue struct {
+	Type  string          `json:"t"`
+	Value json.RawMessage `json:"v,omitempty"`
+}
+
+func encodeValue(v interface{}) typedValue {
+	var typ string
+	switch x := v.(type) {
+	case nil:
+		return typedValue{Type: "null"}
+	case bool:
+		typ = "bool"
+	case int, int8, int16, int32, int64:
+		typ = "int"
+	case uint, uint8, uint16, uint32, uint64:
+		typ = "uint"
+	case float32, float64:
+		typ = "float"
+	case time.Time:
+		typ, v = "time", x.Format(time.RFC3339Nano)
+	case []interface{}:
+		list := make([]typedValue, len(x))
+		for i, elem := range x {
+			list[i] = encodeValue(elem)
+		}
+		typ, v = "list", list
+	case []byte:
+		typ, v = "string", string(x)
+	case string:
+		typ = "string"
+	default:
+		typ, v = "string", fmt.Sprint(x)
+	}
+	raw, _ := json.Marshal(v)
+	return typedValue{Type: typ, Value: raw}
+}
+
+func encodeParameter(p *models.ParameterModel) *storedParameter {
+	sp := &storedParameter{ParameterModel: *p, TopValues: encodeValues(p.TopValues)}
+	sp.ParameterModel.TopValues = nil
+	return sp
+}
+
+func (sp *storedParameter) decode() (*models.ParameterModel, error) {
+	p := sp.ParameterModel
+	values, err := decodeValues(sp.TopValues)
+	if err != nil {
+		return nil, err
+	}
+	p.TopValues = values
+	return &p, nil
+}
+
+func encodeValues(values []interface{}) []typedValue {
+	if values == nil {
+		return nil
+	}
+	out := make([]typedValue, len(values))
+	for i, v := range values {
+		out[i] = encodeValue(v)
+	}
+	return out
+}
+
+func decodeValues(values []typedValue) ([]interface{}, error) {
+	if values == nil {
+		return nil, nil
+	}
+	out := make([]interface{}, len(values))
+	for i, tv := range values {
+		v, err := tv.decode()
+		if err != nil {
+			return nil, err
+		}
+		out[i] = v
+	}
+	return out, nil
+}
+
+func (tv typedValue) decode() (interface{}, error) {
+	var err error
+	switch tv.Type {
+	case "null":
+		return nil, nil
+	case "bool":
+		var b bool
+		err = json.Unmarshal(tv.Value, &b)
+		return b, err
+	case "int":
+		var n int64
+		err = json.Unmarshal(tv.Value, &n)
+		return n, err
+	case "uint":
+		var n uint64
+		err = json.Unmarshal(tv.Value, &n)
+		return n, err
+	case "float":
+		var f float64
+		err = json.Unmarshal(tv.Value, &f)
+		return f, err
+	case "string":
+		var s string
+		err = json.Unmarshal(tv.Value, &s)
+		return s, err
+	case "time":
+		var s string
+		if err = json.Unmarshal(tv.Value, &s); err != nil {
+			return nil, err
+		}
+		return time.Parse(time.RFC3339Nano, s)
+	case "list":
+		var list []typedValue
+		if err = json.Unmarshal(tv.Value, &list); err != nil {
+			return nil, err
+		}
+		out := make([]interface{}, len(list))
+		for i, elem := range list {
+			if out[i], err = elem.decode(); err != nil {
+				return nil, err
+			}
+		}
+		return out, nil
+	}
+	return nil, fmt.Errorf("unknown value type %q", tv.Type)
+}
+
+// writeJSONFile writes v to path atomically, creating its directory.
+func writeJSONFile(path string, v interface{}) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func readJSONFile(path string, v interface{}) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/turtacn/SQLTraceBench/internal/domain/models"
+	"github.com/turtacn/SQLTraceBench/pkg/types"
+)
+
+func TestFileTemplateRepository(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	repo, err := NewFileTemplateRepository(dir, "run1")
+	require.NoError(t, err)
+
+	_, err = repo.List(ctx)
+	assert.ErrorIs(t, err, ErrRunNotFound)
+
+	users := models.SQLTemplate{GroupKey: "select * from users where id = :p1", RawSQL: "select * from users where id = :p1", Weight: 3,
+		Parameters: []string{":p1"}, QueryType: types.QueryUpdate, Tables: []string{"users"}}
+	orders := models.SQLTemplate{GroupKey: "select * from orders", RawSQL: "select * from orders", Weight: 1, Tables: []string{"orders"}}
+	require.NoError(t, repo.SaveAll(ctx, []models.SQLTemplate{users, orders}))
+
+	users.Weight = 5
+	require.NoError(t, repo.Save(ctx, users))
+	tpls, err := repo.List(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []models.SQLTemplate{users, orders}, tpls)
+
+	found, err := repo.FindByTable(ctx, "ORDERS")
+	require.NoError(t, err)
+	assert.Equal(t, []models.SQLTemplate{orders}, found)
+
+	require.NoError(t, repo.Delete(ctx, orders.GroupKey))
+	assert.Error(t, repo.Delete(ctx, orders.GroupKey))
+	tpls, err = repo.List(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []models.SQLTemplate{users}, tpls)
+
+	// Runs are independent.
+	other, err := NewFileTemplateRepository(dir, "run2")
+	require.NoError(t, err)
+	require.NoError(t, other.Save(ctx, orders))
+	runs, err := ListRuns(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"run1", "run2"}, runs)
+	latest, err := LatestRun(dir)
+	require.NoError(t, err)
+	assert.Equal(t, "run2", latest)
+
+	_, err = NewFileTemplateRepository(dir, "../escape")
+	assert.Error(t, err)
+}
+
+func TestFileParameterRepository(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	repo, err := NewFileParameterRepository(dir, NewRunID())
+	require.NoError(t, err)
+	assert.ErrorIs(t, repo.Update(ctx, models.NewWorkloadParameterModel()), ErrRunNotFound)
+
+	ts := time.Date(2025, 1, 2, 3, 4, 5, 6, time.UTC)
+	m := models.NewWorkloadParameterModel()
+	m.TemplateParameters["select * from t where a = :p1 and b in (:p2)"] = map[string]*models.ParameterModel{
+		":p1": {ParamName: ":p1", DistType: models.DistZipfian, ZipfS: 1.2, Cardinality: 5,
+			TopValues:      []interface{}{int64(42), 1.5, "42", true, nil, ts, uint64(7)},
+			TopFrequencies: []int{10, 5, 3, 2, 1, 1, 1}},
+		":p3": {ParamName: ":p3", DistType: models.DistHistogram, TopValues: []interface{}{2.5}, TopFrequencies: []int{1},
+			Continuous: &models.ContinuousModel{ValueKind: "float", Min: 1, Max: 3, BinEdges: []float64{1, 2, 3}, BinCounts: []int{4, 6}, AIC: 12.5, KS: 0.1}},
+		":p2": {ParamName: ":p2", DistType: models.DistEmpirical,
+			TopValues: []interface{}{[]interface{}{int64(1), "x"}}, TopFrequencies: []int{1}},
+	}
+	m.TemplateJoints["select * from t where a = :p1 and c = :p2"] = &models.JointParameterModel{
+		Params:           []string{":p1", ":p2"},
+		Tuples:           [][]interface{}{{int64(1), "a"}, {int64(2), ts}},
+		TupleFrequencies: []int{3, 2},
+		Conditionals: []models.ConditionalDistribution{{Given: int64(3), Count: 1,
+			Values: [][]interface{}{nil, {"c"}}, Frequencies: [][]int{nil, {1}}}},
+		Total: 7,
+	}
+	m.TemplateRanges["select * from t where d between :p1 and :p2"] = []*models.RangeParameterModel{{
+		Column: "d", Lower: ":p1", Upper: ":p2", Kind: models.RangeTime,
+		Width: &models.ParameterModel{ParamName: ":p2-:p1", DistType: models.DistEmpirical,
+			TopValues: []interface{}{86400.0, 3600.5}, TopFrequencies: []int{3, 1}},
+	}}
+	m.Sessions = &models.SessionModel{Order: 1, States: []models.SessionState{
+		{Next: []string{"login"}, Counts: []int{4}},
+		{Context: []string{"login"}, Next: []string{"", "cart"}, Counts: []int{1, 3},
+			ThinkTime: &models.ParameterModel{ParamName: "think_time", DataType: "FLOAT", DistType: models.DistEmpirical,
+				TopValues: []interface{}{1.5, 2.0}, TopFrequencies: []int{2, 1}}},
+	}}
+	m.Temporal = &models.TemporalPattern{Window: time.Hour, BinCounts: map[int]int{0: 5, 3: 2, 11: 1}}
+	require.NoError(t, repo.Save(ctx, m))
+	require.NoError(t, repo.Update(ctx, m))
+
+	loaded, err := repo.Load(ctx, repo.runID)
+	require.NoError(t, err)
+	assert.Equal(t, m, loaded, "values keep their types")
+
+	_, err = repo.Load(ctx, "missing")
+	assert.ErrorIs(t, err, ErrRunNotFound)
+}
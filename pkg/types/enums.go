@@ -1,6 +1,9 @@
 package types
 
-import "strings"
+import (
+	"fmt"
+	"strings"
+)
 
 type DatabaseType int
 
@@ -55,6 +58,41 @@ func (q QueryType) String() string {
 	return [...]string{"SELECT", "INSERT", "UPDATE", "DELETE", "DDL", "OTHER"}[q]
 }
 
+// QueryTypeFromString parses a query type name, ignoring case. Unknown names are QueryOther.
+func QueryTypeFromString(s string) QueryType {
+	switch strings.ToUpper(s) {
+	case "SELECT":
+		return QuerySelect
+	case "INSERT":
+		return QueryInsert
+	case "UPDATE":
+		return QueryUpdate
+	case "DELETE":
+		return QueryDelete
+	case "DDL":
+		return QueryDDL
+	default:
+		return QueryOther
+	}
+}
+
+// MarshalText encodes the query type by name, so JSON and YAML files read "SELECT" rather than 0.
+func (q QueryType) MarshalText() ([]byte, error) {
+	if q < QuerySelect || q > QueryOther {
+		return nil, fmt.Errorf("invalid query type %d", int(q))
+	}
+	return []byte(q.String()), nil
+}
+
+// UnmarshalText decodes a query type name.
+func (q *QueryType) UnmarshalText(text []byte) error {
+	*q = QueryTypeFromString(string(text))
+	if *q == QueryOther && !strings.EqualFold(string(text), "OTHER") {
+		return fmt.Errorf("unknown query type %q", text)
+	}
+	return nil
+}
+
 type ParameterType int
 
 const (
@@ -77,10 +115,12 @@ const (
 	DistributionNormal
 	DistributionZipfian
 	DistributionExponential
+	DistributionLogNormal
+	DistributionHistogram
 )
 
 func (d DistributionType) String() string {
-	return [...]string{"uniform", "normal", "zipfian", "exponential"}[d]
+	return [...]string{"uniform", "normal", "zipfian", "exponential", "lognormal", "histogram"}[d]
 }
 
 type BenchmarkStatus int
@@ -35,6 +35,17 @@ func TestQueryType(t *testing.T) {
 	assert.Equal(t, "DELETE", QueryDelete.String())
 	assert.Equal(t, "DDL", QueryDDL.String())
 	assert.Equal(t, "OTHER", QueryOther.String())
+
+	assert.Equal(t, QueryUpdate, QueryTypeFromString("update"))
+	assert.Equal(t, QueryOther, QueryTypeFromString("merge"))
+
+	text, err := QueryDelete.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "DELETE", string(text))
+	var q QueryType
+	assert.NoError(t, q.UnmarshalText([]byte("ddl")))
+	assert.Equal(t, QueryDDL, q)
+	assert.Error(t, q.UnmarshalText([]byte("merge")))
 }
 
 func TestParameterType(t *testing.T) {
@@ -51,6 +62,8 @@ func TestDistributionType(t *testing.T) {
 	assert.Equal(t, "normal", DistributionNormal.String())
 	assert.Equal(t, "zipfian", DistributionZipfian.String())
 	assert.Equal(t, "exponential", DistributionExponential.String())
+	assert.Equal(t, "lognormal", DistributionLogNormal.String())
+	assert.Equal(t, "histogram", DistributionHistogram.String())
 }
 
 func TestBenchmarkStatus(t *testing.T) {
@@ -51,6 +51,11 @@ func (l *Logger) Info(msg string, fields ...Field) {
 	l.WithFields(fieldsToLogrus(fields)).Info(msg)
 }
 
+// Warn logs a message at the warn level with structured fields.
+func (l *Logger) Warn(msg string, fields ...Field) {
+	l.WithFields(fieldsToLogrus(fields)).Warn(msg)
+}
+
 // Error logs a message at the error level with structured fields.
 func (l *Logger) Error(msg string, fields ...Field) {
 	l.WithFields(fieldsToLogrus(fields)).Error(msg)
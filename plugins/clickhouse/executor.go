@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ClickHouse/clickhouse-go/v2"
 	"github.com/turtacn/SQLTraceBench/pkg/proto"
 )
 
@@ -25,9 +26,14 @@ func (e *BenchmarkExecutor) ExecuteQuery(ctx context.Context, req *proto.Execute
 		}, nil
 	}
 	start := time.Now()
+	// Named placeholders ({name:Type}) are bound as query parameters.
 	args := make([]interface{}, len(req.Args))
 	for i, v := range req.Args {
-		args[i] = v
+		if i < len(req.ArgNames) {
+			args[i] = clickhouse.Named(req.ArgNames[i], v)
+		} else {
+			args[i] = v
+		}
 	}
 	_, err := e.conn.ExecContext(ctx, req.Sql, args...)
 	duration := time.Since(start)
@@ -2,6 +2,7 @@ package integration
 
 import (
 	"context"
+	"fmt"
 	"math"
 	"math/rand"
 	"sort"
@@ -60,7 +61,8 @@ func TestEndToEnd_GenerationFidelity(t *testing.T) {
 
 	// 4. KS Test for "customer_id" parameter
 	originalDist := extractDistFromTraces(traces, ":customer_id")
-	generatedDist := extractIntsFromWorkloadForQuery(workload, "SELECT * FROM orders WHERE customer_id = :customer_id")
+	// Generated queries are rendered from the template, with '?' placeholders.
+	generatedDist := extractIntsFromWorkloadForQuery(workload, "select * from orders where customer_id = ?")
 
 	dStat := ksStatistic(originalDist, generatedDist)
 
@@ -68,6 +70,102 @@ func TestEndToEnd_GenerationFidelity(t *testing.T) {
 	assert.Less(t, dStat, 0.40, "Distributions should be similar, D=%f", dStat)
 }
 
+func TestEndToEnd_JointParameterFidelity(t *testing.T) {
+	// 1. Mock traces where each user belongs to one tenant.
+	traces := make([]models.SQLTrace, 0, 3000)
+	startTime := time.Now()
+	zipfSrc := rand.NewZipf(rand.New(rand.NewSource(321)), 1.3, 1.0, 199)
+	observed := make(map[[2]int]bool)
+	for i := 0; i < 3000; i++ {
+		user := int(zipfSrc.Uint64())
+		tenant := user % 17
+		observed[[2]int{tenant, user}] = true
+		traces = append(traces, models.SQLTrace{
+			Query:     fmt.Sprintf("SELECT * FROM orders WHERE tenant_id = %d AND user_id = %d", tenant, user),
+			Timestamp: startTime.Add(time.Duration(i) * time.Second),
+		})
+	}
+
+	// 2. Generate
+	workload, err := generation.NewService().GenerateWorkload(context.Background(), generation.GenerateRequest{
+		SourceTraces: traces,
+		Count:        3000,
+	})
+	require.NoError(t, err)
+	require.Len(t, workload.Queries, 3000)
+
+	// 3. Every generated (tenant, user) pair co-occurs in the traces.
+	unobserved := 0
+	for _, q := range workload.Queries {
+		require.Len(t, q.Args, 2)
+		tenant, user := toInt(t, q.Args[0]), toInt(t, q.Args[1])
+		if !observed[[2]int{tenant, user}] {
+			unobserved++
+		}
+	}
+	assert.Zero(t, unobserved, "generated pairs should co-occur in the source traces")
+}
+
+func TestEndToEnd_RangePredicateFidelity(t *testing.T) {
+	// 1. Mock scans over time windows of an hour, a day or a week.
+	const layout = "2006-01-02 15:04:05"
+	rng := rand.New(rand.NewSource(99))
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	windows := []time.Duration{time.Hour, 24 * time.Hour, 7 * 24 * time.Hour}
+	traces := make([]models.SQLTrace, 0, 2000)
+	sourceWidths := make(map[time.Duration]float64)
+	for i := 0; i < 2000; i++ {
+		w := windows[0]
+		if r := rng.Float64(); r > 0.9 {
+			w = windows[2]
+		} else if r > 0.6 {
+			w = windows[1]
+		}
+		sourceWidths[w] += 1.0 / 2000
+		lo := base.Add(time.Duration(rng.Intn(30*24)) * time.Hour)
+		traces = append(traces, models.SQLTrace{
+			Query: fmt.Sprintf("SELECT count() FROM events WHERE ts BETWEEN '%s' AND '%s'", lo.Format(layout), lo.Add(w).Format(layout)),
+		})
+	}
+
+	// 2. Generate
+	workload, err := generation.NewService().GenerateWorkload(context.Background(), generation.GenerateRequest{
+		SourceTraces: traces,
+		Count:        2000,
+	})
+	require.NoError(t, err)
+	require.Len(t, workload.Queries, 2000)
+
+	// 3. Every range is valid, and the windows are as wide as in the traces.
+	widths := make(map[time.Duration]float64)
+	for _, q := range workload.Queries {
+		require.Len(t, q.Args, 2)
+		lo, err := time.Parse(layout, q.Args[0].(string))
+		require.NoError(t, err)
+		hi, err := time.Parse(layout, q.Args[1].(string))
+		require.NoError(t, err)
+		require.False(t, hi.Before(lo), "inverted range %v - %v", lo, hi)
+		widths[hi.Sub(lo)] += 1.0 / 2000
+	}
+	assert.Len(t, widths, len(windows))
+	for _, w := range windows {
+		assert.InDelta(t, sourceWidths[w], widths[w], 0.05, "share of %v windows", w)
+	}
+}
+
+func toInt(t *testing.T, v interface{}) int {
+	switch n := v.(type) {
+	case int:
+		return n
+	case int64:
+		return int(n)
+	case float64:
+		return int(n)
+	}
+	t.Fatalf("unexpected argument %v (%T)", v, v)
+	return 0
+}
+
 func extractIntsFromWorkloadForQuery(workload *models.BenchmarkWorkload, query string) []float64 {
 	values := make([]float64, 0)
 	for _, q := range workload.Queries {
@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"os"
 	"testing"
-	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -77,8 +76,10 @@ func TestWarningSystem(t *testing.T) {
     report, err := collector.GenerateReport("json")
     require.NoError(t, err)
 
-    var data map[string]int
+    var data struct {
+        Total int `json:"total"`
+    }
     err = json.Unmarshal([]byte(report), &data)
     require.NoError(t, err)
-    assert.Equal(t, 1, data["total"])
+    assert.Equal(t, 1, data.Total)
 }
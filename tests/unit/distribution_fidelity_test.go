@@ -3,6 +3,7 @@ package unit
 import (
 	"fmt"
 	"math"
+	"math/rand"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -95,3 +96,67 @@ func TestZipfDistributionFidelity(t *testing.T) {
 		lastFreq = currentFreq
 	}
 }
+
+func TestJointDistributionFidelity(t *testing.T) {
+	// 1. Setup: 20 tenants of 5 users each, with users drawn from a Zipf distribution.
+	groupKey := "SELECT_FROM_ORDERS"
+	zipf := rand.NewZipf(rand.New(rand.NewSource(7)), 1.2, 1.0, 99)
+	traces := make([]models.SQLTrace, 0, 5000)
+	for i := 0; i < 5000; i++ {
+		user := int(zipf.Uint64())
+		traces = append(traces, models.SQLTrace{Parameters: map[string]interface{}{
+			":tenant_id": user / 5,
+			":user_id":   user,
+		}})
+	}
+
+	// Keep only some combinations as tuples so that the conditionals are exercised too.
+	analyzer := services.NewParameterAnalyzer()
+	analyzer.JointTopK = 20
+	workloadModel := models.NewWorkloadParameterModel()
+	workloadModel.TemplateParameters[groupKey] = analyzer.Analyze(traces)
+	workloadModel.TemplateJoints[groupKey] = analyzer.AnalyzeJoint(traces)
+	synthesizer := services.NewSynthesizer(workloadModel)
+	template := &models.SQLTemplate{GroupKey: groupKey, Parameters: []string{":tenant_id", ":user_id"}}
+
+	// 2. Action: Generate pairs of values.
+	source := pairFrequencies(len(traces), func(i int) (interface{}, interface{}) {
+		return traces[i].Parameters[":tenant_id"], traces[i].Parameters[":user_id"]
+	})
+	numSamples := 20000
+	generated := make([][]interface{}, numSamples)
+	for i := range generated {
+		args, err := synthesizer.FillParameters(template)
+		assert.NoError(t, err)
+		generated[i] = args
+	}
+	synthetic := pairFrequencies(numSamples, func(i int) (interface{}, interface{}) {
+		return generated[i][0], generated[i][1]
+	})
+
+	// 3. Verify: Every generated pair co-occurs in the source, at about the same rate.
+	distance := 0.0
+	for pair, p := range synthetic {
+		_, ok := source[pair]
+		assert.True(t, ok, "generated pair %v never co-occurs in the source", pair)
+		distance += math.Abs(p - source[pair])
+	}
+	for pair, p := range source {
+		if _, ok := synthetic[pair]; !ok {
+			distance += p
+		}
+	}
+	distance /= 2
+	t.Logf("Total variation distance of the pair distributions: %.3f", distance)
+	assert.Less(t, distance, 0.05)
+}
+
+// pairFrequencies returns the relative frequency of each pair of values.
+func pairFrequencies(n int, pair func(i int) (interface{}, interface{})) map[[2]interface{}]float64 {
+	freqs := make(map[[2]interface{}]float64)
+	for i := 0; i < n; i++ {
+		a, b := pair(i)
+		freqs[[2]interface{}{a, b}] += 1 / float64(n)
+	}
+	return freqs
+}
@@ -2,6 +2,7 @@ package unit
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/turtacn/SQLTraceBench/internal/domain/models"
@@ -22,3 +23,67 @@ func TestParameterAnalyzer_TypeInference(t *testing.T) {
 	assert.Equal(t, "DATETIME", stats["date"].DataType)
 	assert.Equal(t, 2, stats["id"].Cardinality)
 }
+
+func TestParameterAnalyzer_AnalyzeJoint(t *testing.T) {
+	traces := []models.SQLTrace{
+		{Parameters: map[string]interface{}{":tenant": 1, ":user": 10, ":ids": []interface{}{1, 2}}},
+		{Parameters: map[string]interface{}{":tenant": 1, ":user": 10, ":ids": []interface{}{3}}},
+		{Parameters: map[string]interface{}{":tenant": 1, ":user": 11, ":ids": []interface{}{4}}},
+		{Parameters: map[string]interface{}{":tenant": 2, ":user": 20, ":ids": []interface{}{5}}},
+		{Parameters: map[string]interface{}{":tenant": 2, ":user": 21, ":ids": []interface{}{6}}},
+	}
+
+	analyzer := services.NewParameterAnalyzer()
+	analyzer.JointTopK = 2
+	joint := analyzer.AnalyzeJoint(traces)
+
+	// The IN list is modeled per value and stays out of the joint model.
+	assert.Equal(t, []string{":tenant", ":user"}, joint.Params)
+	assert.Equal(t, 5, joint.Total)
+	assert.Equal(t, [][]interface{}{{1, 10}, {1, 11}}, joint.Tuples)
+	assert.Equal(t, []int{2, 1}, joint.TupleFrequencies)
+
+	// The rest is conditioned on the user, which has the most distinct values.
+	assert.Equal(t, 1, joint.Anchor)
+	if assert.Len(t, joint.Conditionals, 2) {
+		assert.Equal(t, 20, joint.Conditionals[0].Given)
+		assert.Equal(t, []interface{}{2}, joint.Conditionals[0].Values[0])
+		assert.Empty(t, joint.Conditionals[0].Values[1])
+		assert.Equal(t, 21, joint.Conditionals[1].Given)
+	}
+
+	assert.Nil(t, analyzer.AnalyzeJoint(nil))
+	assert.Nil(t, analyzer.AnalyzeJoint([]models.SQLTrace{{Parameters: map[string]interface{}{":tenant": 1}}}))
+}
+
+func TestParameterAnalyzer_RelativeTimes(t *testing.T) {
+	ts := time.Date(2025, 5, 10, 12, 0, 0, 0, time.UTC)
+	traces := []models.SQLTrace{
+		{Timestamp: ts, Parameters: map[string]interface{}{"since": "2025-05-09 12:00:00", "day": "2025-05-03", "at": ts.Add(-time.Hour)}},
+		{Timestamp: ts.Add(time.Hour), Parameters: map[string]interface{}{"since": "2025-05-09 13:00:00", "day": "2025-05-03", "at": ts}},
+	}
+
+	analyzer := services.NewParameterAnalyzer()
+	stats := analyzer.Analyze(traces)
+
+	since := stats["since"]
+	assert.True(t, since.Relative)
+	assert.Equal(t, "DATETIME", since.DataType)
+	assert.Equal(t, []interface{}{-86400.0}, since.TopValues)
+	assert.Equal(t, "2006-01-02 15:04:05.999999999", since.TimeLayout)
+
+	// Dates are offset by whole days.
+	assert.Equal(t, []interface{}{-7 * 86400.0}, stats["day"].TopValues)
+	assert.Equal(t, []interface{}{-3600.0}, stats["at"].TopValues)
+	assert.Empty(t, stats["at"].TimeLayout)
+
+	// Without trace timestamps the observed times are kept.
+	traces[1].Timestamp = time.Time{}
+	stats = analyzer.Analyze(traces)
+	assert.False(t, stats["since"].Relative)
+	assert.Len(t, stats["since"].TopValues, 2)
+
+	analyzer.RelativeTimes = false
+	traces[1].Timestamp = ts
+	assert.False(t, analyzer.Analyze(traces)["since"].Relative)
+}